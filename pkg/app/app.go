@@ -13,8 +13,11 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/afero"
 
+	"github.com/jesseduffield/lazygit/pkg/app/headless"
 	appTypes "github.com/jesseduffield/lazygit/pkg/app/types"
+	"github.com/jesseduffield/lazygit/pkg/commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_config"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/common"
 	"github.com/jesseduffield/lazygit/pkg/config"
@@ -24,6 +27,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/i18n"
 	integrationTypes "github.com/jesseduffield/lazygit/pkg/integration/types"
 	"github.com/jesseduffield/lazygit/pkg/logs"
+	"github.com/jesseduffield/lazygit/pkg/remote"
 	"github.com/jesseduffield/lazygit/pkg/updates"
 )
 
@@ -140,6 +144,39 @@ func NewApp(config config.AppConfigurer, test integrationTypes.IntegrationTest,
 	return app, nil
 }
 
+// Runs one of the 'lazygit exec' commands without starting the gui, and returns
+// the exit code
+func RunHeadless(appConfig config.AppConfigurer, common *common.Common, args []string) int {
+	return headless.Run(args, os.Stdout, func() (*headless.Env, error) {
+		tr, err := i18n.NewTranslationSetFromConfig(common.Log, common.UserConfig().Gui.Language)
+		if err != nil {
+			return nil, err
+		}
+		common.Tr = tr
+
+		app := &App{Common: common, Config: appConfig}
+		app.OSCommand = oscommands.NewOSCommand(common, appConfig, oscommands.GetPlatform(), oscommands.NewNullGuiIO(app.Log))
+
+		gitVersion, err := app.validateGitVersion()
+		if err != nil {
+			return nil, err
+		}
+
+		git, err := commands.NewGitCommand(
+			common,
+			gitVersion,
+			app.OSCommand,
+			git_config.NewStdCachedGitConfig(common.Log),
+			config.NewPagerConfig(common.UserConfig),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		return &headless.Env{Common: common, Cmd: app.OSCommand.Cmd, Git: git}, nil
+	})
+}
+
 const minGitVersionStr = "2.32.0"
 
 func minGitVersionErrorMessage(tr *i18n.TranslationSet) string {
@@ -274,10 +311,37 @@ func (app *App) setupRepo(
 }
 
 func (app *App) Run(startArgs appTypes.StartArgs) error {
+	if startArgs.ListenSocket != "" {
+		server, err := app.startRemoteServer(startArgs.ListenSocket)
+		if err != nil {
+			return err
+		}
+		defer server.Close()
+	}
+
 	err := app.Gui.RunAndHandleError(startArgs)
 	return err
 }
 
+func (app *App) startRemoteServer(path string) (*remote.Server, error) {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+
+	server, err := remote.NewServer(absPath, app.Log)
+	if err != nil {
+		return nil, err
+	}
+
+	// so that processes started from within lazygit (e.g. an editor) can talk
+	// back to us
+	os.Setenv("LAZYGIT_SOCKET", absPath)
+
+	app.Gui.AttachRemoteServer(server)
+	return server, nil
+}
+
 // Close closes any resources
 func (app *App) Close() error {
 	for _, closer := range app.closers {
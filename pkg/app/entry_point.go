@@ -27,14 +27,18 @@ import (
 )
 
 type cliArgs struct {
-	RepoPath           string
-	FilterPath         string
-	GitArg             string
-	UseConfigDir       string
-	WorkTree           string
-	GitDir             string
-	CustomConfigFile   string
-	ScreenMode         string
+	RepoPath         string
+	FilterPath       string
+	GitArg           string
+	UseConfigDir     string
+	WorkTree         string
+	GitDir           string
+	CustomConfigFile string
+	ScreenMode       string
+	ListenSocket     string
+	// the arguments following 'exec', if lazygit was invoked as 'lazygit exec ...'
+	ExecArgs           []string
+	IsExec             bool
 	PrintVersionInfo   bool
 	Debug              bool
 	TailLogs           bool
@@ -159,6 +163,12 @@ func Start(buildInfo *BuildInfo, integrationTest integrationTypes.IntegrationTes
 		return
 	}
 
+	if cliArgs.IsExec {
+		exitCode := RunHeadless(appConfig, common, cliArgs.ExecArgs)
+		os.RemoveAll(tempDir)
+		os.Exit(exitCode)
+	}
+
 	if cliArgs.Profile {
 		go func() {
 			if err := http.ListenAndServe("localhost:6060", nil); err != nil {
@@ -169,7 +179,7 @@ func Start(buildInfo *BuildInfo, integrationTest integrationTypes.IntegrationTes
 
 	parsedGitArg := parseGitArg(cliArgs.GitArg)
 
-	Run(appConfig, common, appTypes.NewStartArgs(cliArgs.FilterPath, parsedGitArg, cliArgs.ScreenMode, integrationTest))
+	Run(appConfig, common, appTypes.NewStartArgs(cliArgs.FilterPath, parsedGitArg, cliArgs.ScreenMode, cliArgs.ListenSocket, integrationTest))
 }
 
 func parseCliArgsAndEnvVars() *cliArgs {
@@ -182,7 +192,7 @@ func parseCliArgsAndEnvVars() *cliArgs {
 	flaggy.String(&filterPath, "f", "filter", "Path to filter on in `git log -- <path>`. When in filter mode, the commits, reflog, and stash are filtered based on the given path, and some operations are restricted")
 
 	gitArg := ""
-	flaggy.AddPositionalValue(&gitArg, "git-arg", 1, false, "Panel to focus upon opening lazygit. Accepted values (based on git terminology): status, branch, log, stash. Ignored if --filter arg is passed.")
+	flaggy.AddPositionalValue(&gitArg, "git-arg", 1, false, "Panel to focus upon opening lazygit. Accepted values (based on git terminology): status, branch, log, stash. Ignored if --filter arg is passed. Alternatively, 'exec' followed by a command runs that command without starting the gui; see 'lazygit exec help'.")
 
 	printVersionInfo := false
 	flaggy.Bool(&printVersionInfo, "v", "version", "Print the current version")
@@ -217,7 +227,11 @@ func parseCliArgsAndEnvVars() *cliArgs {
 	screenMode := ""
 	flaggy.String(&screenMode, "sm", "screen-mode", "The initial screen-mode, which determines the size of the focused panel. Valid options: 'normal' (default), 'half', 'full'")
 
-	flaggy.Parse()
+	listenSocket := ""
+	flaggy.String(&listenSocket, "", "listen", "Path of a Unix domain socket on which to accept JSON-RPC commands from other tools (e.g. editors) for remote-controlling lazygit. See docs/Remote_Control.md")
+
+	globalArgs, execArgs, isExec := splitExecArgs(os.Args[1:])
+	flaggy.ParseArgs(globalArgs)
 
 	if os.Getenv("DEBUG") == "TRUE" {
 		debug = true
@@ -238,7 +252,49 @@ func parseCliArgsAndEnvVars() *cliArgs {
 		GitDir:             gitDir,
 		CustomConfigFile:   customConfigFile,
 		ScreenMode:         screenMode,
+		ListenSocket:       listenSocket,
+		ExecArgs:           execArgs,
+		IsExec:             isExec,
+	}
+}
+
+// Splits the arguments of 'lazygit [flags] exec <command> [<args>]' into the
+// global flags, which are parsed as usual, and the arguments following 'exec'.
+// We can't use a flaggy subcommand for this because the git-arg positional
+// value occupies the same position.
+func splitExecArgs(args []string) ([]string, []string, bool) {
+	takesValue := func(arg string) bool {
+		if strings.Contains(arg, "=") {
+			return false
+		}
+		name := strings.TrimLeft(arg, "-")
+		flag, ok := lo.Find(flaggy.DefaultParser.Flags, func(flag *flaggy.Flag) bool { return flag.HasName(name) })
+		if !ok {
+			return false
+		}
+		_, isBool := flag.AssignmentVar.(*bool)
+		return !isBool
 	}
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if arg == "--" {
+			break
+		}
+		if strings.HasPrefix(arg, "-") {
+			if takesValue(arg) {
+				i++
+			}
+			continue
+		}
+		if arg == "exec" {
+			return args[:i], args[i+1:], true
+		}
+		// the first positional argument is the git-arg
+		break
+	}
+
+	return args, nil, false
 }
 
 func parseGitArg(gitArg string) appTypes.GitArg {
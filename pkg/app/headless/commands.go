@@ -0,0 +1,174 @@
+package headless
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+type commitResult struct {
+	Hash    string `json:"hash"`
+	Subject string `json:"subject"`
+}
+
+type findFixupBaseResult struct {
+	commitResult
+	// false if there were no staged changes, so the unstaged ones were used
+	HasStagedChanges bool `json:"hasStagedChanges"`
+	// if true, hunks with only added lines were ignored, so the changes might
+	// also belong to another commit
+	HasHunksWithOnlyAddedLines bool `json:"hasHunksWithOnlyAddedLines"`
+}
+
+// The result of the commands that rewrite the current branch
+type rebaseResult struct {
+	Head string `json:"head"`
+}
+
+func findFixupBase(env *Env, args []string) (any, error) {
+	commits, err := loadCommits(env)
+	if err != nil {
+		return nil, err
+	}
+
+	base, err := helpers.FindBaseCommitForFixup(env.Git, env.Common.Tr, commits)
+	if err != nil {
+		return nil, err
+	}
+
+	return findFixupBaseResult{
+		commitResult:               commitResult{Hash: base.Commit.Hash(), Subject: base.Commit.Name},
+		HasStagedChanges:           base.HasStagedChanges,
+		HasHunksWithOnlyAddedLines: base.HasHunksWithOnlyAddedLines,
+	}, nil
+}
+
+func squashFixups(env *Env, args []string) (any, error) {
+	if err := ensureNotRebasing(env); err != nil {
+		return nil, err
+	}
+
+	commits, err := loadCommits(env)
+	if err != nil {
+		return nil, err
+	}
+
+	var commit *models.Commit
+	if len(args) > 0 {
+		commit, _, err = findCommit(env, commits, args[0])
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		// the oldest commit of the current branch
+		_, index, ok := lo.FindIndexOf(commits, func(c *models.Commit) bool {
+			return c.IsMerge() || c.Status == models.StatusMerged
+		})
+		if !ok || index == 0 {
+			return nil, errors.New(env.Common.Tr.CannotSquashCommitsInCurrentBranch)
+		}
+		commit = commits[index-1]
+	}
+
+	err = env.Git.Rebase.SquashAllAboveFixupCommits(commit)
+	return rebaseOutcome(env, err)
+}
+
+func moveCommit(env *Env, args []string) (any, error) {
+	direction := args[1]
+	if direction != "up" && direction != "down" {
+		return nil, &usageError{fmt.Sprintf("invalid direction '%s', must be 'up' or 'down'", direction)}
+	}
+
+	if err := ensureNotRebasing(env); err != nil {
+		return nil, err
+	}
+
+	commits, err := loadCommits(env)
+	if err != nil {
+		return nil, err
+	}
+
+	_, index, err := findCommit(env, commits, args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	if direction == "up" {
+		if index == 0 {
+			return nil, errors.New(env.Common.Tr.CannotMoveAnyFurther)
+		}
+
+		err = env.Git.Rebase.MoveCommitsUp(commits, index, index)
+	} else {
+		if index >= len(commits)-1 {
+			return nil, errors.New(env.Common.Tr.CannotMoveAnyFurther)
+		}
+
+		err = env.Git.Rebase.MoveCommitsDown(commits, index, index)
+	}
+
+	return rebaseOutcome(env, err)
+}
+
+// Loads the commits of the current branch like the commits panel does, so that
+// we know which ones are already merged
+func loadCommits(env *Env) ([]*models.Commit, error) {
+	var refForPushedStatus models.Ref
+	if branchInfo, err := env.Git.Branch.CurrentBranchInfo(); err == nil && !branchInfo.DetachedHead {
+		refForPushedStatus = &models.Branch{Name: branchInfo.RefName}
+	}
+
+	return env.Git.Loaders.CommitLoader.GetCommits(git_commands.GetCommitsOptions{
+		Limit:              true,
+		RefName:            "HEAD",
+		RefForPushedStatus: refForPushedStatus,
+		MainBranches:       git_commands.NewMainBranches(env.Common, env.Cmd),
+		HashPool:           &utils.StringPool{},
+	})
+}
+
+func findCommit(env *Env, commits []*models.Commit, revision string) (*models.Commit, int, error) {
+	hash, err := env.Git.Commit.GetCommitHash(revision)
+	if err != nil {
+		return nil, -1, err
+	}
+
+	commit, index, ok := lo.FindIndexOf(commits, func(commit *models.Commit) bool {
+		return commit.Hash() == hash
+	})
+	if !ok {
+		return nil, -1, fmt.Errorf("'%s' is not a commit of the current branch", revision)
+	}
+
+	return commit, index, nil
+}
+
+func ensureNotRebasing(env *Env) error {
+	if env.Git.Status.WorkingTreeState().Any() {
+		return errors.New(env.Common.Tr.AlreadyRebasing)
+	}
+
+	return nil
+}
+
+func rebaseOutcome(env *Env, err error) (any, error) {
+	if err != nil {
+		if env.Git.Status.WorkingTreeState().Rebasing {
+			return nil, &conflictsError{err}
+		}
+		return nil, err
+	}
+
+	head, err := env.Git.Commit.GetCommitHash("HEAD")
+	if err != nil {
+		return nil, err
+	}
+
+	return rebaseResult{Head: head}, nil
+}
@@ -0,0 +1,161 @@
+// Package headless implements 'lazygit exec', which runs some of lazygit's git
+// operations without starting the gui, for use from scripts and git aliases.
+//
+// The outcome of a command is printed to stdout as a single JSON object with a
+// 'status' field ("ok", "error" or "conflicts"); on success, the 'result' field
+// has the command-specific output, otherwise the 'error' field has the error
+// message. The exit code tells the same (see the ExitCode constants).
+package headless
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/common"
+	"github.com/samber/lo"
+)
+
+const (
+	ExitCodeOK = 0
+	// The command failed, e.g. because no base commit was found
+	ExitCodeError = 1
+	// The arguments were invalid
+	ExitCodeUsage = 2
+	// The command started a rebase which stopped because of conflicts; it
+	// needs to be continued or aborted by the user
+	ExitCodeConflicts = 3
+)
+
+// What the commands need for running. It is only created after the arguments
+// have been validated, so that usage errors are reported even outside of a
+// repo.
+type Env struct {
+	Common *common.Common
+	Cmd    oscommands.ICmdObjBuilder
+	Git    *commands.GitCommand
+}
+
+type command struct {
+	name        string
+	args        []string
+	description string
+	run         func(env *Env, args []string) (any, error)
+}
+
+var commandList = []*command{
+	{
+		name:        "find-fixup-base",
+		description: "Print the commit that the staged changes (or, if there are none, the unstaged changes) should be squashed into",
+		run:         findFixupBase,
+	},
+	{
+		name:        "squash-fixups",
+		args:        []string{"[<commit>]"},
+		description: "Squash all fixup commits above the given commit into their base commits, or all those of the current branch if no commit is given",
+		run:         squashFixups,
+	},
+	{
+		name:        "move-commit",
+		args:        []string{"<commit>", "up|down"},
+		description: "Move a commit of the current branch up (towards HEAD) or down by one",
+		run:         moveCommit,
+	},
+}
+
+type output struct {
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+	Result any    `json:"result,omitempty"`
+}
+
+// An error about the arguments which can only be detected by the command itself
+type usageError struct {
+	message string
+}
+
+func (self *usageError) Error() string {
+	return self.message
+}
+
+// An error after which the repo is left in the middle of a rebase
+type conflictsError struct {
+	err error
+}
+
+func (self *conflictsError) Error() string {
+	return self.err.Error()
+}
+
+// Runs the command given by args (the arguments following 'exec'), prints its
+// outcome to out, and returns the exit code
+func Run(args []string, out io.Writer, newEnv func() (*Env, error)) int {
+	if len(args) == 0 || lo.Contains([]string{"help", "-h", "--help"}, args[0]) {
+		printUsage(out)
+		return lo.Ternary(len(args) == 0, ExitCodeUsage, ExitCodeOK)
+	}
+
+	cmd, ok := lo.Find(commandList, func(cmd *command) bool { return cmd.name == args[0] })
+	if !ok {
+		return fail(out, ExitCodeUsage, fmt.Errorf("unknown command '%s'. Run 'lazygit exec help' for the list of commands", args[0]))
+	}
+
+	cmdArgs := args[1:]
+	numRequiredArgs := lo.CountBy(cmd.args, func(arg string) bool { return !strings.HasPrefix(arg, "[") })
+	if len(cmdArgs) < numRequiredArgs || len(cmdArgs) > len(cmd.args) {
+		return fail(out, ExitCodeUsage, fmt.Errorf("usage: lazygit exec %s", cmd.usage()))
+	}
+
+	env, err := newEnv()
+	if err != nil {
+		return fail(out, ExitCodeError, err)
+	}
+
+	result, err := cmd.run(env, cmdArgs)
+	if err != nil {
+		var conflictsErr *conflictsError
+		var usageErr *usageError
+		switch {
+		case errors.As(err, &conflictsErr):
+			write(out, output{Status: "conflicts", Error: err.Error()})
+			return ExitCodeConflicts
+		case errors.As(err, &usageErr):
+			return fail(out, ExitCodeUsage, err)
+		default:
+			return fail(out, ExitCodeError, err)
+		}
+	}
+
+	write(out, output{Status: "ok", Result: result})
+	return ExitCodeOK
+}
+
+func (self *command) usage() string {
+	return strings.Join(append([]string{self.name}, self.args...), " ")
+}
+
+func printUsage(out io.Writer) {
+	fmt.Fprintln(out, "Usage: lazygit exec <command> [<args>]")
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, "Commands:")
+	for _, cmd := range commandList {
+		fmt.Fprintf(out, "  %s\n      %s\n", cmd.usage(), cmd.description)
+	}
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, "The outcome is printed as JSON. Exit codes: 0 success, 1 error, 2 invalid arguments, 3 stopped with conflicts.")
+}
+
+func fail(out io.Writer, exitCode int, err error) int {
+	write(out, output{Status: "error", Error: err.Error()})
+	return exitCode
+}
+
+func write(out io.Writer, o output) {
+	encoder := json.NewEncoder(out)
+	encoder.SetEscapeHTML(false)
+	_ = encoder.Encode(o)
+}
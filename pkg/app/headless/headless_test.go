@@ -0,0 +1,69 @@
+package headless
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunArgumentErrors(t *testing.T) {
+	scenarios := []struct {
+		name             string
+		args             []string
+		expectedExitCode int
+		expectedOutput   string
+	}{
+		{
+			name:             "unknown command",
+			args:             []string{"frobnicate"},
+			expectedExitCode: ExitCodeUsage,
+			expectedOutput:   `{"status":"error","error":"unknown command 'frobnicate'. Run 'lazygit exec help' for the list of commands"}` + "\n",
+		},
+		{
+			name:             "missing argument",
+			args:             []string{"move-commit", "abc123"},
+			expectedExitCode: ExitCodeUsage,
+			expectedOutput:   `{"status":"error","error":"usage: lazygit exec move-commit <commit> up|down"}` + "\n",
+		},
+		{
+			name:             "too many arguments",
+			args:             []string{"squash-fixups", "abc123", "def456"},
+			expectedExitCode: ExitCodeUsage,
+			expectedOutput:   `{"status":"error","error":"usage: lazygit exec squash-fixups [<commit>]"}` + "\n",
+		},
+		{
+			name:             "not in a repo",
+			args:             []string{"find-fixup-base"},
+			expectedExitCode: ExitCodeError,
+			expectedOutput:   `{"status":"error","error":"not a git repository"}` + "\n",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			out := &bytes.Buffer{}
+			exitCode := Run(s.args, out, func() (*Env, error) {
+				return nil, errors.New("not a git repository")
+			})
+
+			assert.Equal(t, s.expectedExitCode, exitCode)
+			assert.Equal(t, s.expectedOutput, out.String())
+		})
+	}
+}
+
+func TestRunHelp(t *testing.T) {
+	out := &bytes.Buffer{}
+	exitCode := Run([]string{"help"}, out, nil)
+
+	assert.Equal(t, ExitCodeOK, exitCode)
+	assert.Contains(t, out.String(), "move-commit <commit> up|down")
+
+	out.Reset()
+	exitCode = Run([]string{}, out, nil)
+
+	assert.Equal(t, ExitCodeUsage, exitCode)
+	assert.Contains(t, out.String(), "Usage: lazygit exec <command> [<args>]")
+}
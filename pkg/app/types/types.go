@@ -14,6 +14,8 @@ type StartArgs struct {
 	FilterPath string
 	// ScreenMode determines the initial Screen Mode (normal, half or full) to use
 	ScreenMode string
+	// ListenSocket is the path of the socket for remote-controlling lazygit, if any
+	ListenSocket string
 }
 
 type GitArg string
@@ -26,11 +28,12 @@ const (
 	GitArgStash  GitArg = "stash"
 )
 
-func NewStartArgs(filterPath string, gitArg GitArg, screenMode string, test integrationTypes.IntegrationTest) StartArgs {
+func NewStartArgs(filterPath string, gitArg GitArg, screenMode string, listenSocket string, test integrationTypes.IntegrationTest) StartArgs {
 	return StartArgs{
 		FilterPath:      filterPath,
 		GitArg:          gitArg,
 		ScreenMode:      screenMode,
+		ListenSocket:    listenSocket,
 		IntegrationTest: test,
 	}
 }
@@ -114,6 +114,8 @@ func localisedTitle(tr *i18n.TranslationSet, str string) string {
 		"commitFiles":       tr.CommitFilesTitle,
 		"commitMessage":     tr.CommitSummaryTitle,
 		"commitDescription": tr.CommitDescriptionTitle,
+		"hunkEditor":        tr.HunkEditorTitle,
+		"commandLogEntries": tr.CommandLogEntriesTitle,
 		"commits":           tr.CommitsTitle,
 		"confirmation":      tr.ConfirmationTitle,
 		"prompt":            tr.PromptTitle,
@@ -129,6 +131,7 @@ func localisedTitle(tr *i18n.TranslationSet, str string) string {
 		"suggestions":       tr.SuggestionsCheatsheetTitle,
 		"extras":            tr.ExtrasTitle,
 		"worktrees":         tr.WorktreesTitle,
+		"workspace":         tr.WorkspaceTitle,
 	}
 
 	title, ok := contextTitleMap[str]
@@ -53,6 +53,7 @@ type Loaders struct {
 	StashLoader        *git_commands.StashLoader
 	TagLoader          *git_commands.TagLoader
 	Worktrees          *git_commands.WorktreeLoader
+	Workspace          *git_commands.WorkspaceLoader
 }
 
 func NewGitCommand(
@@ -144,6 +145,7 @@ func NewGitCommandAux(
 	reflogCommitLoader := git_commands.NewReflogCommitLoader(cmn, cmd)
 	remoteLoader := git_commands.NewRemoteLoader(cmn, cmd, repo.Remotes)
 	worktreeLoader := git_commands.NewWorktreeLoader(gitCommon)
+	workspaceLoader := git_commands.NewWorkspaceLoader(gitCommon)
 	stashLoader := git_commands.NewStashLoader(cmn, cmd)
 	tagLoader := git_commands.NewTagLoader(cmn, cmd)
 
@@ -176,6 +178,7 @@ func NewGitCommandAux(
 			ReflogCommitLoader: reflogCommitLoader,
 			RemoteLoader:       remoteLoader,
 			Worktrees:          worktreeLoader,
+			Workspace:          workspaceLoader,
 			StashLoader:        stashLoader,
 			TagLoader:          tagLoader,
 		},
@@ -2,7 +2,9 @@ package git_commands
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
@@ -322,6 +324,122 @@ func (self *BranchCommands) IsBranchMerged(branch *models.Branch, mainBranches *
 	return stdout == "", nil
 }
 
+// Returns whether all commits of the branch are reachable from the given ref.
+func (self *BranchCommands) IsMergedInto(branchName string, ref string) (bool, error) {
+	cmdArgs := NewGitCmd("rev-list").
+		Arg("--max-count=1").
+		Arg(branchName, "^"+ref).
+		Arg("--").
+		ToArgv()
+
+	stdout, _, err := self.cmd.New(cmdArgs).DontLog().RunWithOutputs()
+	if err != nil {
+		return false, err
+	}
+
+	return stdout == "", nil
+}
+
+// Returns whether the changes of the branch have been applied to the given ref
+// as a single commit, as happens when a branch is squash-merged. We do this by
+// comparing the patch id of the branch's combined diff against its merge base
+// with the patch ids of the commits that were added to ref since then. This is
+// what git cherry does too, but unlike using git cherry on a commit created
+// with commit-tree it doesn't leave dangling objects behind.
+func (self *BranchCommands) IsSquashMergedInto(branchName string, ref string) (bool, error) {
+	mergeBase, err := self.cmd.New(
+		NewGitCmd("merge-base").Arg(ref, branchName).ToArgv(),
+	).DontLog().RunWithOutput()
+	if err != nil {
+		return false, err
+	}
+	mergeBase = strings.TrimSpace(mergeBase)
+
+	diff, err := self.cmd.New(
+		NewGitCmd("diff").
+			Arg("--no-ext-diff", "--no-color").
+			Arg(mergeBase, branchName).
+			Arg("--").
+			ToArgv(),
+	).DontLog().RunWithOutput()
+	if err != nil {
+		return false, err
+	}
+	if diff == "" {
+		return false, nil
+	}
+
+	branchPatchIds, err := self.patchIds(diff)
+	if err != nil || len(branchPatchIds) == 0 {
+		return false, err
+	}
+
+	log, err := self.cmd.New(
+		NewGitCmd("log").
+			Arg("--no-merges", "--no-ext-diff", "--no-color", "-p").
+			Arg(mergeBase + ".." + ref).
+			Arg("--").
+			ToArgv(),
+	).DontLog().RunWithOutput()
+	if err != nil {
+		return false, err
+	}
+
+	refPatchIds, err := self.patchIds(log)
+	if err != nil {
+		return false, err
+	}
+
+	return lo.Contains(refPatchIds, branchPatchIds[0]), nil
+}
+
+// Returns the patch ids of the patches in the given diff or log output
+func (self *BranchCommands) patchIds(patches string) ([]string, error) {
+	if patches == "" {
+		return nil, nil
+	}
+
+	output, err := self.cmd.New(
+		NewGitCmd("patch-id").Arg("--stable").ToArgv(),
+	).SetStdin(patches).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.FilterMap(utils.SplitLines(output), func(line string, _ int) (string, bool) {
+		patchId, _, _ := strings.Cut(line, " ")
+		return patchId, patchId != ""
+	}), nil
+}
+
+// Returns the committer date of the tip of each local branch, keyed by branch
+// name.
+func (self *BranchCommands) GetLastCommitTimes() (map[string]time.Time, error) {
+	cmdArgs := NewGitCmd("for-each-ref").
+		Arg("--format=%(refname:short)%00%(committerdate:unix)").
+		Arg("refs/heads").
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string]time.Time{}
+	for _, line := range utils.SplitLines(output) {
+		name, timestamp, ok := strings.Cut(line, "\x00")
+		if !ok {
+			continue
+		}
+		unixTime, err := strconv.ParseInt(timestamp, 10, 64)
+		if err != nil {
+			continue
+		}
+		result[name] = time.Unix(unixTime, 0)
+	}
+	return result, nil
+}
+
 func (self *BranchCommands) UpdateBranchRefs(updateCommands string) error {
 	cmdArgs := NewGitCmd("update-ref").
 		Arg("--stdin").
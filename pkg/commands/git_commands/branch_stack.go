@@ -0,0 +1,111 @@
+package git_commands
+
+import (
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+// Returns the stacks of local branches that are built on top of each other.
+// Only commits that are not on any of the main branches are taken into
+// account, so every returned root is a branch that is based directly on a
+// main branch (or on a commit of a main branch).
+func (self *BranchCommands) GetBranchStacks(mainBranches *MainBranches) ([]*models.BranchStackNode, error) {
+	mainBranchNames := self.UserConfig().Git.MainBranches
+
+	cmdArgs := NewGitCmd("log").
+		Arg("--topo-order").
+		Arg("--format=%H%x00%P%x00%D").
+		Arg("--decorate-refs=refs/heads/").
+		Arg(lo.Map(mainBranchNames, func(name string, _ int) string { return "--exclude=" + name })...).
+		Arg("--branches").
+		Arg(lo.Map(mainBranches.Get(), func(ref string, _ int) string { return "^" + ref })...).
+		Arg("--").
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	return parseBranchStacks(output, mainBranchNames), nil
+}
+
+type branchStackCommit struct {
+	firstParent string
+	branches    []string
+}
+
+// Expects the output of git log in topological order, i.e. children before
+// their parents.
+func parseBranchStacks(output string, mainBranchNames []string) []*models.BranchStackNode {
+	commits := map[string]branchStackCommit{}
+	hashes := []string{}
+	for _, line := range utils.SplitLines(output) {
+		fields := strings.Split(line, "\x00")
+		if len(fields) != 3 {
+			continue
+		}
+
+		hash, parents, decorations := fields[0], strings.Fields(fields[1]), fields[2]
+		commit := branchStackCommit{
+			branches: parseBranchDecorations(decorations, mainBranchNames),
+		}
+		if len(parents) > 0 {
+			commit.firstParent = parents[0]
+		}
+		commits[hash] = commit
+		hashes = append(hashes, hash)
+	}
+
+	nodes := map[string]*models.BranchStackNode{}
+	roots := []*models.BranchStackNode{}
+
+	// Walk the commits from oldest to newest so that a branch's parent branch
+	// has always been created before the branch itself
+	for i := len(hashes) - 1; i >= 0; i-- {
+		commit := commits[hashes[i]]
+		if len(commit.branches) == 0 {
+			continue
+		}
+
+		var parent *models.BranchStackNode
+		for hash := commit.firstParent; hash != ""; {
+			ancestor, ok := commits[hash]
+			if !ok {
+				break
+			}
+			if len(ancestor.branches) > 0 {
+				parent = nodes[ancestor.branches[0]]
+				break
+			}
+			hash = ancestor.firstParent
+		}
+
+		for _, branchName := range commit.branches {
+			node := &models.BranchStackNode{BranchName: branchName, Parent: parent}
+			nodes[branchName] = node
+			if parent != nil {
+				parent.Children = append(parent.Children, node)
+			} else {
+				roots = append(roots, node)
+			}
+		}
+	}
+
+	return roots
+}
+
+// Parses the %D placeholder of git log, e.g. "HEAD -> feature, other"
+func parseBranchDecorations(decorations string, mainBranchNames []string) []string {
+	if decorations == "" {
+		return nil
+	}
+
+	return lo.FilterMap(strings.Split(decorations, ", "), func(decoration string, _ int) (string, bool) {
+		branchName := strings.TrimPrefix(decoration, "HEAD -> ")
+		return branchName, branchName != "HEAD" && !lo.Contains(mainBranchNames, branchName)
+	})
+}
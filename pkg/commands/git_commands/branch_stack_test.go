@@ -0,0 +1,76 @@
+package git_commands
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseBranchStacks(t *testing.T) {
+	type scenario struct {
+		testName string
+		output   string
+		expected map[string]string // branch name -> parent branch name
+		roots    []string
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "no branches",
+			output:   "",
+			expected: map[string]string{},
+			roots:    []string{},
+		},
+		{
+			testName: "linear stack",
+			output: "c3\x00c2\x00HEAD -> feature-c\n" +
+				"c2\x00c1b\x00feature-b\n" +
+				"c1b\x00c1a\x00\n" +
+				"c1a\x00c0\x00feature-a\n" +
+				"c0\x00base\x00\n",
+			expected: map[string]string{"feature-a": "", "feature-b": "feature-a", "feature-c": "feature-b"},
+			roots:    []string{"feature-a"},
+		},
+		{
+			testName: "forked stack and unrelated branch",
+			output: "d1\x00a1\x00feature-d\n" +
+				"x1\x00base\x00other\n" +
+				"b1\x00a1\x00feature-b, feature-b-copy\n" +
+				"a1\x00base\x00feature-a\n",
+			expected: map[string]string{
+				"feature-a":      "",
+				"feature-b":      "feature-a",
+				"feature-b-copy": "feature-a",
+				"feature-d":      "feature-a",
+				"other":          "",
+			},
+			roots: []string{"feature-a", "other"},
+		},
+		{
+			testName: "local main branch is ignored",
+			output: "a1\x00m1\x00feature-a\n" +
+				"m1\x00base\x00master\n",
+			expected: map[string]string{"feature-a": ""},
+			roots:    []string{"feature-a"},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			roots := parseBranchStacks(s.output, []string{"master", "main"})
+			assert.Equal(t, s.roots, lo.Map(roots, func(node *models.BranchStackNode, _ int) string { return node.BranchName }))
+
+			parents := map[string]string{}
+			for _, root := range roots {
+				for _, node := range root.Flatten() {
+					parents[node.BranchName] = lo.TernaryF(node.Parent == nil,
+						func() string { return "" },
+						func() string { return node.Parent.BranchName })
+				}
+			}
+			assert.Equal(t, s.expected, parents)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package git_commands
 
 import (
 	"testing"
+	"time"
 
 	"github.com/go-errors/errors"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
@@ -303,3 +304,88 @@ func TestBranchCurrentBranchInfo(t *testing.T) {
 		})
 	}
 }
+
+func TestBranchIsSquashMergedInto(t *testing.T) {
+	scenarios := []struct {
+		testName       string
+		diff           string
+		log            string
+		patchIdOutputs []string
+		expected       bool
+	}{
+		{
+			testName: "squash-merged",
+			diff:     "diff --git a/file b/file\n",
+			log:      "commit 1234567\n\ndiff --git a/file b/file\n",
+			patchIdOutputs: []string{
+				"aaa 0000000000000000000000000000000000000000\n",
+				"bbb 2345678\naaa 1234567\n",
+			},
+			expected: true,
+		},
+		{
+			testName: "not merged",
+			diff:     "diff --git a/file b/file\n",
+			log:      "commit 1234567\n\ndiff --git a/file b/file\n",
+			patchIdOutputs: []string{
+				"aaa 0000000000000000000000000000000000000000\n",
+				"bbb 1234567\n",
+			},
+			expected: false,
+		},
+		{
+			testName: "no commits added to ref since the merge base",
+			diff:     "diff --git a/file b/file\n",
+			log:      "",
+			patchIdOutputs: []string{
+				"aaa 0000000000000000000000000000000000000000\n",
+			},
+			expected: false,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			runner := oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"merge-base", "refs/heads/master", "feature"}, "abc\n", nil).
+				ExpectGitArgs([]string{"diff", "--no-ext-diff", "--no-color", "abc", "feature", "--"}, s.diff, nil).
+				ExpectGitArgs([]string{"log", "--no-merges", "--no-ext-diff", "--no-color", "-p", "abc..refs/heads/master", "--"}, s.log, nil)
+			for _, output := range s.patchIdOutputs {
+				runner = runner.ExpectGitArgs([]string{"patch-id", "--stable"}, output, nil)
+			}
+			instance := buildBranchCommands(commonDeps{runner: runner})
+
+			merged, err := instance.IsSquashMergedInto("feature", "refs/heads/master")
+			assert.NoError(t, err)
+			assert.Equal(t, s.expected, merged)
+			runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestBranchIsSquashMergedIntoWithoutChanges(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"merge-base", "refs/heads/master", "feature"}, "abc\n", nil).
+		ExpectGitArgs([]string{"diff", "--no-ext-diff", "--no-color", "abc", "feature", "--"}, "", nil)
+	instance := buildBranchCommands(commonDeps{runner: runner})
+
+	merged, err := instance.IsSquashMergedInto("feature", "refs/heads/master")
+	assert.NoError(t, err)
+	assert.False(t, merged)
+	runner.CheckForMissingCalls()
+}
+
+func TestBranchGetLastCommitTimes(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"for-each-ref", "--format=%(refname:short)%00%(committerdate:unix)", "refs/heads"},
+			"master\x001700000000\nfeature/a\x001600000000\n", nil)
+	instance := buildBranchCommands(commonDeps{runner: runner})
+
+	times, err := instance.GetLastCommitTimes()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]time.Time{
+		"master":    time.Unix(1700000000, 0),
+		"feature/a": time.Unix(1600000000, 0),
+	}, times)
+	runner.CheckForMissingCalls()
+}
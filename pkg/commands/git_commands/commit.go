@@ -173,6 +173,21 @@ func (self *CommitCommands) GetCommitSubject(commitHash string) (string, error)
 	return strings.TrimSpace(subject), err
 }
 
+// Returns the full hash of the commit that the given revision (e.g. an
+// abbreviated hash or a branch name) points to
+func (self *CommitCommands) GetCommitHash(revision string) (string, error) {
+	cmdArgs := NewGitCmd("rev-parse").
+		Arg("--verify", "--quiet", "--end-of-options", revision+"^{commit}").
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return "", fmt.Errorf("'%s' is not a commit", revision)
+	}
+
+	return strings.TrimSpace(output), nil
+}
+
 func (self *CommitCommands) GetCommitDiff(commitHash string) (string, error) {
 	cmdArgs := NewGitCmd("show").Arg("--no-color", commitHash).ToArgv()
 
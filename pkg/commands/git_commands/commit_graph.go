@@ -0,0 +1,237 @@
+package git_commands
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// CommitGraph reads git's on-disk commit-graph (see
+// https://git-scm.com/docs/gitformat-commit-graph), which git writes on gc or
+// fetch and which contains the generation numbers of all commits that existed
+// at that time. A commit's generation number is always greater than the ones
+// of its ancestors, so comparing them lets us rule out cheaply that one commit
+// is an ancestor of another, without walking any history.
+//
+// Both a single commit-graph file and split commit-graph chains are supported.
+type CommitGraph struct {
+	// base graphs first
+	files []*commitGraphFile
+}
+
+type commitGraphFile struct {
+	file    *os.File
+	hashLen int
+	// fanout[i] is the number of commits whose hash starts with a byte <= i
+	fanout           [256]uint32
+	oidLookupOffset  int64
+	commitDataOffset int64
+}
+
+const (
+	commitGraphSignature = "CGPH"
+	// the entries of the commit data chunk consist of the hash of the root
+	// tree, followed by two parent positions and the generation number and
+	// commit time, taking up 16 bytes altogether
+	commitDataExtraLen = 16
+)
+
+// Opens the commit-graph in the given objects dir. Returns nil and no error if
+// there is none.
+func OpenCommitGraph(objectsDir string) (*CommitGraph, error) {
+	infoDir := filepath.Join(objectsDir, "info")
+
+	paths := []string{filepath.Join(infoDir, "commit-graph")}
+	if chain, err := os.ReadFile(filepath.Join(infoDir, "commit-graphs", "commit-graph-chain")); err == nil {
+		paths = nil
+		for _, hash := range strings.Fields(string(chain)) {
+			paths = append(paths, filepath.Join(infoDir, "commit-graphs", "graph-"+hash+".graph"))
+		}
+	}
+
+	graph := &CommitGraph{}
+	for _, path := range paths {
+		file, err := openCommitGraphFile(path)
+		if err != nil {
+			graph.Close()
+			if os.IsNotExist(err) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		graph.files = append(graph.files, file)
+	}
+
+	return graph, nil
+}
+
+func (self *CommitGraph) Close() {
+	for _, file := range self.files {
+		file.file.Close()
+	}
+}
+
+// Returns the generation number (topological level) of the given commit, or
+// false if the commit is not in the commit-graph or was written without one.
+func (self *CommitGraph) Generation(hash string) (uint32, bool) {
+	hashBytes, err := hex.DecodeString(hash)
+	if err != nil {
+		return 0, false
+	}
+
+	for _, file := range self.files {
+		if generation, ok := file.generation(hashBytes); ok {
+			return generation, generation != 0
+		}
+	}
+
+	return 0, false
+}
+
+// Returns true if the commit-graph proves that ancestor is not an ancestor of
+// descendant. A false result proves nothing, e.g. because one of the commits is
+// newer than the commit-graph.
+func (self *CommitGraph) CannotBeAncestor(ancestor string, descendant string) bool {
+	ancestorGeneration, ok := self.Generation(ancestor)
+	if !ok {
+		return false
+	}
+
+	descendantGeneration, ok := self.Generation(descendant)
+	if !ok {
+		return false
+	}
+
+	return ancestorGeneration >= descendantGeneration
+}
+
+func openCommitGraphFile(path string) (*commitGraphFile, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	self := &commitGraphFile{file: file}
+	if err := self.readHeader(); err != nil {
+		file.Close()
+		return nil, fmt.Errorf("invalid commit-graph file %s: %w", path, err)
+	}
+
+	return self, nil
+}
+
+func (self *commitGraphFile) readHeader() error {
+	reader := bufio.NewReader(io.NewSectionReader(self.file, 0, 1<<62))
+
+	header := make([]byte, 8)
+	if _, err := io.ReadFull(reader, header); err != nil {
+		return err
+	}
+	if string(header[:4]) != commitGraphSignature {
+		return errors.New("bad signature")
+	}
+	if header[4] != 1 {
+		return fmt.Errorf("unsupported version %d", header[4])
+	}
+	switch header[5] {
+	case 1:
+		self.hashLen = 20
+	case 2:
+		self.hashLen = 32
+	default:
+		return fmt.Errorf("unsupported hash version %d", header[5])
+	}
+
+	numChunks := int(header[6])
+	var fanoutOffset int64 = -1
+	self.oidLookupOffset = -1
+	self.commitDataOffset = -1
+	// the table of contents has a terminating entry after the last chunk
+	for range numChunks + 1 {
+		entry := make([]byte, 12)
+		if _, err := io.ReadFull(reader, entry); err != nil {
+			return err
+		}
+		offset := int64(binary.BigEndian.Uint64(entry[4:]))
+		switch string(entry[:4]) {
+		case "OIDF":
+			fanoutOffset = offset
+		case "OIDL":
+			self.oidLookupOffset = offset
+		case "CDAT":
+			self.commitDataOffset = offset
+		}
+	}
+	if fanoutOffset < 0 || self.oidLookupOffset < 0 || self.commitDataOffset < 0 {
+		return errors.New("missing required chunk")
+	}
+
+	fanout := make([]byte, 256*4)
+	if _, err := self.file.ReadAt(fanout, fanoutOffset); err != nil {
+		return err
+	}
+	for i := range self.fanout {
+		self.fanout[i] = binary.BigEndian.Uint32(fanout[i*4:])
+	}
+
+	return nil
+}
+
+// Returns the generation number stored for the given commit, or false if the
+// commit is not in this file
+func (self *commitGraphFile) generation(hash []byte) (uint32, bool) {
+	if len(hash) != self.hashLen {
+		return 0, false
+	}
+
+	position, ok := self.lookup(hash)
+	if !ok {
+		return 0, false
+	}
+
+	data := make([]byte, 4)
+	entryLen := int64(self.hashLen + commitDataExtraLen)
+	// the generation number takes up the upper 30 bits of the 8 bytes after
+	// the tree hash and the two parent positions
+	offset := self.commitDataOffset + int64(position)*entryLen + int64(self.hashLen) + 8
+	if _, err := self.file.ReadAt(data, offset); err != nil {
+		return 0, false
+	}
+
+	return binary.BigEndian.Uint32(data) >> 2, true
+}
+
+// Binary search in the sorted list of hashes, within the range that the
+// fanout table gives us for the hash's first byte
+func (self *commitGraphFile) lookup(hash []byte) (uint32, bool) {
+	low := uint32(0)
+	if hash[0] > 0 {
+		low = self.fanout[hash[0]-1]
+	}
+	high := self.fanout[hash[0]]
+
+	candidate := make([]byte, self.hashLen)
+	for low < high {
+		mid := low + (high-low)/2
+		if _, err := self.file.ReadAt(candidate, self.oidLookupOffset+int64(mid)*int64(self.hashLen)); err != nil {
+			return 0, false
+		}
+		switch bytes.Compare(candidate, hash) {
+		case 0:
+			return mid, true
+		case -1:
+			low = mid + 1
+		default:
+			high = mid
+		}
+	}
+
+	return 0, false
+}
@@ -0,0 +1,142 @@
+package git_commands
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+// Writes a commit-graph file containing commits with the given generation
+// numbers and no parents
+func writeCommitGraphFile(t *testing.T, path string, generations map[string]uint32) {
+	t.Helper()
+
+	hashes := lo.Keys(generations)
+	slices.Sort(hashes)
+
+	const hashLen = 20
+	const headerLen = 8 + 4*12
+	fanoutOffset := headerLen
+	oidLookupOffset := fanoutOffset + 256*4
+	commitDataOffset := oidLookupOffset + len(hashes)*hashLen
+	endOffset := commitDataOffset + len(hashes)*(hashLen+commitDataExtraLen)
+
+	content := []byte{'C', 'G', 'P', 'H', 1, 1, 3, 0}
+	for _, chunk := range []struct {
+		id     string
+		offset int
+	}{{"OIDF", fanoutOffset}, {"OIDL", oidLookupOffset}, {"CDAT", commitDataOffset}, {"\x00\x00\x00\x00", endOffset}} {
+		content = append(content, chunk.id...)
+		content = binary.BigEndian.AppendUint64(content, uint64(chunk.offset))
+	}
+
+	var fanout [256]uint32
+	for _, hash := range hashes {
+		firstByte, err := hex.DecodeString(hash[:2])
+		assert.NoError(t, err)
+		for i := int(firstByte[0]); i < 256; i++ {
+			fanout[i]++
+		}
+	}
+	for _, count := range fanout {
+		content = binary.BigEndian.AppendUint32(content, count)
+	}
+
+	for _, hash := range hashes {
+		hashBytes, err := hex.DecodeString(hash)
+		assert.NoError(t, err)
+		content = append(content, hashBytes...)
+	}
+
+	for _, hash := range hashes {
+		// root tree
+		content = append(content, make([]byte, hashLen)...)
+		// no parents
+		content = binary.BigEndian.AppendUint32(content, 0x70000000)
+		content = binary.BigEndian.AppendUint32(content, 0x70000000)
+		// generation number and commit time
+		content = binary.BigEndian.AppendUint32(content, generations[hash]<<2)
+		content = binary.BigEndian.AppendUint32(content, 1640826609)
+	}
+
+	assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	assert.NoError(t, os.WriteFile(path, content, 0o644))
+}
+
+func TestCommitGraph(t *testing.T) {
+	hashA := "0eea75e8c631fba6b58135697835d58ba4c18dbc"
+	hashB := "b21997d6b4cbdf84b149d8e6a2c4d06a8e9ec164"
+	hashC := "e94e8fc5b6fab4cb755f29f1bdb3ee5e001df35c"
+	hashD := "d8084cd558925eb7c9c38afeed5725c21653ab90"
+	hashNotInGraph := "65f910ebd85283b5cce9bf67d03d3f1a9ea3813a"
+
+	objectsDir := filepath.Join(t.TempDir(), "objects")
+	writeCommitGraphFile(t, filepath.Join(objectsDir, "info", "commit-graph"),
+		map[string]uint32{hashA: 3, hashB: 2, hashC: 1, hashD: 0})
+
+	graph, err := OpenCommitGraph(objectsDir)
+	assert.NoError(t, err)
+	assert.NotNil(t, graph)
+	defer graph.Close()
+
+	generation, ok := graph.Generation(hashA)
+	assert.True(t, ok)
+	assert.Equal(t, uint32(3), generation)
+
+	generation, ok = graph.Generation(hashC)
+	assert.True(t, ok)
+	assert.Equal(t, uint32(1), generation)
+
+	// written without a generation number
+	_, ok = graph.Generation(hashD)
+	assert.False(t, ok)
+
+	_, ok = graph.Generation(hashNotInGraph)
+	assert.False(t, ok)
+
+	assert.True(t, graph.CannotBeAncestor(hashA, hashB))
+	assert.True(t, graph.CannotBeAncestor(hashA, hashA))
+	assert.False(t, graph.CannotBeAncestor(hashC, hashA))
+	assert.False(t, graph.CannotBeAncestor(hashA, hashD))
+	assert.False(t, graph.CannotBeAncestor(hashA, hashNotInGraph))
+}
+
+func TestCommitGraphChain(t *testing.T) {
+	hashA := "0eea75e8c631fba6b58135697835d58ba4c18dbc"
+	hashB := "b21997d6b4cbdf84b149d8e6a2c4d06a8e9ec164"
+
+	objectsDir := filepath.Join(t.TempDir(), "objects")
+	graphsDir := filepath.Join(objectsDir, "info", "commit-graphs")
+	writeCommitGraphFile(t, filepath.Join(graphsDir, "graph-1111.graph"), map[string]uint32{hashB: 1})
+	writeCommitGraphFile(t, filepath.Join(graphsDir, "graph-2222.graph"), map[string]uint32{hashA: 2})
+	assert.NoError(t, os.WriteFile(filepath.Join(graphsDir, "commit-graph-chain"), []byte("1111\n2222\n"), 0o644))
+
+	graph, err := OpenCommitGraph(objectsDir)
+	assert.NoError(t, err)
+	assert.NotNil(t, graph)
+	defer graph.Close()
+
+	assert.False(t, graph.CannotBeAncestor(hashB, hashA))
+	assert.True(t, graph.CannotBeAncestor(hashA, hashB))
+}
+
+func TestCommitGraphMissing(t *testing.T) {
+	graph, err := OpenCommitGraph(filepath.Join(t.TempDir(), "objects"))
+	assert.NoError(t, err)
+	assert.Nil(t, graph)
+}
+
+func TestCommitGraphInvalid(t *testing.T) {
+	objectsDir := filepath.Join(t.TempDir(), "objects")
+	assert.NoError(t, os.MkdirAll(filepath.Join(objectsDir, "info"), 0o755))
+	assert.NoError(t, os.WriteFile(filepath.Join(objectsDir, "info", "commit-graph"), []byte("garbage"), 0o644))
+
+	_, err := OpenCommitGraph(objectsDir)
+	assert.Error(t, err)
+}
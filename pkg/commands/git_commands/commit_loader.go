@@ -34,10 +34,35 @@ type CommitLoader struct {
 	getWorkingTreeState func() models.WorkingTreeState
 	readFile            func(filename string) ([]byte, error)
 	walkFiles           func(root string, fn filepath.WalkFunc) error
-	dotGitDir           string
+	// returns nil if the repo has no commit-graph
+	openCommitGraph func() *CommitGraph
+	dotGitDir       string
 	*GitCommon
+
+	// the result of the most recent incremental load; see
+	// loadPrependedCommits
+	lastIncrementalLoad      *incrementalLoad
+	lastIncrementalLoadMutex sync.Mutex
+}
+
+type incrementalLoad struct {
+	key     incrementalLoadKey
+	commits []*models.Commit
 }
 
+// The options that determine which commits we get; we can only reuse commits
+// that were loaded with the same ones. (We don't load incrementally when
+// filtering or showing the whole graph, see canLoadIncrementally, so those
+// options aren't needed here.)
+type incrementalLoadKey struct {
+	refName  string
+	limit    bool
+	logOrder string
+}
+
+// the number of commits that we load when limiting the log
+const commitLimit = 300
+
 // making our dependencies explicit for the sake of easier testing
 func NewCommitLoader(
 	cmn *common.Common,
@@ -45,7 +70,7 @@ func NewCommitLoader(
 	getWorkingTreeState func() models.WorkingTreeState,
 	gitCommon *GitCommon,
 ) *CommitLoader {
-	return &CommitLoader{
+	self := &CommitLoader{
 		Common:              cmn,
 		cmd:                 cmd,
 		getWorkingTreeState: getWorkingTreeState,
@@ -53,6 +78,15 @@ func NewCommitLoader(
 		walkFiles:           filepath.Walk,
 		GitCommon:           gitCommon,
 	}
+	self.openCommitGraph = func() *CommitGraph {
+		graph, err := OpenCommitGraph(filepath.Join(self.repoPaths.RepoGitDirPath(), "objects"))
+		if err != nil {
+			self.Log.Warnf("Failed to read commit-graph: %v", err)
+		}
+		return graph
+	}
+
+	return self
 }
 
 type GetCommitsOptions struct {
@@ -68,6 +102,10 @@ type GetCommitsOptions struct {
 	RefToShowDivergenceFrom string
 	MainBranches            *MainBranches
 	HashPool                *utils.StringPool
+	// If true, we remember the commits that we load, and the next time we're
+	// called with the same options we try to only load the commits that were
+	// added on top of them since then
+	Incremental bool
 }
 
 // GetCommits obtains the commits of the current branch
@@ -90,17 +128,21 @@ func (self *CommitLoader) GetCommits(opts GetCommitsOptions) ([]*models.Commit,
 	go utils.Safe(func() {
 		defer wg.Done()
 
-		var realCommits []*models.Commit
-		realCommits, logErr = loadCommits(self.getLogCmd(opts), opts.FilterPath, func(line string) (*models.Commit, bool) {
-			return self.extractCommitFromLine(opts.HashPool, line, opts.RefToShowDivergenceFrom != ""), false
-		})
+		realCommits, ok := self.loadPrependedCommits(opts)
+		if !ok {
+			realCommits, logErr = loadCommits(self.getLogCmd(opts), opts.FilterPath, func(line string) (*models.Commit, bool) {
+				return self.extractCommitFromLine(opts.HashPool, line, opts.RefToShowDivergenceFrom != ""), false
+			})
+		}
 		if logErr == nil {
+			self.rememberIncrementalLoad(opts, realCommits)
 			commits = append(commits, realCommits...)
 		}
 	})
 
 	var unmergedCommitHashes *set.Set[string]
 	var remoteUnmergedCommitHashes *set.Set[string]
+	var otherBranchCommitHashes *set.Set[string]
 	mainBranches := opts.MainBranches.Get()
 
 	go utils.Safe(func() {
@@ -112,6 +154,10 @@ func (self *CommitLoader) GetCommits(opts GetCommitsOptions) ([]*models.Commit,
 				remoteUnmergedCommitHashes = self.getReachableHashes(opts.RefToShowDivergenceFrom, mainBranches)
 			}
 		}
+
+		if opts.All {
+			otherBranchCommitHashes = self.getReachableHashes("--all", []string{opts.RefName})
+		}
 	})
 
 	var unpushedCommitHashes *set.Set[string]
@@ -149,6 +195,10 @@ func (self *CommitLoader) GetCommits(opts GetCommitsOptions) ([]*models.Commit,
 		setCommitStatuses(unpushedCommitHashes, unmergedCommitHashes, commits)
 	}
 
+	if otherBranchCommitHashes != nil {
+		setOtherBranchStatuses(otherBranchCommitHashes, commits)
+	}
+
 	return commits, nil
 }
 
@@ -285,23 +335,7 @@ func (self *CommitLoader) getHydratedTodoCommits(hashPool *utils.StringPool, tod
 
 	// note that we're not filtering these as we do non-rebasing commits just because
 	// I suspect that will cause some damage
-	cmdObj := self.cmd.New(
-		NewGitCmd("show").
-			Config("log.showSignature=false").
-			Arg("--no-patch", "--oneline", "--abbrev=20", prettyFormat).
-			Arg(commitHashes...).
-			ToArgv(),
-	).DontLog()
-
-	fullCommits := map[string]*models.Commit{}
-	err := cmdObj.RunAndProcessLines(func(line string) (bool, error) {
-		if line == "" || line[0] != '+' {
-			return false, nil
-		}
-		commit := self.extractCommitFromLine(hashPool, line[1:], false)
-		fullCommits[commit.Hash()] = commit
-		return false, nil
-	})
+	fullCommits, err := self.loadCommitsByHash(hashPool, commitHashes)
 	if err != nil {
 		return nil, err
 	}
@@ -332,6 +366,30 @@ func (self *CommitLoader) getHydratedTodoCommits(hashPool *utils.StringPool, tod
 	return hydratedCommits, nil
 }
 
+// Loads the given commits, keyed by their hash
+func (self *CommitLoader) loadCommitsByHash(hashPool *utils.StringPool, hashes []string) (map[string]*models.Commit, error) {
+	cmdObj := self.cmd.New(
+		NewGitCmd("show").
+			Config("log.showSignature=false").
+			Arg("--no-patch", "--oneline", "--abbrev=20", prettyFormat).
+			Arg(hashes...).
+			ToArgv(),
+	).DontLog()
+
+	commits := map[string]*models.Commit{}
+	err := cmdObj.RunAndProcessLines(func(line string) (bool, error) {
+		if line == "" || line[0] != '+' {
+			return false, nil
+		}
+		if commit := self.extractCommitFromLine(hashPool, line[1:], false); commit != nil {
+			commits[commit.Hash()] = commit
+		}
+		return false, nil
+	})
+
+	return commits, err
+}
+
 // getRebasingCommits obtains the commits that we're in the process of rebasing
 
 // git-rebase-todo example:
@@ -557,6 +615,16 @@ func setCommitStatuses(unpushedCommitHashes *set.Set[string], unmergedCommitHash
 	}
 }
 
+// When showing the whole git graph, marks the commits that are not reachable
+// from the checked-out ref so that we can tell them apart from our own ones.
+func setOtherBranchStatuses(otherBranchCommitHashes *set.Set[string], commits []*models.Commit) {
+	for _, commit := range commits {
+		if !commit.IsTODO() && otherBranchCommitHashes.Includes(commit.Hash()) {
+			commit.Status = models.StatusOtherBranch
+		}
+	}
+}
+
 func (self *CommitLoader) getReachableHashes(refName string, notRefNames []string) *set.Set[string] {
 	output, _, err := self.cmd.New(
 		NewGitCmd("rev-list").
@@ -575,6 +643,166 @@ func (self *CommitLoader) getReachableHashes(refName string, notRefNames []strin
 	return set.NewFromSlice(utils.SplitLines(output))
 }
 
+// Tries to load only the commits that were added on top of the ones that we
+// loaded last time with the same options, reusing the rest; in big repos this
+// is a lot faster than loading them all again. This only works if the new
+// commits form a linear chain on top of the previous head commit, because only
+// then do we know that they come before all previous commits in the log,
+// regardless of the log order. Returns false if that's not the case (e.g.
+// because nothing was added, a merge was made, or commits were rewritten), in
+// which case the caller needs to load all commits.
+func (self *CommitLoader) loadPrependedCommits(opts GetCommitsOptions) ([]*models.Commit, bool) {
+	previousCommits := self.previousCommits(opts)
+	if len(previousCommits) == 0 {
+		return nil, false
+	}
+	oldHead := previousCommits[0].Hash()
+
+	newHead, err := self.cmd.New(
+		NewGitCmd("rev-parse").Arg("--verify", "--quiet", opts.RefName+"^{commit}").ToArgv(),
+	).DontLog().RunWithOutput()
+	newHead = strings.TrimSpace(newHead)
+	// If nothing was added we still load everything, because refs might have
+	// been created or deleted, which we couldn't tell otherwise
+	if err != nil || newHead == oldHead {
+		return nil, false
+	}
+
+	// Walking from the new head to the old one could take a long time if the
+	// old head is not an ancestor of the new one (e.g. after a hard reset), so
+	// see if the commit-graph can rule this out first
+	if self.openCommitGraph != nil {
+		if graph := self.openCommitGraph(); graph != nil {
+			defer graph.Close()
+			if graph.CannotBeAncestor(oldHead, newHead) {
+				return nil, false
+			}
+		}
+	}
+
+	newCommits, err := loadCommits(self.getPrependedCommitsLogCmd(newHead, oldHead), "", func(line string) (*models.Commit, bool) {
+		return self.extractCommitFromLine(opts.HashPool, line, false), false
+	})
+	if err != nil || len(newCommits) == 0 || len(newCommits) > commitLimit {
+		return nil, false
+	}
+
+	for i, commit := range newCommits {
+		expectedParent := oldHead
+		if i+1 < len(newCommits) {
+			expectedParent = newCommits[i+1].Hash()
+		}
+		if parents := commit.Parents(); len(parents) != 1 || parents[0] != expectedParent {
+			return nil, false
+		}
+	}
+
+	reusedCommits, err := self.copyWithCurrentDecorations(opts.HashPool, previousCommits)
+	if err != nil {
+		return nil, false
+	}
+
+	commits := append(newCommits, reusedCommits...)
+	if opts.Limit {
+		commits = commits[:min(len(commits), commitLimit)]
+	}
+
+	return commits, true
+}
+
+// We don't load incrementally when filtering or showing the whole graph or a
+// divergence, because the commits we get then aren't necessarily on a linear
+// chain from the head commit
+func canLoadIncrementally(opts GetCommitsOptions) bool {
+	return opts.Incremental && opts.FilterPath == "" && opts.FilterAuthor == "" && !opts.All && opts.RefToShowDivergenceFrom == ""
+}
+
+func (self *CommitLoader) previousCommits(opts GetCommitsOptions) []*models.Commit {
+	if !canLoadIncrementally(opts) {
+		return nil
+	}
+
+	self.lastIncrementalLoadMutex.Lock()
+	defer self.lastIncrementalLoadMutex.Unlock()
+
+	if self.lastIncrementalLoad == nil || self.lastIncrementalLoad.key != self.incrementalLoadKey(opts) {
+		return nil
+	}
+
+	return self.lastIncrementalLoad.commits
+}
+
+func (self *CommitLoader) rememberIncrementalLoad(opts GetCommitsOptions, commits []*models.Commit) {
+	if !opts.Incremental {
+		return
+	}
+
+	self.lastIncrementalLoadMutex.Lock()
+	defer self.lastIncrementalLoadMutex.Unlock()
+
+	// Forget the previous commits when filtering, so that we don't mistake
+	// the filtered ones for them afterwards
+	if !canLoadIncrementally(opts) {
+		self.lastIncrementalLoad = nil
+		return
+	}
+
+	self.lastIncrementalLoad = &incrementalLoad{key: self.incrementalLoadKey(opts), commits: commits}
+}
+
+func (self *CommitLoader) incrementalLoadKey(opts GetCommitsOptions) incrementalLoadKey {
+	return incrementalLoadKey{
+		refName:  opts.RefName,
+		limit:    opts.Limit,
+		logOrder: self.UserConfig().Git.Log.Order,
+	}
+}
+
+// Returns copies of the given commits with up-to-date decorations; the ones
+// they had before might have moved to the new commits (e.g. the branch head).
+// We only need to look at commits that had decorations before, because
+// creating a tag or branch on an existing commit doesn't add any commits, so
+// we would have done a full load in that case.
+func (self *CommitLoader) copyWithCurrentDecorations(hashPool *utils.StringPool, commits []*models.Commit) ([]*models.Commit, error) {
+	decoratedHashes := lo.FilterMap(commits, func(commit *models.Commit, _ int) (string, bool) {
+		return commit.Hash(), commit.ExtraInfo != ""
+	})
+
+	currentCommits := map[string]*models.Commit{}
+	if len(decoratedHashes) > 0 {
+		var err error
+		currentCommits, err = self.loadCommitsByHash(hashPool, decoratedHashes)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return lo.Map(commits, func(commit *models.Commit, _ int) *models.Commit {
+		commitCopy := *commit
+		if currentCommit, ok := currentCommits[commit.Hash()]; ok {
+			commitCopy.Tags = currentCommit.Tags
+			commitCopy.ExtraInfo = currentCommit.ExtraInfo
+		}
+		return &commitCopy
+	}), nil
+}
+
+// The log command for loading the commits that were added on top of oldHead.
+// We load one more than we can use so that we can tell if there were too many.
+func (self *CommitLoader) getPrependedCommitsLogCmd(newHead string, oldHead string) *oscommands.CmdObj {
+	cmdArgs := NewGitCmd("log").
+		Arg(newHead, "^"+oldHead).
+		Arg("--oneline").
+		Arg(prettyFormat).
+		Arg("--abbrev=40").
+		Arg(fmt.Sprintf("-%d", commitLimit+1)).
+		Arg("--no-show-signature").
+		Arg("--").
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).DontLog()
+}
+
 // getLog gets the git log.
 func (self *CommitLoader) getLogCmd(opts GetCommitsOptions) *oscommands.CmdObj {
 	gitLogOrder := self.UserConfig().Git.Log.Order
@@ -592,7 +820,7 @@ func (self *CommitLoader) getLogCmd(opts GetCommitsOptions) *oscommands.CmdObj {
 		Arg(prettyFormat).
 		Arg("--abbrev=40").
 		ArgIf(opts.FilterAuthor != "", "--author="+opts.FilterAuthor).
-		ArgIf(opts.Limit, "-300").
+		ArgIf(opts.Limit, fmt.Sprintf("-%d", commitLimit)).
 		ArgIf(opts.FilterPath != "", "--follow", "--name-status").
 		Arg("--no-show-signature").
 		ArgIf(opts.RefToShowDivergenceFrom != "", "--left-right").
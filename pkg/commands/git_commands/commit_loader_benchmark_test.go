@@ -0,0 +1,143 @@
+package git_commands
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/common"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+// The number of commits on the main branch of the benchmark repo; every tenth
+// of them is a merge of a side branch
+const benchmarkRepoCommits = 20000
+
+// Creates a repo with a long history in a temp dir and changes into it. HEAD
+// points to the branch "base", which is one commit behind "master".
+func setupBenchmarkRepo(b *testing.B, writeCommitGraph bool) {
+	b.Helper()
+
+	if _, err := exec.LookPath("git"); err != nil {
+		b.Skip("git not found")
+	}
+
+	dir := b.TempDir()
+	b.Chdir(dir)
+
+	runGit := func(stdin string, args ...string) {
+		cmd := exec.Command("git", args...)
+		cmd.Stdin = strings.NewReader(stdin)
+		if output, err := cmd.CombinedOutput(); err != nil {
+			b.Fatalf("git %s failed: %v\n%s", strings.Join(args, " "), err, output)
+		}
+	}
+
+	var stream strings.Builder
+	writeCommit := func(ref string, mark int, timestamp int, from int, merge int) {
+		message := fmt.Sprintf("commit %d", mark)
+		fmt.Fprintf(&stream, "commit %s\nmark :%d\n", ref, mark)
+		fmt.Fprintf(&stream, "committer Jesse Duffield <jessedduffield@gmail.com> %d +0000\n", 1640000000+timestamp)
+		fmt.Fprintf(&stream, "data %d\n%s\n", len(message), message)
+		if from > 0 {
+			fmt.Fprintf(&stream, "from :%d\n", from)
+		}
+		if merge > 0 {
+			fmt.Fprintf(&stream, "merge :%d\n", merge)
+		}
+		fmt.Fprintf(&stream, "M 644 inline file\ndata %d\n%s\n\n", len(message), message)
+	}
+	for i := 1; i <= benchmarkRepoCommits; i++ {
+		sideMark := 0
+		if i%10 == 5 {
+			sideMark = benchmarkRepoCommits + i
+			writeCommit("refs/heads/side", sideMark, 2*i-1, i-3, 0)
+		}
+		writeCommit("refs/heads/master", i, 2*i, i-1, sideMark)
+	}
+
+	runGit("", "init", "--quiet")
+	runGit(stream.String(), "fast-import", "--quiet")
+	runGit("", "branch", "base", "master~1")
+	runGit("", "symbolic-ref", "HEAD", "refs/heads/base")
+	if writeCommitGraph {
+		runGit("", "commit-graph", "write", "--reachable")
+	}
+}
+
+func newBenchmarkCommitLoader(b *testing.B) *CommitLoader {
+	b.Helper()
+
+	cmn := common.NewDummyCommon()
+	cmn.UserConfig().Git.MainBranches = []string{}
+	cmd := oscommands.NewDummyOSCommand().Cmd
+
+	return NewCommitLoader(cmn, cmd,
+		func() models.WorkingTreeState { return models.WorkingTreeState{} },
+		&GitCommon{Common: cmn, cmd: cmd, repoPaths: MockRepoPaths(".")},
+	)
+}
+
+func benchmarkCommitLoaderOptions(loader *CommitLoader) GetCommitsOptions {
+	return GetCommitsOptions{
+		Limit:        true,
+		RefName:      "HEAD",
+		MainBranches: NewMainBranches(loader.Common, loader.cmd),
+		HashPool:     &utils.StringPool{},
+		Incremental:  true,
+	}
+}
+
+func benchmarkGetCommits(b *testing.B, writeCommitGraph bool) {
+	setupBenchmarkRepo(b, writeCommitGraph)
+	loader := newBenchmarkCommitLoader(b)
+	opts := benchmarkCommitLoaderOptions(loader)
+	opts.Incremental = false
+
+	for b.Loop() {
+		if _, err := loader.GetCommits(opts); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkGetCommits(b *testing.B) {
+	benchmarkGetCommits(b, false)
+}
+
+func BenchmarkGetCommitsWithCommitGraph(b *testing.B) {
+	benchmarkGetCommits(b, true)
+}
+
+func benchmarkGetCommitsIncrementally(b *testing.B, writeCommitGraph bool) {
+	setupBenchmarkRepo(b, writeCommitGraph)
+	loader := newBenchmarkCommitLoader(b)
+	opts := benchmarkCommitLoaderOptions(loader)
+
+	// load the commits of "base", then add a commit by switching to "master"
+	if _, err := loader.GetCommits(opts); err != nil {
+		b.Fatal(err)
+	}
+	previousLoad := loader.lastIncrementalLoad
+	if err := exec.Command("git", "symbolic-ref", "HEAD", "refs/heads/master").Run(); err != nil {
+		b.Fatal(err)
+	}
+
+	for b.Loop() {
+		loader.lastIncrementalLoad = previousLoad
+		if _, err := loader.GetCommits(opts); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkGetCommitsIncrementally(b *testing.B) {
+	benchmarkGetCommitsIncrementally(b, false)
+}
+
+func BenchmarkGetCommitsIncrementallyWithCommitGraph(b *testing.B) {
+	benchmarkGetCommitsIncrementally(b, true)
+}
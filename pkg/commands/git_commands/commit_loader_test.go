@@ -27,6 +27,9 @@ var commitsOutput = strings.ReplaceAll(`+0eea75e8c631fba6b58135697835d58ba4c18db
 
 var singleCommitOutput = strings.ReplaceAll(`+0eea75e8c631fba6b58135697835d58ba4c18dbc|1640826609|Jesse Duffield|jessedduffield@gmail.com|b21997d6b4cbdf84b149|>|HEAD -> better-tests|better typing for rebase mode`, "|", "\x00")
 
+var allBranchesCommitsOutput = strings.ReplaceAll(`+8f2c1b7e5a39d4c0e6b1f2a3c4d5e6f708192a3b|1640826700|Jesse Duffield|jessedduffield@gmail.com|b21997d6b4cbdf84b149|>|other-branch|other work
++0eea75e8c631fba6b58135697835d58ba4c18dbc|1640826609|Jesse Duffield|jessedduffield@gmail.com|b21997d6b4cbdf84b149|>|HEAD -> better-tests|better typing for rebase mode`, "|", "\x00")
+
 func TestGetCommits(t *testing.T) {
 	type scenario struct {
 		testName           string
@@ -283,6 +286,42 @@ func TestGetCommits(t *testing.T) {
 			expectedCommitOpts: []models.NewCommitOpts{},
 			expectedError:      nil,
 		},
+		{
+			testName: "should mark commits of other branches when showing the whole graph",
+			logOrder: "topo-order",
+			opts:     GetCommitsOptions{RefName: "HEAD", RefForPushedStatus: &models.Branch{Name: "mybranch"}, All: true},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"rev-list", "refs/heads/mybranch", "^mybranch@{u}"}, "", nil).
+				ExpectGitArgs([]string{"log", "HEAD", "--topo-order", "--all", "--oneline", "--pretty=format:+%H%x00%at%x00%aN%x00%ae%x00%P%x00%m%x00%D%x00%s", "--abbrev=40", "--no-show-signature", "--"}, allBranchesCommitsOutput, nil).
+				// here it's seeing which commits are not reachable from HEAD
+				ExpectGitArgs([]string{"rev-list", "--all", "^HEAD"}, "8f2c1b7e5a39d4c0e6b1f2a3c4d5e6f708192a3b\n", nil),
+
+			expectedCommitOpts: []models.NewCommitOpts{
+				{
+					Hash:          "8f2c1b7e5a39d4c0e6b1f2a3c4d5e6f708192a3b",
+					Name:          "other work",
+					Status:        models.StatusOtherBranch,
+					Action:        models.ActionNone,
+					ExtraInfo:     "(other-branch)",
+					AuthorName:    "Jesse Duffield",
+					AuthorEmail:   "jessedduffield@gmail.com",
+					UnixTimestamp: 1640826700,
+					Parents:       []string{"b21997d6b4cbdf84b149"},
+				},
+				{
+					Hash:          "0eea75e8c631fba6b58135697835d58ba4c18dbc",
+					Name:          "better typing for rebase mode",
+					Status:        models.StatusPushed,
+					Action:        models.ActionNone,
+					ExtraInfo:     "(HEAD -> better-tests)",
+					AuthorName:    "Jesse Duffield",
+					AuthorEmail:   "jessedduffield@gmail.com",
+					UnixTimestamp: 1640826609,
+					Parents:       []string{"b21997d6b4cbdf84b149"},
+				},
+			},
+			expectedError: nil,
+		},
 		{
 			testName: "should set filter path",
 			logOrder: "default",
@@ -333,6 +372,208 @@ func TestGetCommits(t *testing.T) {
 	}
 }
 
+func TestGetCommitsIncrementally(t *testing.T) {
+	hashA := "0eea75e8c631fba6b58135697835d58ba4c18dbc"
+	hashB := "b21997d6b4cbdf84b149d8e6a2c4d06a8e9ec164"
+	hashC := "e94e8fc5b6fab4cb755f29f1bdb3ee5e001df35c"
+	hashD := "d8084cd558925eb7c9c38afeed5725c21653ab90"
+	hashE := "65f910ebd85283b5cce9bf67d03d3f1a9ea3813a"
+
+	logLine := func(hash string, parents string, decorations string, message string) string {
+		return strings.Join([]string{"+" + hash, "1640826609", "Jesse Duffield", "jessedduffield@gmail.com", parents, ">", decorations, message}, "\x00")
+	}
+	commitOpts := func(hash string, parents []string, extraInfo string, message string) models.NewCommitOpts {
+		return models.NewCommitOpts{
+			Hash:          hash,
+			Name:          message,
+			Status:        models.StatusPushed,
+			Action:        models.ActionNone,
+			ExtraInfo:     extraInfo,
+			AuthorName:    "Jesse Duffield",
+			AuthorEmail:   "jessedduffield@gmail.com",
+			UnixTimestamp: 1640826609,
+			Parents:       parents,
+		}
+	}
+
+	logArgs := []string{"log", "HEAD", "--oneline", "--pretty=format:+%H%x00%at%x00%aN%x00%ae%x00%P%x00%m%x00%D%x00%s", "--abbrev=40", "--no-show-signature", "--"}
+	prependedLogArgs := func(newHead string, oldHead string) []string {
+		return []string{"log", newHead, "^" + oldHead, "--oneline", "--pretty=format:+%H%x00%at%x00%aN%x00%ae%x00%P%x00%m%x00%D%x00%s", "--abbrev=40", "-301", "--no-show-signature", "--"}
+	}
+	showArgs := func(hashes ...string) []string {
+		return append([]string{"-c", "log.showSignature=false", "show", "--no-patch", "--oneline", "--abbrev=20", "--pretty=format:+%H%x00%at%x00%aN%x00%ae%x00%P%x00%m%x00%D%x00%s"}, hashes...)
+	}
+
+	previousOutput := strings.Join([]string{
+		logLine(hashB, hashC, "HEAD -> master", "second"),
+		logLine(hashC, "", "", "first"),
+	}, "\n")
+
+	type scenario struct {
+		testName string
+		// the commit generations in the commit-graph, if any
+		commitGraph map[string]uint32
+		// the options of the first load, if different from opts
+		previousOpts       *GetCommitsOptions
+		opts               GetCommitsOptions
+		runner             *oscommands.FakeCmdObjRunner
+		expectedCommitOpts []models.NewCommitOpts
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "loads only the commits that were added",
+			opts:     GetCommitsOptions{RefName: "HEAD", Incremental: true},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs(logArgs, previousOutput, nil).
+				ExpectGitArgs([]string{"rev-parse", "--verify", "--quiet", "HEAD^{commit}"}, hashD+"\n", nil).
+				ExpectGitArgs(prependedLogArgs(hashD, hashB), strings.Join([]string{
+					logLine(hashD, hashA, "HEAD -> master", "fourth"),
+					logLine(hashA, hashB, "", "third"),
+				}, "\n"), nil).
+				// the branch head moved away from the previous head commit
+				ExpectGitArgs(showArgs(hashB), logLine(hashB, hashC, "", "second"), nil),
+			expectedCommitOpts: []models.NewCommitOpts{
+				commitOpts(hashD, []string{hashA}, "(HEAD -> master)", "fourth"),
+				commitOpts(hashA, []string{hashB}, "", "third"),
+				commitOpts(hashB, []string{hashC}, "", "second"),
+				commitOpts(hashC, []string{}, "", "first"),
+			},
+		},
+		{
+			testName: "loads all commits if none were added",
+			opts:     GetCommitsOptions{RefName: "HEAD", Incremental: true},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs(logArgs, previousOutput, nil).
+				ExpectGitArgs([]string{"rev-parse", "--verify", "--quiet", "HEAD^{commit}"}, hashB+"\n", nil).
+				ExpectGitArgs(logArgs, previousOutput, nil),
+			expectedCommitOpts: []models.NewCommitOpts{
+				commitOpts(hashB, []string{hashC}, "(HEAD -> master)", "second"),
+				commitOpts(hashC, []string{}, "", "first"),
+			},
+		},
+		{
+			testName: "loads all commits if a merge commit was added",
+			opts:     GetCommitsOptions{RefName: "HEAD", Incremental: true},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs(logArgs, previousOutput, nil).
+				ExpectGitArgs([]string{"rev-parse", "--verify", "--quiet", "HEAD^{commit}"}, hashD+"\n", nil).
+				ExpectGitArgs(prependedLogArgs(hashD, hashB), strings.Join([]string{
+					logLine(hashD, hashB+" "+hashE, "HEAD -> master", "merge"),
+					logLine(hashE, hashC, "", "other"),
+				}, "\n"), nil).
+				ExpectGitArgs(logArgs, strings.Join([]string{
+					logLine(hashD, hashB+" "+hashE, "HEAD -> master", "merge"),
+					logLine(hashB, hashC, "", "second"),
+					logLine(hashE, hashC, "", "other"),
+					logLine(hashC, "", "", "first"),
+				}, "\n"), nil),
+			expectedCommitOpts: []models.NewCommitOpts{
+				commitOpts(hashD, []string{hashB, hashE}, "(HEAD -> master)", "merge"),
+				commitOpts(hashB, []string{hashC}, "", "second"),
+				commitOpts(hashE, []string{hashC}, "", "other"),
+				commitOpts(hashC, []string{}, "", "first"),
+			},
+		},
+		{
+			testName:    "loads all commits if the commit-graph shows that the previous head is not an ancestor",
+			commitGraph: map[string]uint32{hashB: 2, hashC: 1, hashE: 2},
+			opts:        GetCommitsOptions{RefName: "HEAD", Incremental: true},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs(logArgs, previousOutput, nil).
+				ExpectGitArgs([]string{"rev-parse", "--verify", "--quiet", "HEAD^{commit}"}, hashE+"\n", nil).
+				ExpectGitArgs(logArgs, strings.Join([]string{
+					logLine(hashE, hashC, "HEAD -> master", "other"),
+					logLine(hashC, "", "", "first"),
+				}, "\n"), nil),
+			expectedCommitOpts: []models.NewCommitOpts{
+				commitOpts(hashE, []string{hashC}, "(HEAD -> master)", "other"),
+				commitOpts(hashC, []string{}, "", "first"),
+			},
+		},
+		{
+			testName:     "loads all commits after filtering",
+			previousOpts: &GetCommitsOptions{RefName: "HEAD", FilterPath: "file", Incremental: true},
+			opts:         GetCommitsOptions{RefName: "HEAD", Incremental: true},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"log", "HEAD", "--oneline", "--pretty=format:+%H%x00%at%x00%aN%x00%ae%x00%P%x00%m%x00%D%x00%s", "--abbrev=40", "--follow", "--name-status", "--no-show-signature", "--", "file"},
+					logLine(hashC, "", "", "first"), nil).
+				ExpectGitArgs(logArgs, previousOutput, nil),
+			expectedCommitOpts: []models.NewCommitOpts{
+				commitOpts(hashB, []string{hashC}, "(HEAD -> master)", "second"),
+				commitOpts(hashC, []string{}, "", "first"),
+			},
+		},
+		{
+			testName: "loads all commits if not loading incrementally",
+			opts:     GetCommitsOptions{RefName: "HEAD"},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs(logArgs, previousOutput, nil).
+				ExpectGitArgs(logArgs, previousOutput, nil),
+			expectedCommitOpts: []models.NewCommitOpts{
+				commitOpts(hashB, []string{hashC}, "(HEAD -> master)", "second"),
+				commitOpts(hashC, []string{}, "", "first"),
+			},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.testName, func(t *testing.T) {
+			common := common.NewDummyCommon()
+			common.UserConfig().Git.Log.Order = "default"
+			common.UserConfig().Git.MainBranches = []string{}
+			cmd := oscommands.NewDummyCmdObjBuilder(scenario.runner)
+
+			objectsDir := filepath.Join(t.TempDir(), "objects")
+			if scenario.commitGraph != nil {
+				writeCommitGraphFile(t, filepath.Join(objectsDir, "info", "commit-graph"), scenario.commitGraph)
+			}
+
+			builder := &CommitLoader{
+				Common:              common,
+				cmd:                 cmd,
+				getWorkingTreeState: func() models.WorkingTreeState { return models.WorkingTreeState{} },
+				dotGitDir:           ".git",
+				readFile: func(filename string) ([]byte, error) {
+					return []byte(""), nil
+				},
+				walkFiles: func(root string, fn filepath.WalkFunc) error {
+					return nil
+				},
+				openCommitGraph: func() *CommitGraph {
+					graph, err := OpenCommitGraph(objectsDir)
+					assert.NoError(t, err)
+					return graph
+				},
+			}
+
+			hashPool := &utils.StringPool{}
+
+			opts := scenario.opts
+			opts.MainBranches = NewMainBranches(common, cmd)
+			opts.HashPool = hashPool
+
+			previousOpts := opts
+			if scenario.previousOpts != nil {
+				previousOpts = *scenario.previousOpts
+				previousOpts.MainBranches = opts.MainBranches
+				previousOpts.HashPool = hashPool
+			}
+			_, err := builder.GetCommits(previousOpts)
+			assert.NoError(t, err)
+
+			commits, err := builder.GetCommits(opts)
+			assert.NoError(t, err)
+
+			expectedCommits := lo.Map(scenario.expectedCommitOpts,
+				func(opts models.NewCommitOpts, _ int) *models.Commit { return models.NewCommit(hashPool, opts) })
+			assert.Equal(t, expectedCommits, commits)
+
+			scenario.runner.CheckForMissingCalls()
+		})
+	}
+}
+
 func TestCommitLoader_getConflictedCommitImpl(t *testing.T) {
 	hashPool := &utils.StringPool{}
 
@@ -1,6 +1,7 @@
 package git_commands
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
@@ -390,6 +391,47 @@ func TestGetCommitMsg(t *testing.T) {
 	}
 }
 
+func TestGetCommitHash(t *testing.T) {
+	type scenario struct {
+		testName       string
+		revision       string
+		runner         *oscommands.FakeCmdObjRunner
+		expectedOutput string
+		expectedErr    string
+	}
+	scenarios := []scenario{
+		{
+			testName: "valid revision",
+			revision: "abc123",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"rev-parse", "--verify", "--quiet", "--end-of-options", "abc123^{commit}"}, "abc123def456\n", nil),
+			expectedOutput: "abc123def456",
+		},
+		{
+			testName: "invalid revision",
+			revision: "nope",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"rev-parse", "--verify", "--quiet", "--end-of-options", "nope^{commit}"}, "", errors.New("error")),
+			expectedErr: "'nope' is not a commit",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildCommitCommands(commonDeps{runner: s.runner})
+
+			output, err := instance.GetCommitHash(s.revision)
+			if s.expectedErr != "" {
+				assert.EqualError(t, err, s.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, s.expectedOutput, output)
+			}
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
+
 func TestGetCommitMessageFromHistory(t *testing.T) {
 	type scenario struct {
 		testName string
@@ -167,3 +167,15 @@ func buildFlowCommands(deps commonDeps) *FlowCommands {
 
 	return NewFlowCommands(gitCommon)
 }
+
+func buildTagCommands(deps commonDeps) *TagCommands {
+	gitCommon := buildGitCommon(deps)
+
+	return NewTagCommands(gitCommon)
+}
+
+func buildRemoteCommands(deps commonDeps) *RemoteCommands {
+	gitCommon := buildGitCommon(deps)
+
+	return NewRemoteCommands(gitCommon)
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/samber/lo"
 )
 
 type FileLoaderConfig interface {
@@ -36,21 +37,53 @@ type GetStatusFileOptions struct {
 	// This is useful for users with bare repos for dotfiles who default to hiding untracked files,
 	// but want to occasionally see them to `git add` a new file.
 	ForceShowUntracked bool
+	// One of "no", "normal" or "all"; if empty, we use DefaultUntrackedFilesMode
+	UntrackedFilesMode string
+}
+
+// Returns which untracked files to show if the user hasn't chosen otherwise:
+// one of "no", "normal" or "all"
+func (self *FileLoader) DefaultUntrackedFilesMode() string {
+	if mode := self.UserConfig().Git.Status.UntrackedFiles; mode != "" {
+		return mode
+	}
+
+	// check if the git config wants us ignoring untracked files
+	if mode := self.config.GetShowUntrackedFiles(); mode != "" {
+		return mode
+	}
+
+	return "all"
 }
 
 func (self *FileLoader) GetStatusFiles(opts GetStatusFileOptions) []*models.File {
-	// check if config wants us ignoring untracked files
-	untrackedFilesSetting := self.config.GetShowUntrackedFiles()
+	untrackedFilesMode := opts.UntrackedFilesMode
+	if untrackedFilesMode == "" {
+		untrackedFilesMode = self.DefaultUntrackedFilesMode()
+	}
+	// We don't override "normal" here, because you might have chosen it so as
+	// not to list the files of huge untracked directories
+	if opts.ForceShowUntracked && untrackedFilesMode == "no" {
+		untrackedFilesMode = "all"
+	}
 
-	if opts.ForceShowUntracked || untrackedFilesSetting == "" {
-		untrackedFilesSetting = "all"
+	// If there's a limit on the number of untracked files, we get the
+	// untracked directories first and then list their files ourselves, so that
+	// we can stop when there are too many
+	maxUntrackedFiles := self.UserConfig().Git.Status.MaxUntrackedFiles
+	limitUntrackedFiles := untrackedFilesMode == "all" && maxUntrackedFiles > 0
+	if limitUntrackedFiles {
+		untrackedFilesMode = "normal"
 	}
-	untrackedFilesArg := fmt.Sprintf("--untracked-files=%s", untrackedFilesSetting)
+	untrackedFilesArg := fmt.Sprintf("--untracked-files=%s", untrackedFilesMode)
 
 	statuses, err := self.gitStatus(GitStatusOptions{NoRenames: opts.NoRenames, UntrackedFilesArg: untrackedFilesArg})
 	if err != nil {
 		self.Log.Error(err)
 	}
+	if limitUntrackedFiles {
+		statuses = self.expandUntrackedDirs(statuses, maxUntrackedFiles)
+	}
 	files := []*models.File{}
 
 	fileDiffs := map[string]FileDiff{}
@@ -78,6 +111,14 @@ func (self *FileLoader) GetStatusFiles(opts GetStatusFileOptions) []*models.File
 			file.LinesDeleted = diff.LinesDeleted
 		}
 
+		// git lists untracked directories (and linked worktrees) with a trailing
+		// slash when it doesn't list the files in them. If we kept the slash,
+		// they would be rendered as a folder with a null file inside.
+		if isUntrackedDir(status) {
+			file.Path = strings.TrimSuffix(file.Path, "/")
+			file.IsUntrackedDirectory = true
+		}
+
 		models.SetStatusFields(file, status.Change)
 		files = append(files, file)
 	}
@@ -94,9 +135,7 @@ func (self *FileLoader) GetStatusFiles(opts GetStatusFileOptions) []*models.File
 			}
 			if absFilePath == worktreePath {
 				file.IsWorktree = true
-				// `git status` renders this worktree as a folder with a trailing slash but we'll represent it as a singular worktree
-				// If we include the slash, it will be rendered as a folder with a null file inside.
-				file.Path = strings.TrimSuffix(file.Path, "/")
+				file.IsUntrackedDirectory = false
 				break
 			}
 		}
@@ -105,6 +144,94 @@ func (self *FileLoader) GetStatusFiles(opts GetStatusFileOptions) []*models.File
 	return files
 }
 
+func isUntrackedDir(status FileStatus) bool {
+	return status.Change == "??" && strings.HasSuffix(status.Path, "/")
+}
+
+// Replaces the untracked directories in the given statuses, which we got with
+// --untracked-files=normal, with the untracked files in them, unless there are
+// more than maxFiles of those altogether; in that case we keep the directories.
+// We stop listing files as soon as there are too many, so that we don't have
+// to wait for huge directories to be walked completely.
+func (self *FileLoader) expandUntrackedDirs(statuses []FileStatus, maxFiles int) []FileStatus {
+	dirs := lo.FilterMap(statuses, func(status FileStatus, _ int) (string, bool) {
+		return status.Path, isUntrackedDir(status)
+	})
+	if len(dirs) == 0 {
+		return statuses
+	}
+
+	filesByDir := make(map[string][]FileStatus, len(dirs))
+	for _, dir := range dirs {
+		filesByDir[dir] = nil
+	}
+
+	fileCount := 0
+	err := self.cmd.New(untrackedFilesCmdArgs(dirs)).DontLog().RunAndProcessLines(func(line string) (bool, error) {
+		if line == "" {
+			return false, nil
+		}
+
+		fileCount++
+		if fileCount > maxFiles {
+			return true, nil
+		}
+
+		path := unquotePath(line)
+		// find the untracked directory that the file is in
+		for i := range len(path) {
+			if path[i] != '/' {
+				continue
+			}
+			if files, ok := filesByDir[path[:i+1]]; ok {
+				filesByDir[path[:i+1]] = append(files, FileStatus{
+					StatusString: "?? " + path,
+					Change:       "??",
+					Path:         path,
+				})
+				break
+			}
+		}
+		return false, nil
+	})
+	if err != nil {
+		self.Log.Error(err)
+		return statuses
+	}
+	if fileCount > maxFiles {
+		return statuses
+	}
+
+	return lo.FlatMap(statuses, func(status FileStatus, _ int) []FileStatus {
+		if files := filesByDir[status.Path]; isUntrackedDir(status) && len(files) > 0 {
+			return files
+		}
+		return []FileStatus{status}
+	})
+}
+
+// Lists the untracked files in the given directories, one per line. (Nested
+// repos are listed as directories, with a trailing slash.)
+func untrackedFilesCmdArgs(dirs []string) []string {
+	return NewGitCmd("ls-files").
+		Config("core.quotePath=false").
+		Arg("--others", "--exclude-standard", "--").
+		Arg(dirs...).
+		ToArgv()
+}
+
+// git quotes paths containing special characters such as newlines, using C
+// escape sequences, which Go understands too
+func unquotePath(path string) string {
+	if strings.HasPrefix(path, "\"") {
+		if unquoted, err := strconv.Unquote(path); err == nil {
+			return unquoted
+		}
+	}
+
+	return path
+}
+
 type FileDiff struct {
 	LinesAdded   int
 	LinesDeleted int
@@ -168,7 +295,10 @@ func (self *FileLoader) gitDiffNumStat() (string, error) {
 }
 
 func (self *FileLoader) gitStatus(opts GitStatusOptions) ([]FileStatus, error) {
+	statusConfig := self.UserConfig().Git.Status
 	cmdArgs := NewGitCmd("status").
+		ConfigIf(statusConfig.UseFsmonitor && self.canUseFsmonitor(), "core.fsmonitor=true").
+		ConfigIf(statusConfig.UseUntrackedCache, "core.untrackedCache=true").
 		Arg(opts.UntrackedFilesArg).
 		Arg("--porcelain").
 		Arg("-z").
@@ -213,3 +343,8 @@ func (self *FileLoader) gitStatus(opts GitStatusOptions) ([]FileStatus, error) {
 
 	return response, nil
 }
+
+// git's builtin file system monitor only exists on macOS and Windows
+func (self *FileLoader) canUseFsmonitor() bool {
+	return (self.os.Platform.OS == "darwin" || self.os.Platform.OS == "windows") && self.version.IsAtLeast(2, 37, 0)
+}
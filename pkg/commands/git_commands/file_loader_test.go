@@ -15,9 +15,35 @@ func TestFileGetStatusFiles(t *testing.T) {
 		similarityThreshold    int
 		runner                 oscommands.ICmdObjRunner
 		showNumstatInFilesView bool
+		statusConfig           config.StatusConfig
+		gitVersion             *GitVersion
+		opts                   GetStatusFileOptions
 		expectedFiles          []*models.File
 	}
 
+	untrackedFile := func(path string) *models.File {
+		return &models.File{
+			Path:               path,
+			HasUnstagedChanges: true,
+			Added:              true,
+			DisplayString:      "?? " + path,
+			ShortStatus:        "??",
+		}
+	}
+	untrackedDir := func(path string) *models.File {
+		return &models.File{
+			Path:                 path,
+			HasUnstagedChanges:   true,
+			Added:                true,
+			DisplayString:        "?? " + path + "/",
+			ShortStatus:          "??",
+			IsUntrackedDirectory: true,
+		}
+	}
+	lsFilesArgs := func(dirs ...string) []string {
+		return append([]string{"-c", "core.quotePath=false", "ls-files", "--others", "--exclude-standard", "--"}, dirs...)
+	}
+
 	scenarios := []scenario{
 		{
 			testName:            "No files found",
@@ -229,6 +255,83 @@ func TestFileGetStatusFiles(t *testing.T) {
 				},
 			},
 		},
+		{
+			testName:            "Untracked files mode from config",
+			similarityThreshold: 50,
+			statusConfig:        config.StatusConfig{UntrackedFiles: "no"},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"status", "--untracked-files=no", "--porcelain", "-z", "--find-renames=50%"}, "", nil),
+			expectedFiles: []*models.File{},
+		},
+		{
+			testName:            "Untracked files mode from options",
+			similarityThreshold: 50,
+			statusConfig:        config.StatusConfig{UntrackedFiles: "no"},
+			opts:                GetStatusFileOptions{UntrackedFilesMode: "normal"},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"status", "--untracked-files=normal", "--porcelain", "-z", "--find-renames=50%"},
+					"?? dir/\x00?? file.txt", nil),
+			expectedFiles: []*models.File{
+				untrackedDir("dir"),
+				untrackedFile("file.txt"),
+			},
+		},
+		{
+			testName:            "Force showing untracked files",
+			similarityThreshold: 50,
+			statusConfig:        config.StatusConfig{UntrackedFiles: "no"},
+			opts:                GetStatusFileOptions{ForceShowUntracked: true},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"status", "--untracked-files=all", "--porcelain", "-z", "--find-renames=50%"}, "", nil),
+			expectedFiles: []*models.File{},
+		},
+		{
+			testName:            "Untracked directories with fewer files than the limit",
+			similarityThreshold: 50,
+			statusConfig:        config.StatusConfig{UntrackedFiles: "all", MaxUntrackedFiles: 3},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"status", "--untracked-files=normal", "--porcelain", "-z", "--find-renames=50%"},
+					"?? dir/\x00?? file.txt\x00?? other/", nil).
+				ExpectGitArgs(lsFilesArgs("dir/", "other/"), "dir/a.txt\ndir/sub/b.txt\n\"other/new\\nline\"\n", nil),
+			expectedFiles: []*models.File{
+				untrackedFile("dir/a.txt"),
+				untrackedFile("dir/sub/b.txt"),
+				untrackedFile("file.txt"),
+				untrackedFile("other/new\nline"),
+			},
+		},
+		{
+			testName:            "Untracked directories with more files than the limit",
+			similarityThreshold: 50,
+			statusConfig:        config.StatusConfig{UntrackedFiles: "all", MaxUntrackedFiles: 2},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"status", "--untracked-files=normal", "--porcelain", "-z", "--find-renames=50%"},
+					"?? dir/\x00?? file.txt\x00?? other/", nil).
+				ExpectGitArgs(lsFilesArgs("dir/", "other/"), "dir/a.txt\ndir/sub/b.txt\nother/c.txt\n", nil),
+			expectedFiles: []*models.File{
+				untrackedDir("dir"),
+				untrackedFile("file.txt"),
+				untrackedDir("other"),
+			},
+		},
+		{
+			testName:            "Using the file system monitor and the untracked cache",
+			similarityThreshold: 50,
+			statusConfig:        config.StatusConfig{UseFsmonitor: true, UseUntrackedCache: true},
+			gitVersion:          &GitVersion{2, 37, 0, ""},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"-c", "core.untrackedCache=true", "-c", "core.fsmonitor=true", "status", "--untracked-files=yes", "--porcelain", "-z", "--find-renames=50%"}, "", nil),
+			expectedFiles: []*models.File{},
+		},
+		{
+			testName:            "File system monitor not supported by git version",
+			similarityThreshold: 50,
+			statusConfig:        config.StatusConfig{UseFsmonitor: true},
+			gitVersion:          &GitVersion{2, 36, 0, ""},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"status", "--untracked-files=yes", "--porcelain", "-z", "--find-renames=50%"}, "", nil),
+			expectedFiles: []*models.File{},
+		},
 	}
 
 	for _, s := range scenarios {
@@ -238,15 +341,16 @@ func TestFileGetStatusFiles(t *testing.T) {
 			userConfig := &config.UserConfig{}
 			userConfig.Gui.ShowNumstatInFilesView = s.showNumstatInFilesView
 			userConfig.Git.RenameSimilarityThreshold = s.similarityThreshold
+			userConfig.Git.Status = s.statusConfig
 
 			loader := &FileLoader{
-				GitCommon:   buildGitCommon(commonDeps{appState: &config.AppState{}, userConfig: userConfig}),
+				GitCommon:   buildGitCommon(commonDeps{appState: &config.AppState{}, userConfig: userConfig, gitVersion: s.gitVersion}),
 				cmd:         cmd,
 				config:      &FakeFileLoaderConfig{showUntrackedFiles: "yes"},
 				getFileType: func(string) string { return "file" },
 			}
 
-			assert.EqualValues(t, s.expectedFiles, loader.GetStatusFiles(GetStatusFileOptions{}))
+			assert.EqualValues(t, s.expectedFiles, loader.GetStatusFiles(s.opts))
 		})
 	}
 }
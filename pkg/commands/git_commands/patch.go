@@ -2,7 +2,10 @@ package git_commands
 
 import (
 	"fmt"
+	"os"
 	"path/filepath"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/go-errors/errors"
@@ -45,6 +48,13 @@ type ApplyPatchOpts struct {
 	Cached   bool
 	Index    bool
 	Reverse  bool
+	// Needed for patches without context lines
+	UnidiffZero bool
+	// Recalculate the line counts in the hunk headers, for patches that were
+	// edited by hand
+	Recount bool
+	// Only check whether the patch applies, without applying it
+	Check bool
 }
 
 func (self *PatchCommands) ApplyCustomPatch(reverse bool, turnAddedFilesIntoDiffAgainstEmptyFile bool) error {
@@ -58,6 +68,14 @@ func (self *PatchCommands) ApplyCustomPatch(reverse bool, turnAddedFilesIntoDiff
 }
 
 func (self *PatchCommands) ApplyPatch(patch string, opts ApplyPatchOpts) error {
+	if opts.Check {
+		// Checks are typically run repeatedly in the background (e.g. while
+		// typing), so rather than saving the patch to a temporary file each
+		// time we pass it on stdin. We also don't want them to clutter the
+		// command log.
+		return self.cmd.New(applyPatchCmd(opts).ToArgv()).SetStdin(patch).DontLog().Run()
+	}
+
 	filepath, err := self.SaveTemporaryPatch(patch)
 	if err != nil {
 		return err
@@ -67,15 +85,18 @@ func (self *PatchCommands) ApplyPatch(patch string, opts ApplyPatchOpts) error {
 }
 
 func (self *PatchCommands) applyPatchFile(filepath string, opts ApplyPatchOpts) error {
-	cmdArgs := NewGitCmd("apply").
+	return self.cmd.New(applyPatchCmd(opts).Arg(filepath).ToArgv()).Run()
+}
+
+func applyPatchCmd(opts ApplyPatchOpts) *GitCommandBuilder {
+	return NewGitCmd("apply").
 		ArgIf(opts.ThreeWay, "--3way").
 		ArgIf(opts.Cached, "--cached").
 		ArgIf(opts.Index, "--index").
 		ArgIf(opts.Reverse, "--reverse").
-		Arg(filepath).
-		ToArgv()
-
-	return self.cmd.New(cmdArgs).Run()
+		ArgIf(opts.UnidiffZero, "--unidiff-zero").
+		ArgIf(opts.Recount, "--recount").
+		ArgIf(opts.Check, "--check")
 }
 
 func (self *PatchCommands) SaveTemporaryPatch(patch string) (string, error) {
@@ -87,6 +108,132 @@ func (self *PatchCommands) SaveTemporaryPatch(patch string) (string, error) {
 	return filepath, nil
 }
 
+// A custom patch that the user saved under a name
+type SavedPatch struct {
+	Name string
+	// the commit (or stash entry) whose changes the patch was built from
+	To      string
+	ModTime time.Time
+}
+
+// The first line of a saved patch file records what the patch was built
+// from; git apply ignores anything before the first diff header, so the file
+// can still be applied as is.
+const savedPatchToPrefix = "lazygit-patch-to: "
+
+// Saved patches live in the git dir, so they are shared between all
+// worktrees of the repo
+func (self *PatchCommands) savedPatchesDir() string {
+	return filepath.Join(self.repoPaths.RepoGitDirPath(), "lazygit", "patches")
+}
+
+func (self *PatchCommands) savedPatchPath(name string) string {
+	return filepath.Join(self.savedPatchesDir(), name+".patch")
+}
+
+func (self *PatchCommands) SavedPatchExists(name string) bool {
+	_, err := os.Stat(self.savedPatchPath(name))
+	return err == nil
+}
+
+// Saves the current custom patch under the given name
+func (self *PatchCommands) SaveCustomPatch(name string) error {
+	content := savedPatchToPrefix + self.PatchBuilder.To + "\n\n" + self.PatchBuilder.RenderAggregatedPatch(true)
+	return self.os.CreateFileWithContent(self.savedPatchPath(name), content)
+}
+
+// Returns the saved patches, most recently saved first
+func (self *PatchCommands) GetSavedPatches() ([]*SavedPatch, error) {
+	entries, err := os.ReadDir(self.savedPatchesDir())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []*SavedPatch{}, nil
+		}
+		return nil, err
+	}
+
+	savedPatches := []*SavedPatch{}
+	for _, entry := range entries {
+		name, ok := strings.CutSuffix(entry.Name(), ".patch")
+		if !ok || entry.IsDir() {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return nil, err
+		}
+		content, err := os.ReadFile(self.savedPatchPath(name))
+		if err != nil {
+			return nil, err
+		}
+		firstLine, _, _ := strings.Cut(string(content), "\n")
+		to, _ := strings.CutPrefix(firstLine, savedPatchToPrefix)
+		savedPatches = append(savedPatches, &SavedPatch{Name: name, To: to, ModTime: info.ModTime()})
+	}
+
+	sort.SliceStable(savedPatches, func(i, j int) bool {
+		return savedPatches[i].ModTime.After(savedPatches[j].ModTime)
+	})
+	return savedPatches, nil
+}
+
+// Adds the changes of the saved patch with the given name to the custom
+// patch. Returns the paths of files whose changes couldn't all be added, e.g.
+// because the patch was saved for a different commit.
+func (self *PatchCommands) LoadSavedPatch(name string) ([]string, error) {
+	return self.ImportPatchFile(self.savedPatchPath(name))
+}
+
+// Writes the custom patch to a file that can be applied with `git apply`
+func (self *PatchCommands) ExportCustomPatch(path string) error {
+	return self.os.CreateFileWithContent(path, self.PatchBuilder.RenderAggregatedPatch(true))
+}
+
+// Adds the changes of the given patch file to the custom patch. Returns the
+// paths of files whose changes couldn't all be added.
+func (self *PatchCommands) ImportPatchFile(path string) ([]string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return self.PatchBuilder.AddPatch(string(content))
+}
+
+// Applies the custom patch onto the given branch and commits it there. This is
+// done in a temporary worktree so that the working tree of the current branch
+// is left alone; as a consequence, the branch must not be checked out in any
+// worktree.
+func (self *PatchCommands) CommitPatchOnBranch(branchName string, summary string, description string) error {
+	worktreePath, err := os.MkdirTemp(self.os.GetTempDir(), "patch-worktree-")
+	if err != nil {
+		return err
+	}
+	// git worktree add wants to create the directory itself
+	if err := os.Remove(worktreePath); err != nil {
+		return err
+	}
+
+	if err := self.cmd.New(NewGitCmd("worktree").Arg("add", worktreePath, branchName).ToArgv()).Run(); err != nil {
+		return err
+	}
+	defer func() {
+		_ = self.cmd.New(NewGitCmd("worktree").Arg("remove", "--force", worktreePath).ToArgv()).Run()
+	}()
+
+	patchPath, err := self.SaveTemporaryPatch(self.PatchBuilder.PatchToApply(false, true))
+	if err != nil {
+		return err
+	}
+
+	if err := self.cmd.New(NewGitCmd("apply").Arg("--index", "--3way", patchPath).ToArgv()).
+		SetWd(worktreePath).Run(); err != nil {
+		return err
+	}
+
+	return self.commit.CommitCmdObj(summary, description, false).SetWd(worktreePath).Run()
+}
+
 // DeletePatchesFromCommit applies a patch in reverse for a commit
 func (self *PatchCommands) DeletePatchesFromCommit(commits []*models.Commit, commitIndex int) error {
 	if err := self.rebase.BeginInteractiveRebaseForCommit(commits, commitIndex, false); err != nil {
@@ -338,6 +485,54 @@ func (self *PatchCommands) PullPatchIntoNewCommitBefore(
 	return self.rebase.ContinueRebase()
 }
 
+// One of the commits that a commit is split into. Patch contains the part of
+// the original commit's changes that go into this commit.
+type CommitSplitPart struct {
+	Patch       string
+	Summary     string
+	Description string
+}
+
+// Replaces the given commit with one new commit per part, in the given order.
+// The patches of all parts together must make up the whole commit, so that
+// the final tree is unchanged. The new commits keep the original author.
+func (self *PatchCommands) SplitCommit(commits []*models.Commit, commitIdx int, parts []CommitSplitPart) error {
+	commit := commits[commitIdx]
+	if err := self.rebase.BeginInteractiveRebaseForCommit(commits, commitIdx, false); err != nil {
+		return err
+	}
+
+	// Keep the commit's changes in the working tree, but remove them from the
+	// index so that we can add them back part by part
+	if err := self.cmd.New(NewGitCmd("reset").Arg("--mixed", "HEAD^").ToArgv()).Run(); err != nil {
+		_ = self.rebase.AbortRebase()
+		return err
+	}
+
+	authorEnvVars := []string{
+		"GIT_AUTHOR_NAME=" + commit.AuthorName,
+		"GIT_AUTHOR_EMAIL=" + commit.AuthorEmail,
+		fmt.Sprintf("GIT_AUTHOR_DATE=@%d", commit.UnixTimestamp),
+	}
+	for _, part := range parts {
+		if err := self.ApplyPatch(part.Patch, ApplyPatchOpts{Cached: true}); err != nil {
+			_ = self.rebase.AbortRebase()
+			return err
+		}
+
+		if err := self.commit.CommitCmdObj(part.Summary, part.Description, false).AddEnvVars(authorEnvVars...).Run(); err != nil {
+			_ = self.rebase.AbortRebase()
+			return err
+		}
+	}
+
+	if self.rebase.onSuccessfulContinue != nil {
+		return errors.New("You are midway through another rebase operation. Please abort to start again")
+	}
+
+	return self.rebase.ContinueRebase()
+}
+
 // We have just applied a patch in reverse to discard it from a commit; if we
 // now try to apply the patch again to move it to a later commit, or to the
 // index, then this would conflict "with itself" in case the patch contained
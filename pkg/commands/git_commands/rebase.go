@@ -206,6 +206,9 @@ type PrepareInteractiveRebaseCommandOpts struct {
 	instruction                daemon.Instruction
 	overrideEditor             bool
 	keepCommitsThatBecomeEmpty bool
+	updateRefs                 bool
+	// If set, this branch is checked out before rebasing it
+	branch string
 }
 
 // PrepareInteractiveRebaseCommand returns the cmd for an interactive rebase
@@ -221,8 +224,10 @@ func (self *RebaseCommands) PrepareInteractiveRebaseCommand(opts PrepareInteract
 		ArgIf(opts.keepCommitsThatBecomeEmpty, "--empty=keep").
 		Arg("--no-autosquash").
 		Arg("--rebase-merges").
+		ArgIf(opts.updateRefs, "--update-refs").
 		ArgIf(opts.onto != "", "--onto", opts.onto).
 		Arg(opts.baseHashOrRoot).
+		ArgIf(opts.branch != "", opts.branch).
 		ToArgv()
 
 	debug := "FALSE"
@@ -461,6 +466,17 @@ func (self *RebaseCommands) RebaseBranchFromBaseCommit(targetBranchName string,
 	}).Run()
 }
 
+// Rebases a stack of branches onto the given ref. topBranchName is the topmost
+// branch of the stack; it is checked out, and all branches below it are
+// updated along with it.
+func (self *RebaseCommands) RebaseBranchStack(topBranchName string, ref string) error {
+	return self.PrepareInteractiveRebaseCommand(PrepareInteractiveRebaseCommandOpts{
+		baseHashOrRoot: ref,
+		branch:         topBranchName,
+		updateRefs:     true,
+	}).Run()
+}
+
 func (self *RebaseCommands) GenericMergeOrRebaseActionCmdObj(commandType string, command string) *oscommands.CmdObj {
 	cmdArgs := NewGitCmd(commandType).Arg("--" + command).ToArgv()
 
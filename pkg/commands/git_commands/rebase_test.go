@@ -65,6 +65,15 @@ func TestRebaseRebaseBranch(t *testing.T) {
 	}
 }
 
+func TestRebaseRebaseBranchStack(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"rebase", "--interactive", "--autostash", "--keep-empty", "--no-autosquash", "--rebase-merges", "--update-refs", "refs/remotes/origin/master", "feature-c"}, "", nil)
+	instance := buildRebaseCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.RebaseBranchStack("feature-c", "refs/remotes/origin/master"))
+	runner.CheckForMissingCalls()
+}
+
 // TestRebaseSkipEditorCommand confirms that SkipEditorCommand injects
 // environment variables that suppress an interactive editor
 func TestRebaseSkipEditorCommand(t *testing.T) {
@@ -2,9 +2,11 @@ package git_commands
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/jesseduffield/gocui"
+	"github.com/samber/lo"
 )
 
 type RemoteCommands struct {
@@ -49,6 +51,95 @@ func (self *RemoteCommands) UpdateRemoteUrl(remoteName string, updatedUrl string
 	return self.cmd.New(cmdArgs).Run()
 }
 
+// Replaces one of the push URLs of the remote, leaving its other push URLs
+// and its fetch URL untouched
+func (self *RemoteCommands) UpdateRemotePushUrl(remoteName string, oldUrl string, updatedUrl string) error {
+	cmdArgs := NewGitCmd("remote").
+		Arg("set-url", "--push", remoteName, updatedUrl, urlPattern(oldUrl)).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+// Adds a push URL to the remote. Once a remote has push URLs, pushing goes to
+// all of them rather than to the fetch URL.
+func (self *RemoteCommands) AddRemotePushUrl(remoteName string, url string) error {
+	cmdArgs := NewGitCmd("remote").
+		Arg("set-url", "--add", "--push", remoteName, url).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+func (self *RemoteCommands) RemoveRemotePushUrl(remoteName string, url string) error {
+	cmdArgs := NewGitCmd("remote").
+		Arg("set-url", "--delete", "--push", remoteName, urlPattern(url)).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+// Removes all push URLs of the remote, so that pushing uses the fetch URL again
+func (self *RemoteCommands) RemoveRemotePushUrls(remoteName string) error {
+	cmdArgs := NewGitCmd("config").
+		Arg("--unset-all", fmt.Sprintf("remote.%s.pushurl", remoteName)).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+// Returns the remote-tracking branches of the remote (e.g. "origin/feature")
+// that no longer exist on the remote and would be removed by a prune.
+func (self *RemoteCommands) GetStaleRemoteBranches(remoteName string) ([]string, error) {
+	cmdArgs := NewGitCmd("remote").
+		Arg("prune", "--dry-run", remoteName).
+		ToArgv()
+
+	// This talks to the remote, but we need its output, so we can't use the
+	// credential handling here; make git fail rather than hang if it needs
+	// credentials.
+	output, err := self.cmd.New(cmdArgs).AddEnvVars("GIT_TERMINAL_PROMPT=0").RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	return parseStaleRemoteBranches(output), nil
+}
+
+func parseStaleRemoteBranches(output string) []string {
+	const marker = "[would prune] "
+
+	return lo.FilterMap(strings.Split(output, "\n"), func(line string, _ int) (string, bool) {
+		_, branch, found := strings.Cut(line, marker)
+		return strings.TrimSpace(branch), found
+	})
+}
+
+// Sets refs/remotes/<remote>/HEAD by asking the remote for its default branch
+func (self *RemoteCommands) SetRemoteHeadAuto(task gocui.Task, remoteName string) error {
+	cmdArgs := NewGitCmd("remote").
+		Arg("set-head", remoteName, "--auto").
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).PromptOnCredentialRequest(task).Run()
+}
+
+func (self *RemoteCommands) SetRemoteHead(remoteName string, branchName string) error {
+	cmdArgs := NewGitCmd("remote").
+		Arg("set-head", remoteName, branchName).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+func (self *RemoteCommands) DeleteRemoteHead(remoteName string) error {
+	cmdArgs := NewGitCmd("remote").
+		Arg("set-head", remoteName, "--delete").
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
 func (self *RemoteCommands) DeleteRemoteBranch(task gocui.Task, remoteName string, branchNames []string) error {
 	cmdArgs := NewGitCmd("push").
 		Arg(remoteName, "--delete").
@@ -58,9 +149,10 @@ func (self *RemoteCommands) DeleteRemoteBranch(task gocui.Task, remoteName strin
 	return self.cmd.New(cmdArgs).PromptOnCredentialRequest(task).Run()
 }
 
-func (self *RemoteCommands) DeleteRemoteTag(task gocui.Task, remoteName string, tagName string) error {
+func (self *RemoteCommands) DeleteRemoteTag(task gocui.Task, remoteName string, tagNames []string) error {
 	cmdArgs := NewGitCmd("push").
-		Arg(remoteName, "--delete", tagName).
+		Arg(remoteName, "--delete").
+		Arg(tagNames...).
 		ToArgv()
 
 	return self.cmd.New(cmdArgs).PromptOnCredentialRequest(task).Run()
@@ -87,3 +179,9 @@ func (self *RemoteCommands) GetRemoteURL(remoteName string) (string, error) {
 	url, err := self.cmd.New(cmdArgs).RunWithOutput()
 	return strings.TrimSpace(url), err
 }
+
+// git remote set-url matches existing URLs against a regex, so we need to
+// escape the URL to only match it exactly
+func urlPattern(url string) string {
+	return "^" + regexp.QuoteMeta(url) + "$"
+}
@@ -34,7 +34,7 @@ func NewRemoteLoader(
 
 func (self *RemoteLoader) GetRemotes() ([]*models.Remote, error) {
 	wg := sync.WaitGroup{}
-	wg.Add(1)
+	wg.Add(2)
 
 	var remoteBranchesByRemoteName map[string][]*models.RemoteBranch
 	var remoteBranchesErr error
@@ -44,6 +44,13 @@ func (self *RemoteLoader) GetRemotes() ([]*models.Remote, error) {
 		remoteBranchesByRemoteName, remoteBranchesErr = self.getRemoteBranchesByRemoteName()
 	})
 
+	var pushUrlsByRemoteName map[string][]string
+	go utils.Safe(func() {
+		defer wg.Done()
+
+		pushUrlsByRemoteName = self.getPushUrlsByRemoteName()
+	})
+
 	goGitRemotes, err := self.getGoGitRemotes()
 	if err != nil {
 		return nil, err
@@ -59,9 +66,12 @@ func (self *RemoteLoader) GetRemotes() ([]*models.Remote, error) {
 		remoteName := goGitRemote.Config().Name
 		branches := remoteBranchesByRemoteName[remoteName]
 
+		pushUrls := pushUrlsByRemoteName[remoteName]
+
 		return &models.Remote{
 			Name:     goGitRemote.Config().Name,
-			Urls:     goGitRemote.Config().URLs,
+			Urls:     withoutPushUrls(goGitRemote.Config().URLs, pushUrls),
+			PushUrls: pushUrls,
 			Branches: branches,
 		}
 	})
@@ -132,3 +142,45 @@ func (self *RemoteLoader) getRemoteBranchesByRemoteName() (map[string][]*models.
 
 	return remoteBranchesByRemoteName, nil
 }
+
+// go-git doesn't know about push URLs, so we ask git for them
+func (self *RemoteLoader) getPushUrlsByRemoteName() map[string][]string {
+	cmdArgs := NewGitCmd("config").
+		Arg("--get-regexp", `^remote\..*\.pushurl$`).
+		ToArgv()
+
+	// git config exits with status 1 if there are no matches, so we ignore the
+	// error and just go by the output
+	output, _ := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+
+	return parsePushUrls(output)
+}
+
+func parsePushUrls(output string) map[string][]string {
+	pushUrlsByRemoteName := make(map[string][]string)
+	for _, line := range strings.Split(output, "\n") {
+		key, url, found := strings.Cut(strings.TrimSpace(line), " ")
+		if !found {
+			continue
+		}
+
+		remoteName := strings.TrimSuffix(strings.TrimPrefix(key, "remote."), ".pushurl")
+		pushUrlsByRemoteName[remoteName] = append(pushUrlsByRemoteName[remoteName], url)
+	}
+
+	return pushUrlsByRemoteName
+}
+
+// go-git appends the push URLs to the URLs of a remote, so we strip them off
+// again to get the fetch URLs
+func withoutPushUrls(urls []string, pushUrls []string) []string {
+	if len(pushUrls) == 0 || len(urls) <= len(pushUrls) {
+		return urls
+	}
+
+	fetchUrls := urls[:len(urls)-len(pushUrls)]
+	if !slices.Equal(urls[len(fetchUrls):], pushUrls) {
+		return urls
+	}
+	return fetchUrls
+}
@@ -0,0 +1,60 @@
+package git_commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePushUrls(t *testing.T) {
+	output := `remote.origin.pushurl git@mirror-one:repo.git
+remote.origin.pushurl git@mirror-two:repo.git
+remote.my.fork.pushurl git@fork:repo.git
+`
+	assert.Equal(t, map[string][]string{
+		"origin":  {"git@mirror-one:repo.git", "git@mirror-two:repo.git"},
+		"my.fork": {"git@fork:repo.git"},
+	}, parsePushUrls(output))
+}
+
+func TestWithoutPushUrls(t *testing.T) {
+	type scenario struct {
+		testName string
+		urls     []string
+		pushUrls []string
+		expected []string
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "no push urls",
+			urls:     []string{"a"},
+			pushUrls: nil,
+			expected: []string{"a"},
+		},
+		{
+			testName: "push urls appended",
+			urls:     []string{"a", "b", "c"},
+			pushUrls: []string{"b", "c"},
+			expected: []string{"a"},
+		},
+		{
+			testName: "push url same as fetch url",
+			urls:     []string{"a", "a"},
+			pushUrls: []string{"a"},
+			expected: []string{"a"},
+		},
+		{
+			testName: "push urls not appended",
+			urls:     []string{"a"},
+			pushUrls: []string{"b"},
+			expected: []string{"a"},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			assert.Equal(t, s.expected, withoutPushUrls(s.urls, s.pushUrls))
+		})
+	}
+}
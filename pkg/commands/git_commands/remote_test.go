@@ -0,0 +1,82 @@
+package git_commands
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRemoteUpdateRemotePushUrl(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"remote", "set-url", "--push", "origin", "git@new-mirror:repo.git", `^git@mirror:repo\.git$`}, "", nil)
+	instance := buildRemoteCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.UpdateRemotePushUrl("origin", "git@mirror:repo.git", "git@new-mirror:repo.git"))
+	runner.CheckForMissingCalls()
+}
+
+func TestRemoteAddRemotePushUrl(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"remote", "set-url", "--add", "--push", "origin", "git@mirror:repo.git"}, "", nil)
+	instance := buildRemoteCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.AddRemotePushUrl("origin", "git@mirror:repo.git"))
+	runner.CheckForMissingCalls()
+}
+
+func TestRemoteRemoveRemotePushUrl(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"remote", "set-url", "--delete", "--push", "origin", `^https://example\.com/repo\.git$`}, "", nil)
+	instance := buildRemoteCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.RemoveRemotePushUrl("origin", "https://example.com/repo.git"))
+	runner.CheckForMissingCalls()
+}
+
+func TestRemoteRemoveRemotePushUrls(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"config", "--unset-all", "remote.origin.pushurl"}, "", nil)
+	instance := buildRemoteCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.RemoveRemotePushUrls("origin"))
+	runner.CheckForMissingCalls()
+}
+
+func TestRemoteGetStaleRemoteBranches(t *testing.T) {
+	type scenario struct {
+		testName         string
+		output           string
+		expectedBranches []string
+	}
+
+	scenarios := []scenario{
+		{
+			testName:         "nothing to prune",
+			output:           "",
+			expectedBranches: []string{},
+		},
+		{
+			testName: "some branches to prune",
+			output: `Pruning origin
+URL: git@github.com:jesseduffield/lazygit.git
+ * [would prune] origin/feature/one
+ * [would prune] origin/two
+`,
+			expectedBranches: []string{"origin/feature/one", "origin/two"},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			runner := oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"remote", "prune", "--dry-run", "origin"}, s.output, nil)
+			instance := buildRemoteCommands(commonDeps{runner: runner})
+
+			branches, err := instance.GetStaleRemoteBranches("origin")
+			assert.NoError(t, err)
+			assert.Equal(t, s.expectedBranches, branches)
+			runner.CheckForMissingCalls()
+		})
+	}
+}
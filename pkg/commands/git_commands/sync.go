@@ -6,6 +6,7 @@ import (
 	"github.com/go-errors/errors"
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/samber/lo"
 )
 
 type SyncCommands struct {
@@ -54,6 +55,27 @@ func (self *SyncCommands) Push(task gocui.Task, opts PushOpts) error {
 	return cmdObj.Run()
 }
 
+type PushRefspec struct {
+	LocalBranch  string
+	RemoteBranch string
+}
+
+// Pushes several branches to the given remote in a single command, using
+// --force-with-lease. This is used for pushing all branches of a stack after
+// it was rebased.
+func (self *SyncCommands) PushBranchesWithLease(task gocui.Task, remote string, refspecs []PushRefspec, setUpstream bool) error {
+	cmdArgs := NewGitCmd("push").
+		Arg("--force-with-lease").
+		ArgIf(setUpstream, "--set-upstream").
+		Arg(remote).
+		Arg(lo.Map(refspecs, func(refspec PushRefspec, _ int) string {
+			return fmt.Sprintf("refs/heads/%s:%s", refspec.LocalBranch, refspec.RemoteBranch)
+		})...).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).PromptOnCredentialRequest(task).Run()
+}
+
 func (self *SyncCommands) fetchCommandBuilder(fetchAll bool) *GitCommandBuilder {
 	return NewGitCmd("fetch").
 		ArgIf(fetchAll, "--all").
@@ -130,3 +152,36 @@ func (self *SyncCommands) FetchRemote(task gocui.Task, remoteName string) error
 
 	return self.cmd.New(cmdArgs).PromptOnCredentialRequest(task).Run()
 }
+
+// Like FetchRemote, but also removes remote-tracking branches that no longer
+// exist on the remote
+func (self *SyncCommands) FetchRemoteAndPrune(task gocui.Task, remoteName string) error {
+	cmdArgs := self.fetchCommandBuilder(false).
+		Arg("--prune").
+		Arg(remoteName).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).PromptOnCredentialRequest(task).Run()
+}
+
+// Fetches in another repo, e.g. one of the workspace repos. Since this is
+// typically done for several repos at once, it fails rather than prompting
+// if credentials are needed.
+func (self *SyncCommands) FetchInRepo(repoPath string) error {
+	cmdArgs := self.fetchCommandBuilder(self.UserConfig().Git.FetchAll).
+		Dir(repoPath).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).FailOnCredentialRequest().Run()
+}
+
+// Fast-forwards the checked out branch of another repo to its upstream. Like
+// FetchInRepo, it fails if credentials are needed.
+func (self *SyncCommands) PullInRepo(repoPath string) error {
+	cmdArgs := NewGitCmd("pull").
+		Arg("--no-edit", "--ff-only").
+		Dir(repoPath).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).FailOnCredentialRequest().Run()
+}
@@ -105,6 +105,18 @@ func TestSyncPush(t *testing.T) {
 	}
 }
 
+func TestSyncPushBranchesWithLease(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"push", "--force-with-lease", "--set-upstream", "origin", "refs/heads/feature-a:feature-a", "refs/heads/feature-b:my-feature-b"}, "", nil)
+	instance := buildSyncCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.PushBranchesWithLease(gocui.NewFakeTask(), "origin", []PushRefspec{
+		{LocalBranch: "feature-a", RemoteBranch: "feature-a"},
+		{LocalBranch: "feature-b", RemoteBranch: "my-feature-b"},
+	}, true))
+	runner.CheckForMissingCalls()
+}
+
 func TestSyncFetch(t *testing.T) {
 	type scenario struct {
 		testName       string
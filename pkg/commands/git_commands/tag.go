@@ -1,10 +1,14 @@
 package git_commands
 
 import (
+	"fmt"
+	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/samber/lo"
 )
 
 type TagCommands struct {
@@ -37,6 +41,31 @@ func (self *TagCommands) CreateAnnotatedObj(tagName, ref, msg string, force bool
 	return self.cmd.New(cmdArgs)
 }
 
+// Creates a signed annotated tag. If signingKey is empty, the default signing
+// key (user.signingKey) is used.
+func (self *TagCommands) CreateSignedObj(tagName, ref, msg, signingKey string, force bool) *oscommands.CmdObj {
+	cmdArgs := NewGitCmd("tag").Arg(tagName).
+		ArgIf(force, "--force").
+		ArgIfElse(signingKey != "", "--local-user="+signingKey, "--sign").
+		ArgIf(len(ref) > 0, ref).
+		Arg("-m", msg).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs)
+}
+
+// Replaces the message of an existing annotated tag, keeping it pointing at
+// the same object. The tag is signed again if tag.gpgSign is set.
+func (self *TagCommands) EditMessageObj(tagName, msg string) *oscommands.CmdObj {
+	cmdArgs := NewGitCmd("tag").Arg(tagName).
+		Arg("--force", "--annotate").
+		Arg("refs/tags/"+tagName+"^{}").
+		Arg("-m", msg).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs)
+}
+
 func (self *TagCommands) HasTag(tagName string) bool {
 	cmdArgs := NewGitCmd("show-ref").
 		Arg("--tags", "--quiet", "--verify", "--").
@@ -46,15 +75,18 @@ func (self *TagCommands) HasTag(tagName string) bool {
 	return self.cmd.New(cmdArgs).Run() == nil
 }
 
-func (self *TagCommands) LocalDelete(tagName string) error {
-	cmdArgs := NewGitCmd("tag").Arg("-d", tagName).
+func (self *TagCommands) LocalDelete(tagNames []string) error {
+	cmdArgs := NewGitCmd("tag").Arg("-d").Arg(tagNames...).
 		ToArgv()
 
 	return self.cmd.New(cmdArgs).Run()
 }
 
-func (self *TagCommands) Push(task gocui.Task, remoteName string, tagName string) error {
-	cmdArgs := NewGitCmd("push").Arg(remoteName, "tag", tagName).
+func (self *TagCommands) Push(task gocui.Task, remoteName string, tagNames []string) error {
+	cmdArgs := NewGitCmd("push").Arg(remoteName).
+		Arg(lo.FlatMap(tagNames, func(tagName string, _ int) []string {
+			return []string{"tag", tagName}
+		})...).
 		ToArgv()
 
 	return self.cmd.New(cmdArgs).PromptOnCredentialRequest(task).Run()
@@ -77,6 +109,17 @@ func (self *TagCommands) ShowAnnotationInfo(tagName string) (string, error) {
 	return self.cmd.New(cmdArgs).RunWithOutput()
 }
 
+// Returns the message of an annotated tag, without its signature.
+func (self *TagCommands) GetMessage(tagName string) (string, error) {
+	cmdArgs := NewGitCmd("for-each-ref").
+		Arg("--format=%(contents:subject)%0a%0a%(contents:body)").
+		Arg("refs/tags/" + tagName).
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	return strings.TrimSpace(output), err
+}
+
 func (self *TagCommands) IsTagAnnotated(tagName string) (bool, error) {
 	cmdArgs := NewGitCmd("cat-file").
 		Arg("-t").
@@ -86,3 +129,112 @@ func (self *TagCommands) IsTagAnnotated(tagName string) (bool, error) {
 	output, err := self.cmd.New(cmdArgs).RunWithOutput()
 	return strings.TrimSpace(output) == "tag", err
 }
+
+// Verifies the signature of the given tag, returning the output of gpg (or
+// ssh-keygen). A non-nil error means the signature could not be verified.
+func (self *TagCommands) VerifySignature(tagName string) (string, error) {
+	cmdArgs := NewGitCmd("verify-tag").
+		Arg(tagName).
+		ToArgv()
+
+	stdout, stderr, err := self.cmd.New(cmdArgs).DontLog().RunWithOutputs()
+	return strings.TrimSpace(stdout + stderr), err
+}
+
+// Returns the most recent tag reachable from HEAD, or an empty string if there
+// is none.
+func (self *TagCommands) LatestTag() string {
+	cmdArgs := NewGitCmd("describe").
+		Arg("--tags", "--abbrev=0").
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(output)
+}
+
+// Returns the full messages of the commits between the given tag and HEAD.
+// If the tag is empty, all commits reachable from HEAD are considered.
+func (self *TagCommands) CommitMessagesSince(tagName string) ([]string, error) {
+	cmdArgs := NewGitCmd("log").
+		Arg("--format=%B%x00").
+		ArgIfElse(tagName != "", tagName+"..HEAD", "HEAD").
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.FilterMap(strings.Split(output, "\x00"), func(msg string, _ int) (string, bool) {
+		msg = strings.TrimSpace(msg)
+		return msg, msg != ""
+	}), nil
+}
+
+var semverTagRegex = regexp.MustCompile(`^(.*?)(\d+)\.(\d+)\.(\d+)$`)
+
+// Suggests the next semantic version tag, based on the latest tag and the
+// messages of the commits made since then. Conventional-commit style messages
+// determine the bump: a breaking change bumps the major version, a "feat"
+// commit bumps the minor version, anything else bumps the patch version.
+// Returns false if the latest tag is not a semantic version.
+func NextSemverTag(latestTag string, commitMessages []string) (string, bool) {
+	if latestTag == "" {
+		return "v0.1.0", true
+	}
+
+	match := semverTagRegex.FindStringSubmatch(latestTag)
+	if match == nil {
+		return "", false
+	}
+
+	prefix := match[1]
+	major, _ := strconv.Atoi(match[2])
+	minor, _ := strconv.Atoi(match[3])
+	patch, _ := strconv.Atoi(match[4])
+
+	switch semverBump(commitMessages) {
+	case semverBumpMajor:
+		major, minor, patch = major+1, 0, 0
+	case semverBumpMinor:
+		minor, patch = minor+1, 0
+	default:
+		patch++
+	}
+
+	return fmt.Sprintf("%s%d.%d.%d", prefix, major, minor, patch), true
+}
+
+type semverBumpKind int
+
+const (
+	semverBumpPatch semverBumpKind = iota
+	semverBumpMinor
+	semverBumpMajor
+)
+
+var conventionalCommitRegex = regexp.MustCompile(`^(\w+)(\([^)]*\))?(!)?:`)
+
+func semverBump(commitMessages []string) semverBumpKind {
+	result := semverBumpPatch
+	for _, msg := range commitMessages {
+		if strings.Contains(msg, "BREAKING CHANGE:") || strings.Contains(msg, "BREAKING-CHANGE:") {
+			return semverBumpMajor
+		}
+
+		match := conventionalCommitRegex.FindStringSubmatch(msg)
+		if match == nil {
+			continue
+		}
+		if match[3] == "!" {
+			return semverBumpMajor
+		}
+		if match[1] == "feat" {
+			result = semverBumpMinor
+		}
+	}
+	return result
+}
@@ -9,10 +9,9 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
-const tagsOutput = `tag1 this is my message
-tag2
-tag3 this is my other message
-`
+const tagsOutput = "tag1\x00tag\x00this is my message\n" +
+	"tag2\x00commit\x00\n" +
+	"tag3\x00commit\x00this is my other message\n"
 
 func TestGetTags(t *testing.T) {
 	type scenario struct {
@@ -26,16 +25,16 @@ func TestGetTags(t *testing.T) {
 		{
 			testName: "should return no tags if there are none",
 			runner: oscommands.NewFakeRunner(t).
-				ExpectGitArgs([]string{"tag", "--list", "-n", "--sort=-creatordate"}, "", nil),
+				ExpectGitArgs([]string{"tag", "--list", "--sort=-creatordate", "--format=%(refname:lstrip=2)%00%(objecttype)%00%(contents:lines=1)"}, "", nil),
 			expectedTags:  []*models.Tag{},
 			expectedError: nil,
 		},
 		{
 			testName: "should return tags if present",
 			runner: oscommands.NewFakeRunner(t).
-				ExpectGitArgs([]string{"tag", "--list", "-n", "--sort=-creatordate"}, tagsOutput, nil),
+				ExpectGitArgs([]string{"tag", "--list", "--sort=-creatordate", "--format=%(refname:lstrip=2)%00%(objecttype)%00%(contents:lines=1)"}, tagsOutput, nil),
 			expectedTags: []*models.Tag{
-				{Name: "tag1", Message: "this is my message"},
+				{Name: "tag1", Message: "this is my message", IsAnnotated: true},
 				{Name: "tag2", Message: ""},
 				{Name: "tag3", Message: "this is my other message"},
 			},
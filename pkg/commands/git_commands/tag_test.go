@@ -0,0 +1,149 @@
+package git_commands
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTagCreateSignedObj(t *testing.T) {
+	type scenario struct {
+		testName   string
+		ref        string
+		signingKey string
+		force      bool
+		expected   []string
+	}
+
+	scenarios := []scenario{
+		{
+			testName:   "default key",
+			ref:        "",
+			signingKey: "",
+			force:      false,
+			expected:   []string{"git", "tag", "v1.0.0", "--sign", "-m", "release"},
+		},
+		{
+			testName:   "specific key with ref",
+			ref:        "abc123",
+			signingKey: "ABCDEF12",
+			force:      false,
+			expected:   []string{"git", "tag", "v1.0.0", "--local-user=ABCDEF12", "abc123", "-m", "release"},
+		},
+		{
+			testName:   "force",
+			ref:        "",
+			signingKey: "",
+			force:      true,
+			expected:   []string{"git", "tag", "v1.0.0", "--force", "--sign", "-m", "release"},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildTagCommands(commonDeps{})
+			cmdObj := instance.CreateSignedObj("v1.0.0", s.ref, "release", s.signingKey, s.force)
+			assert.Equal(t, s.expected, cmdObj.Args())
+		})
+	}
+}
+
+func TestTagEditMessageObj(t *testing.T) {
+	instance := buildTagCommands(commonDeps{})
+	cmdObj := instance.EditMessageObj("v1.0.0", "new message")
+	assert.Equal(t, []string{"git", "tag", "v1.0.0", "--force", "--annotate", "refs/tags/v1.0.0^{}", "-m", "new message"}, cmdObj.Args())
+}
+
+func TestTagLocalDelete(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"tag", "-d", "v1.0.0", "v1.1.0"}, "", nil)
+	instance := buildTagCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.LocalDelete([]string{"v1.0.0", "v1.1.0"}))
+	runner.CheckForMissingCalls()
+}
+
+func TestTagPush(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"push", "origin", "tag", "v1.0.0", "tag", "v1.1.0"}, "", nil)
+	instance := buildTagCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.Push(gocui.NewFakeTask(), "origin", []string{"v1.0.0", "v1.1.0"}))
+	runner.CheckForMissingCalls()
+}
+
+func TestTagCommitMessagesSince(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"log", "--format=%B%x00", "v1.0.0..HEAD"}, "feat: one\n\nbody\n\x00\nfix: two\n\x00\n", nil)
+	instance := buildTagCommands(commonDeps{runner: runner})
+
+	messages, err := instance.CommitMessagesSince("v1.0.0")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"feat: one\n\nbody", "fix: two"}, messages)
+	runner.CheckForMissingCalls()
+}
+
+func TestNextSemverTag(t *testing.T) {
+	type scenario struct {
+		testName       string
+		latestTag      string
+		commitMessages []string
+		expectedTag    string
+		expectedOk     bool
+	}
+
+	scenarios := []scenario{
+		{
+			testName:       "no tag yet",
+			latestTag:      "",
+			commitMessages: []string{"initial commit"},
+			expectedTag:    "v0.1.0",
+			expectedOk:     true,
+		},
+		{
+			testName:       "not a semantic version",
+			latestTag:      "release-candidate",
+			commitMessages: []string{"fix: something"},
+			expectedTag:    "",
+			expectedOk:     false,
+		},
+		{
+			testName:       "patch bump for non-conventional commits",
+			latestTag:      "v1.2.3",
+			commitMessages: []string{"Fix typo", "Update docs"},
+			expectedTag:    "v1.2.4",
+			expectedOk:     true,
+		},
+		{
+			testName:       "minor bump for feature",
+			latestTag:      "v1.2.3",
+			commitMessages: []string{"fix: typo", "feat(ui): new panel"},
+			expectedTag:    "v1.3.0",
+			expectedOk:     true,
+		},
+		{
+			testName:       "major bump for breaking change marker",
+			latestTag:      "1.2.3",
+			commitMessages: []string{"feat!: drop old config"},
+			expectedTag:    "2.0.0",
+			expectedOk:     true,
+		},
+		{
+			testName:       "major bump for breaking change footer",
+			latestTag:      "release/v1.2.3",
+			commitMessages: []string{"refactor: config\n\nBREAKING CHANGE: config keys renamed"},
+			expectedTag:    "release/v2.0.0",
+			expectedOk:     true,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			tag, ok := NextSemverTag(s.latestTag, s.commitMessages)
+			assert.Equal(t, s.expectedTag, tag)
+			assert.Equal(t, s.expectedOk, ok)
+		})
+	}
+}
@@ -63,6 +63,18 @@ func (self *WorkingTreeCommands) UnstageAll() error {
 	return self.cmd.New(NewGitCmd("reset").ToArgv()).Run()
 }
 
+// Saves the index as a tree object, so that it can be restored later with
+// RestoreIndex. Returns the hash of the tree.
+func (self *WorkingTreeCommands) WriteIndexTree() (string, error) {
+	output, err := self.cmd.New(NewGitCmd("write-tree").ToArgv()).DontLog().RunWithOutput()
+	return strings.TrimSpace(output), err
+}
+
+// Replaces the index with the given tree, without touching the working tree
+func (self *WorkingTreeCommands) RestoreIndex(treeHash string) error {
+	return self.cmd.New(NewGitCmd("read-tree").Arg(treeHash).ToArgv()).Run()
+}
+
 // UnStageFile unstages a file
 // we accept an array of filenames for the cases where a file has been renamed i.e.
 // we accept the current name and the previous name
@@ -290,6 +302,11 @@ func (self *WorkingTreeCommands) WorktreeFileDiffCmdObj(node models.IFile, plain
 	return self.cmd.New(cmdArgs).DontLog()
 }
 
+// Lists the untracked files in the given untracked directory
+func (self *WorkingTreeCommands) UntrackedFilesCmdObj(dir string) *oscommands.CmdObj {
+	return self.cmd.New(untrackedFilesCmdArgs([]string{dir + "/"})).DontLog()
+}
+
 // ShowFileDiff get the diff of specified from and to. Typically this will be used for a single commit so it'll be 123abc^..123abc
 // but when we're in diff mode it could be any 'from' to any 'to'. The reverse flag is also here thanks to diff mode.
 func (self *WorkingTreeCommands) ShowFileDiff(from string, to string, reverse bool, fileName string, plain bool) (string, error) {
@@ -563,3 +563,16 @@ func TestWorkingTreeResetHard(t *testing.T) {
 		})
 	}
 }
+
+func TestWorkingTreeWriteAndRestoreIndex(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"write-tree"}, "1234abcd\n", nil).
+		ExpectGitArgs([]string{"read-tree", "1234abcd"}, "", nil)
+	instance := buildWorkingTreeCommands(commonDeps{runner: runner})
+
+	treeHash, err := instance.WriteIndexTree()
+	assert.NoError(t, err)
+	assert.Equal(t, "1234abcd", treeHash)
+	assert.NoError(t, instance.RestoreIndex(treeHash))
+	runner.CheckForMissingCalls()
+}
@@ -0,0 +1,185 @@
+package git_commands
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+	"github.com/spf13/afero"
+)
+
+const maxConcurrentWorkspaceRepoLoads = 8
+
+type WorkspaceLoader struct {
+	*GitCommon
+}
+
+func NewWorkspaceLoader(gitCommon *GitCommon) *WorkspaceLoader {
+	return &WorkspaceLoader{GitCommon: gitCommon}
+}
+
+// Returns the status of every repo of the workspace. The repos are loaded in
+// parallel; a repo whose status can't be loaded is still returned, with its
+// Err field set.
+func (self *WorkspaceLoader) GetWorkspaceRepos() []*models.WorkspaceRepo {
+	paths := self.getRepoPaths(self.UserConfig().Workspace)
+	names := getUniqueNamesFromPaths(paths)
+
+	repos := lo.Map(paths, func(path string, i int) *models.WorkspaceRepo {
+		return &models.WorkspaceRepo{
+			Path:      path,
+			Name:      names[i],
+			IsCurrent: path == self.repoPaths.WorktreePath(),
+		}
+	})
+
+	// Workspaces can consist of many repos, so we limit the number of git
+	// processes that we run at the same time
+	semaphore := make(chan struct{}, maxConcurrentWorkspaceRepoLoads)
+	wg := sync.WaitGroup{}
+	wg.Add(len(repos))
+	for _, repo := range repos {
+		go utils.Safe(func() {
+			defer wg.Done()
+
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			self.loadRepo(repo)
+		})
+	}
+	wg.Wait()
+
+	return repos
+}
+
+// Returns the configured repos, followed by the repos found directly inside
+// the configured scan directories, without duplicates.
+func (self *WorkspaceLoader) getRepoPaths(workspaceConfig config.WorkspaceConfig) []string {
+	paths := lo.Map(workspaceConfig.Repos, func(path string, _ int) string {
+		return expandHomeDir(path)
+	})
+
+	for _, scanDir := range workspaceConfig.ScanDirs {
+		scanDir = expandHomeDir(scanDir)
+		entries, err := afero.ReadDir(self.Fs, scanDir)
+		if err != nil {
+			self.Log.Warnf("Could not scan workspace directory %s: %v", scanDir, err)
+			continue
+		}
+
+		for _, entry := range entries {
+			if !entry.IsDir() {
+				continue
+			}
+
+			path := filepath.Join(scanDir, entry.Name())
+			// .git is a file rather than a directory in linked worktrees
+			if _, err := self.Fs.Stat(filepath.Join(path, ".git")); err == nil {
+				paths = append(paths, path)
+			}
+		}
+	}
+
+	return lo.Uniq(lo.Map(paths, func(path string, _ int) string {
+		if absPath, err := filepath.Abs(path); err == nil {
+			return absPath
+		}
+		return filepath.Clean(path)
+	}))
+}
+
+func expandHomeDir(path string) string {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path
+	}
+
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+
+	return filepath.Join(homeDir, path[1:])
+}
+
+func (self *WorkspaceLoader) loadRepo(repo *models.WorkspaceRepo) {
+	cmdArgs := NewGitCmd("status").
+		Arg("--porcelain=v2", "--branch").
+		Dir(repo.Path).
+		ToArgv()
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		repo.Err = err
+		return
+	}
+
+	parseWorkspaceRepoStatus(repo, output)
+
+	gitDir, err := callGitRevParseWithDir(self.cmd, repo.Path, "--absolute-git-dir")
+	if err != nil {
+		self.Log.Warnf("Could not find git dir for workspace repo %s: %v", repo.Path, err)
+		return
+	}
+
+	repo.WorkingTreeState = self.workingTreeState(gitDir)
+}
+
+// This is a simplified version of StatusCommands.WorkingTreeState; it doesn't
+// need to be as accurate because we only display it.
+func (self *WorkspaceLoader) workingTreeState(gitDir string) models.WorkingTreeState {
+	exists := func(name string) bool {
+		_, err := self.Fs.Stat(filepath.Join(gitDir, name))
+		return err == nil
+	}
+
+	return models.WorkingTreeState{
+		Rebasing:      exists("rebase-merge") || exists("rebase-apply"),
+		Merging:       exists("MERGE_HEAD"),
+		CherryPicking: exists("CHERRY_PICK_HEAD"),
+		Reverting:     exists("REVERT_HEAD"),
+	}
+}
+
+// Parses the output of `git status --porcelain=v2 --branch`. The header lines
+// start with '#'; every other line is a changed or untracked file.
+func parseWorkspaceRepoStatus(repo *models.WorkspaceRepo, output string) {
+	oid := ""
+	for _, line := range strings.Split(utils.NormalizeLinefeeds(output), "\n") {
+		if line == "" {
+			continue
+		}
+
+		header, isHeader := strings.CutPrefix(line, "# ")
+		if !isHeader {
+			repo.DirtyCount++
+			continue
+		}
+
+		key, value, _ := strings.Cut(header, " ")
+		switch key {
+		case "branch.oid":
+			oid = value
+		case "branch.head":
+			if value == "(detached)" {
+				repo.DetachedHead = true
+			} else {
+				repo.Branch = value
+			}
+		case "branch.ab":
+			ahead, behind, _ := strings.Cut(value, " ")
+			repo.HasUpstream = true
+			repo.Ahead, _ = strconv.Atoi(strings.TrimPrefix(ahead, "+"))
+			repo.Behind, _ = strconv.Atoi(strings.TrimPrefix(behind, "-"))
+		}
+	}
+
+	if repo.DetachedHead {
+		repo.Branch = utils.ShortHash(oid)
+	}
+}
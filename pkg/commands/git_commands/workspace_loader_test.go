@@ -0,0 +1,169 @@
+package git_commands
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetWorkspaceRepos(t *testing.T) {
+	type scenario struct {
+		testName      string
+		repos         []string
+		scanDirs      []string
+		before        func(runner *oscommands.FakeCmdObjRunner, fs afero.Fs)
+		expectedRepos []*models.WorkspaceRepo
+	}
+
+	revParseArgs := func(path string) []string {
+		return []string{"-C", path, "rev-parse", "--path-format=absolute", "--absolute-git-dir"}
+	}
+	statusArgs := func(path string) []string {
+		return []string{"-C", path, "status", "--porcelain=v2", "--branch"}
+	}
+
+	scenarios := []scenario{
+		{
+			testName:      "No repos configured",
+			before:        func(runner *oscommands.FakeCmdObjRunner, fs afero.Fs) {},
+			expectedRepos: []*models.WorkspaceRepo{},
+		},
+		{
+			testName: "Clean repo without upstream, the current one",
+			repos:    []string{"/path/to/repo"},
+			before: func(runner *oscommands.FakeCmdObjRunner, fs afero.Fs) {
+				runner.ExpectGitArgs(statusArgs("/path/to/repo"),
+					"# branch.oid 8f2b1b3c4d5e6f708192a3b4c5d6e7f8091a2b3c\n# branch.head master\n",
+					nil)
+				runner.ExpectGitArgs(revParseArgs("/path/to/repo"), "/path/to/repo/.git", nil)
+			},
+			expectedRepos: []*models.WorkspaceRepo{
+				{
+					Path:      "/path/to/repo",
+					Name:      "repo",
+					IsCurrent: true,
+					Branch:    "master",
+				},
+			},
+		},
+		{
+			testName: "Dirty repo with upstream, mid-rebase",
+			repos:    []string{"/path/to/other"},
+			before: func(runner *oscommands.FakeCmdObjRunner, fs afero.Fs) {
+				runner.ExpectGitArgs(statusArgs("/path/to/other"),
+					`# branch.oid 8f2b1b3c4d5e6f708192a3b4c5d6e7f8091a2b3c
+# branch.head feature
+# branch.upstream origin/feature
+# branch.ab +2 -13
+1 .M N... 100644 100644 100644 3b18e512dba79e4c8300dd08aeb37f8e728b8dad 3b18e512dba79e4c8300dd08aeb37f8e728b8dad file1
+u UU N... 100644 100644 100644 100644 3b18e512dba79e4c8300dd08aeb37f8e728b8dad 3b18e512dba79e4c8300dd08aeb37f8e728b8dad 3b18e512dba79e4c8300dd08aeb37f8e728b8dad file2
+? file3
+`,
+					nil)
+				runner.ExpectGitArgs(revParseArgs("/path/to/other"), "/path/to/other/.git", nil)
+				_ = fs.MkdirAll("/path/to/other/.git/rebase-merge", 0o755)
+			},
+			expectedRepos: []*models.WorkspaceRepo{
+				{
+					Path:             "/path/to/other",
+					Name:             "other",
+					Branch:           "feature",
+					HasUpstream:      true,
+					Ahead:            2,
+					Behind:           13,
+					DirtyCount:       3,
+					WorkingTreeState: models.WorkingTreeState{Rebasing: true},
+				},
+			},
+		},
+		{
+			testName: "Detached head",
+			repos:    []string{"/path/to/other"},
+			before: func(runner *oscommands.FakeCmdObjRunner, fs afero.Fs) {
+				runner.ExpectGitArgs(statusArgs("/path/to/other"),
+					"# branch.oid 8f2b1b3c4d5e6f708192a3b4c5d6e7f8091a2b3c\n# branch.head (detached)\n",
+					nil)
+				runner.ExpectGitArgs(revParseArgs("/path/to/other"), "/path/to/other/.git", nil)
+			},
+			expectedRepos: []*models.WorkspaceRepo{
+				{
+					Path:         "/path/to/other",
+					Name:         "other",
+					Branch:       "8f2b1b3c",
+					DetachedHead: true,
+				},
+			},
+		},
+		{
+			testName: "Missing repo",
+			repos:    []string{"/path/to/missing"},
+			before: func(runner *oscommands.FakeCmdObjRunner, fs afero.Fs) {
+				runner.ExpectGitArgs(statusArgs("/path/to/missing"), "", errors.New("cannot change to '/path/to/missing'"))
+			},
+			expectedRepos: []*models.WorkspaceRepo{
+				{
+					Path: "/path/to/missing",
+					Name: "missing",
+					Err:  errors.New("cannot change to '/path/to/missing'"),
+				},
+			},
+		},
+		{
+			testName: "Scanned directory, skipping non-repos and duplicates",
+			repos:    []string{"/projects/b/"},
+			scanDirs: []string{"/projects"},
+			before: func(runner *oscommands.FakeCmdObjRunner, fs afero.Fs) {
+				_ = fs.MkdirAll("/projects/a/.git", 0o755)
+				_ = fs.MkdirAll("/projects/b/.git", 0o755)
+				_ = fs.MkdirAll("/projects/not-a-repo", 0o755)
+				_ = afero.WriteFile(fs, "/projects/file", []byte{}, 0o644)
+
+				for _, path := range []string{"/projects/a", "/projects/b"} {
+					runner.ExpectGitArgs(statusArgs(path), "# branch.head master\n", nil)
+					runner.ExpectGitArgs(revParseArgs(path), path+"/.git", nil)
+				}
+			},
+			expectedRepos: []*models.WorkspaceRepo{
+				{
+					Path:   "/projects/b",
+					Name:   "b",
+					Branch: "master",
+				},
+				{
+					Path:   "/projects/a",
+					Name:   "a",
+					Branch: "master",
+				},
+			},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			runner := oscommands.NewFakeRunner(t)
+			fs := afero.NewMemMapFs()
+			s.before(runner, fs)
+
+			userConfig := config.GetDefaultConfig()
+			userConfig.Workspace.Repos = s.repos
+			userConfig.Workspace.ScanDirs = s.scanDirs
+
+			loader := &WorkspaceLoader{
+				GitCommon: buildGitCommon(commonDeps{
+					runner:     runner,
+					fs:         fs,
+					userConfig: userConfig,
+					repoPaths:  &RepoPaths{repoPath: "/path/to/repo", worktreePath: "/path/to/repo"},
+				}),
+			}
+
+			assert.EqualValues(t, s.expectedRepos, loader.GetWorkspaceRepos())
+			runner.CheckForMissingCalls()
+		})
+	}
+}
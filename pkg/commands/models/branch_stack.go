@@ -0,0 +1,54 @@
+package models
+
+// A branch in a stack of branches that are built on top of each other. The
+// bottom branch of a stack is based directly on a main branch; its Parent is
+// nil.
+type BranchStackNode struct {
+	BranchName string
+	Parent     *BranchStackNode
+	Children   []*BranchStackNode
+}
+
+// Returns the bottom branch of the stack that this branch is part of
+func (n *BranchStackNode) Root() *BranchStackNode {
+	node := n
+	for node.Parent != nil {
+		node = node.Parent
+	}
+	return node
+}
+
+// Returns this branch and all branches stacked on top of it, parents before
+// children
+func (n *BranchStackNode) Flatten() []*BranchStackNode {
+	result := []*BranchStackNode{n}
+	for _, child := range n.Children {
+		result = append(result, child.Flatten()...)
+	}
+	return result
+}
+
+// Returns the topmost branch if no branch in the stack has more than one
+// branch stacked directly on top of it, or nil otherwise
+func (n *BranchStackNode) Top() *BranchStackNode {
+	node := n
+	for len(node.Children) == 1 {
+		node = node.Children[0]
+	}
+	if len(node.Children) > 1 {
+		return nil
+	}
+	return node
+}
+
+// Finds the node for the given branch in the given stacks
+func FindBranchStackNode(stacks []*BranchStackNode, branchName string) *BranchStackNode {
+	for _, root := range stacks {
+		for _, node := range root.Flatten() {
+			if node.BranchName == branchName {
+				return node
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package models
+
+import (
+	"strconv"
+	"time"
+)
+
+// An entry of the command log: either a command that lazygit ran, or a
+// message about something that it did without running a command (e.g.
+// deleting a file)
+type CommandLogEntry struct {
+	Time time.Time `json:"time"`
+	// the action that the command was run for, e.g. 'Stage file'
+	Action string `json:"action,omitempty"`
+	// the command as it is shown in the command log
+	Command string `json:"command"`
+	// the arguments to re-run the command with; empty for messages and for
+	// commands that can't be re-run, e.g. because they needed a custom
+	// environment or interacted with the user
+	Args []string `json:"args,omitempty"`
+	// false if this is a message rather than a command that could be run on
+	// the command line
+	IsCommandLine bool `json:"isCommandLine"`
+	// the fields below are only set once the command has finished, and never
+	// for commands whose result lazygit doesn't know
+	Finished bool          `json:"finished"`
+	Duration time.Duration `json:"duration,omitempty"`
+	ExitCode int           `json:"exitCode,omitempty"`
+	Stderr   string        `json:"stderr,omitempty"`
+}
+
+func (e *CommandLogEntry) ID() string {
+	return e.Time.Format(time.RFC3339Nano) + " " + e.Command
+}
+
+func (e *CommandLogEntry) Description() string {
+	return e.Command
+}
+
+func (e *CommandLogEntry) CanRerun() bool {
+	return len(e.Args) > 0
+}
+
+func (e *CommandLogEntry) Failed() bool {
+	return e.Finished && e.ExitCode != 0
+}
+
+func (e *CommandLogEntry) ExitCodeString() string {
+	if !e.Finished {
+		return ""
+	}
+	return strconv.Itoa(e.ExitCode)
+}
@@ -22,6 +22,9 @@ const (
 	StatusCherryPickingOrReverting
 	StatusConflicted
 	StatusReflog
+	// Only used when showing the whole git graph: the commit is not reachable
+	// from the checked-out ref, i.e. it is only on other branches
+	StatusOtherBranch
 )
 
 const (
@@ -25,6 +25,11 @@ type File struct {
 
 	// If true, this must be a worktree folder
 	IsWorktree bool
+
+	// If true, this is an untracked directory whose files aren't listed
+	// individually (see the git.status.untrackedFiles and
+	// git.status.maxUntrackedFiles configs)
+	IsUntrackedDirectory bool
 }
 
 // sometimes we need to deal with either a node (which contains a file) or an actual file
@@ -2,8 +2,11 @@ package models
 
 // Remote : A git remote
 type Remote struct {
-	Name     string
-	Urls     []string
+	Name string
+	Urls []string
+	// Explicitly configured push URLs (remote.<name>.pushurl). If empty, git
+	// pushes to Urls.
+	PushUrls []string
 	Branches []*RemoteBranch
 }
 
@@ -6,6 +6,8 @@ type Tag struct {
 	// this is either the first line of the message of an annotated tag, or the
 	// first line of a commit message for a lightweight tag
 	Message string
+	// false for a lightweight tag
+	IsAnnotated bool
 }
 
 func (t *Tag) FullRefName() string {
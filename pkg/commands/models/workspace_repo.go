@@ -0,0 +1,39 @@
+package models
+
+// A repository shown in the workspace panel
+type WorkspaceRepo struct {
+	// path to the directory of the repo's working tree
+	Path string
+	// if true, this is the repo that lazygit currently shows
+	IsCurrent bool
+	// based on the path, but uniquified
+	Name string
+	// the checked out branch, or the short hash of HEAD if it is detached
+	Branch string
+	// true if HEAD is detached
+	DetachedHead bool
+	// true if the branch has an upstream. Ahead and Behind are only
+	// meaningful if this is true
+	HasUpstream bool
+	Ahead       int
+	Behind      int
+	// number of changed, staged, conflicted or untracked files
+	DirtyCount int
+	// the operation (rebase, merge, etc.) that is in progress in the repo
+	WorkingTreeState WorkingTreeState
+	// set if the status of the repo could not be loaded, e.g. because the
+	// directory doesn't exist anymore
+	Err error
+}
+
+func (r *WorkspaceRepo) RefName() string {
+	return r.Name
+}
+
+func (r *WorkspaceRepo) ID() string {
+	return r.Path
+}
+
+func (r *WorkspaceRepo) Description() string {
+	return r.Path
+}
@@ -100,14 +100,17 @@ func (self *cmdObjRunner) RunWithOutputs(cmdObj *CmdObj) (string, string, error)
 func (self *cmdObjRunner) RunWithOutputAux(cmdObj *CmdObj) (string, error) {
 	self.log.WithField("command", cmdObj.ToString()).Debug("RunCommand")
 
-	if cmdObj.ShouldLog() {
-		self.logCmdObj(cmdObj)
-	}
+	logResult := self.logCmdObj(cmdObj)
 
 	t := time.Now()
-	output, err := sanitisedCommandOutput(cmdObj.GetCmd().CombinedOutput())
+	rawOutput, runErr := cmdObj.GetCmd().CombinedOutput()
+	output, err := sanitisedCommandOutput(rawOutput, runErr)
 	if err != nil {
 		self.log.WithField("command", cmdObj.ToString()).Error(output)
+		// stdout and stderr are combined here, so the output is the best we have
+		logResult(runErr, output)
+	} else {
+		logResult(nil, "")
 	}
 
 	self.log.Infof("%s (%s)", cmdObj.ToString(), time.Since(t))
@@ -118,9 +121,7 @@ func (self *cmdObjRunner) RunWithOutputAux(cmdObj *CmdObj) (string, error) {
 func (self *cmdObjRunner) RunWithOutputsAux(cmdObj *CmdObj) (string, string, error) {
 	self.log.WithField("command", cmdObj.ToString()).Debug("RunCommand")
 
-	if cmdObj.ShouldLog() {
-		self.logCmdObj(cmdObj)
-	}
+	logResult := self.logCmdObj(cmdObj)
 
 	t := time.Now()
 	var outBuffer, errBuffer bytes.Buffer
@@ -130,6 +131,7 @@ func (self *cmdObjRunner) RunWithOutputsAux(cmdObj *CmdObj) (string, string, err
 	err := cmd.Run()
 
 	self.log.Infof("%s (%s)", cmdObj.ToString(), time.Since(t))
+	logResult(err, errBuffer.String())
 
 	stdout := outBuffer.String()
 	stderr, err := sanitisedCommandOutput(errBuffer.Bytes(), err)
@@ -150,20 +152,20 @@ func (self *cmdObjRunner) RunAndProcessLines(cmdObj *CmdObj, onLine func(line st
 		return errors.New("cannot call RunAndProcessLines with credential strategy. If you're seeing this then a contributor to Lazygit has accidentally called this method! Please raise an issue")
 	}
 
-	if cmdObj.ShouldLog() {
-		self.logCmdObj(cmdObj)
-	}
+	logResult := self.logCmdObj(cmdObj)
 	t := time.Now()
 
 	cmd := cmdObj.GetCmd()
 	stdoutPipe, err := cmd.StdoutPipe()
 	if err != nil {
+		logResult(err, "")
 		return err
 	}
 
 	scanner := bufio.NewScanner(stdoutPipe)
 	scanner.Split(utils.ScanLinesAndTruncateWhenLongerThanBuffer(bufio.MaxScanTokenSize))
 	if err := cmd.Start(); err != nil {
+		logResult(err, "")
 		return err
 	}
 
@@ -172,6 +174,7 @@ func (self *cmdObjRunner) RunAndProcessLines(cmdObj *CmdObj, onLine func(line st
 		stop, err := onLine(line)
 		if err != nil {
 			stdoutPipe.Close()
+			logResult(err, "")
 			return err
 		}
 		if stop {
@@ -182,18 +185,31 @@ func (self *cmdObjRunner) RunAndProcessLines(cmdObj *CmdObj, onLine func(line st
 
 	if scanner.Err() != nil {
 		stdoutPipe.Close()
+		logResult(scanner.Err(), "")
 		return scanner.Err()
 	}
 
-	_ = cmd.Wait()
+	waitErr := cmd.Wait()
 
 	self.log.Infof("%s (%s)", cmdObj.ToString(), time.Since(t))
+	logResult(waitErr, "")
 
 	return nil
 }
 
-func (self *cmdObjRunner) logCmdObj(cmdObj *CmdObj) {
-	self.guiIO.logCommandFn(cmdObj.ToString(), true)
+// Logs the command if it should be logged, and returns a function that must
+// be called with the command's error and stderr once it has finished, so that
+// its result ends up in the log too
+func (self *cmdObjRunner) logCmdObj(cmdObj *CmdObj) func(err error, stderr string) {
+	if !cmdObj.ShouldLog() {
+		return func(error, string) {}
+	}
+
+	onDone := self.guiIO.logCmdObjFn(cmdObj)
+	start := time.Now()
+	return func(err error, stderr string) {
+		onDone(NewCmdResult(start, err, stderr))
+	}
 }
 
 func sanitisedCommandOutput(output []byte, err error) (string, error) {
@@ -229,9 +245,7 @@ func (self *cmdObjRunner) runAndStreamAux(
 ) error {
 	cmdWriter := self.guiIO.newCmdWriterFn()
 
-	if cmdObj.ShouldLog() {
-		self.logCmdObj(cmdObj)
-	}
+	logResult := self.logCmdObj(cmdObj)
 	self.log.WithField("command", cmdObj.ToString()).Debug("RunCommand")
 	cmd := cmdObj.GetCmd()
 
@@ -246,6 +260,7 @@ func (self *cmdObjRunner) runAndStreamAux(
 		handler, err = self.getCmdHandlerNonPty(cmd)
 	}
 	if err != nil {
+		logResult(err, "")
 		return err
 	}
 
@@ -265,6 +280,7 @@ func (self *cmdObjRunner) runAndStreamAux(
 	err = cmd.Wait()
 
 	self.log.Infof("%s (%s)", cmdObj.ToString(), time.Since(t))
+	logResult(err, stderr.String())
 
 	if err != nil {
 		errStr := stderr.String()
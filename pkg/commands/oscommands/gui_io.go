@@ -1,7 +1,10 @@
 package oscommands
 
 import (
+	"errors"
 	"io"
+	"os/exec"
+	"time"
 
 	"github.com/sirupsen/logrus"
 )
@@ -19,6 +22,10 @@ type guiIO struct {
 	// depending on whether we're directly outputting a command we're about to run that
 	// will be run on the command line, or if we're using something from Go's standard lib.
 	logCommandFn func(str string, isCommandLineCommand bool)
+	// this is for us to log a command that we're about to run, like logCommandFn,
+	// but with all its details. It returns a function that we call with the
+	// result of the command once it has finished.
+	logCmdObjFn func(cmdObj *CmdObj) func(CmdResult)
 	// this is for us to directly write the output of a command. We will do this for
 	// certain commands like 'git push'. The GUI will write this to a command output panel.
 	// We need a new cmd writer per command, hence it being a function.
@@ -32,12 +39,14 @@ type guiIO struct {
 func NewGuiIO(
 	log *logrus.Entry,
 	logCommandFn func(string, bool),
+	logCmdObjFn func(*CmdObj) func(CmdResult),
 	newCmdWriterFn func() io.Writer,
 	promptForCredentialFn func(CredentialType) <-chan string,
 ) *guiIO {
 	return &guiIO{
 		log:                   log,
 		logCommandFn:          logCommandFn,
+		logCmdObjFn:           logCmdObjFn,
 		newCmdWriterFn:        newCmdWriterFn,
 		promptForCredentialFn: promptForCredentialFn,
 	}
@@ -49,7 +58,35 @@ func NewNullGuiIO(log *logrus.Entry) *guiIO {
 	return &guiIO{
 		log:                   log,
 		logCommandFn:          func(string, bool) {},
+		logCmdObjFn:           func(*CmdObj) func(CmdResult) { return func(CmdResult) {} },
 		newCmdWriterFn:        func() io.Writer { return io.Discard },
 		promptForCredentialFn: failPromptFn,
 	}
 }
+
+// The outcome of a command that we ran, for the command log
+type CmdResult struct {
+	Duration time.Duration
+	// -1 if the command couldn't be run at all
+	ExitCode int
+	Stderr   string
+}
+
+// Creates the result of a command that was started at the given time and has
+// just finished with the given error
+func NewCmdResult(start time.Time, err error, stderr string) CmdResult {
+	return CmdResult{Duration: time.Since(start), ExitCode: exitCode(err), Stderr: stderr}
+}
+
+func exitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.ExitCode()
+	}
+
+	return -1
+}
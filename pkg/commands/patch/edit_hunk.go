@@ -0,0 +1,114 @@
+package patch
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/samber/lo"
+)
+
+// Returned by EditedHunkPatch if the edited body of a hunk can't be turned
+// into a patch
+type EditHunkError struct {
+	// zero-based index of the offending line in the edited body
+	LineIdx int
+	// true if the line doesn't start with ' ', '+' or '-'. Otherwise the line
+	// is a context or deleted line that doesn't match the original hunk, or
+	// such a line is missing; only added lines can be edited.
+	InvalidPrefix bool
+}
+
+func (self *EditHunkError) Error() string {
+	if self.InvalidPrefix {
+		return fmt.Sprintf("line %d of edited hunk doesn't start with ' ', '+' or '-'", self.LineIdx+1)
+	}
+	return fmt.Sprintf("line %d of edited hunk doesn't match the original hunk", self.LineIdx+1)
+}
+
+// Returns the body of the given hunk (i.e. without the header line) as a plain
+// string
+func (self *Patch) FormatHunkBodyPlain(hunkIndex int) string {
+	return strings.Join(lo.Map(self.hunks[hunkIndex].bodyLines, func(line *PatchLine, _ int) string {
+		return line.Content + "\n"
+	}), "")
+}
+
+// Returns true if the given hunk contains a "\ No newline at end of file"
+// marker
+func (self *Patch) HunkHasNoNewlineMarker(hunkIndex int) bool {
+	return nLinesWithKind(self.hunks[hunkIndex].bodyLines, []PatchLineKind{NEWLINE_MESSAGE}) > 0
+}
+
+// Takes the body of the given hunk as returned by FormatHunkBodyPlain, after
+// the user edited its added lines, and returns a patch that puts these added
+// lines into the index in place of the original ones.
+//
+// If staged is false, the patch is a diff of the index against the working
+// tree, so the index contains the old side of the hunk and the edited hunk can
+// be applied as is. If staged is true, the patch is a diff of HEAD against the
+// index, so the index contains the new side of the hunk; in that case the
+// original added lines are deleted and the edited ones added instead.
+//
+// The line counts in the hunk header of the returned patch are not meant to be
+// relied upon, so it should be applied with `git apply --recount`.
+func (self *Patch) EditedHunkPatch(hunkIndex int, editedBody string, staged bool) (string, error) {
+	hunk := self.hunks[hunkIndex]
+	originalLines := hunk.bodyLines
+
+	bodyLines := []*PatchLine{}
+	originalIdx := 0
+	editedAdditions := []*PatchLine{}
+	// Adds the added lines of the current block, replacing the original ones
+	flushAdditions := func() {
+		for ; originalIdx < len(originalLines) && originalLines[originalIdx].Kind == ADDITION; originalIdx++ {
+			if staged {
+				bodyLines = append(bodyLines, &PatchLine{Kind: DELETION, Content: "-" + originalLines[originalIdx].Content[1:]})
+			}
+		}
+		bodyLines = append(bodyLines, editedAdditions...)
+		editedAdditions = nil
+	}
+
+	editedLines := strings.Split(strings.TrimSuffix(editedBody, "\n"), "\n")
+	for i, content := range editedLines {
+		if content == "" {
+			// editors tend to strip trailing whitespace, so we treat an empty
+			// line as an empty context line, like git does
+			content = " "
+		}
+
+		kind := parseFirstChar(content[:1])
+		if kind == ADDITION {
+			editedAdditions = append(editedAdditions, &PatchLine{Kind: kind, Content: content})
+			continue
+		}
+		if !strings.ContainsAny(content[:1], " -") {
+			return "", &EditHunkError{LineIdx: i, InvalidPrefix: true}
+		}
+
+		flushAdditions()
+		if originalIdx >= len(originalLines) || originalLines[originalIdx].Content != content {
+			return "", &EditHunkError{LineIdx: i}
+		}
+		if kind == CONTEXT || !staged {
+			bodyLines = append(bodyLines, &PatchLine{Kind: kind, Content: content})
+		}
+		originalIdx++
+	}
+	flushAdditions()
+
+	if originalIdx < len(originalLines) {
+		return "", &EditHunkError{LineIdx: len(editedLines)}
+	}
+
+	start := lo.Ternary(staged, hunk.newStart, hunk.oldStart)
+	return formatPlain(&Patch{
+		header: self.header,
+		hunks: []*Hunk{{
+			oldStart:      start,
+			newStart:      start,
+			headerContext: hunk.headerContext,
+			bodyLines:     bodyLines,
+		}},
+	}), nil
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/jesseduffield/generics/set"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/syntax"
 	"github.com/jesseduffield/lazygit/pkg/theme"
 	"github.com/samber/lo"
 )
@@ -16,6 +17,18 @@ type patchPresenter struct {
 
 	// line indices for tagged lines (e.g. lines added to a custom patch)
 	incLineIndices *set.Set[int]
+
+	// if true, the parts of changed lines that differ from their counterpart
+	// are highlighted
+	highlightWordDiff bool
+
+	// if non-zero, the old and new versions are rendered next to each other
+	// within this width
+	sideBySideWidth int
+	tabWidth        int
+
+	// nil if syntax highlighting is disabled
+	highlighter *syntax.Highlighter
 }
 
 // formats the patch as a plain string
@@ -41,6 +54,14 @@ func formatRangePlain(patch *Patch, startIdx int, endIdx int) string {
 type FormatViewOpts struct {
 	// line indices for tagged lines (e.g. lines added to a custom patch)
 	IncLineIndices *set.Set[int]
+	// highlight the words that changed within edited lines
+	HighlightWordDiff bool
+	// if non-zero, render the old and new versions of the file next to each
+	// other, using this total width
+	SideBySideWidth int
+	// only used for side-by-side rendering, where we need to know how wide
+	// tabs are in order to align the columns
+	TabWidth int
 }
 
 // formats the patch for rendering within a view, meaning it's coloured and
@@ -51,9 +72,13 @@ func formatView(patch *Patch, opts FormatViewOpts) string {
 		includedLineIndices = set.New[int]()
 	}
 	presenter := &patchPresenter{
-		patch:          patch,
-		plain:          false,
-		incLineIndices: includedLineIndices,
+		patch:             patch,
+		plain:             false,
+		incLineIndices:    includedLineIndices,
+		highlightWordDiff: opts.HighlightWordDiff,
+		sideBySideWidth:   opts.SideBySideWidth,
+		tabWidth:          opts.TabWidth,
+		highlighter:       theme.SyntaxHighlighter,
 	}
 	return presenter.format()
 }
@@ -78,6 +103,11 @@ func (self *patchPresenter) format() string {
 		appendLine(self.formatLineAux(line, theme.DefaultTextColor.SetBold(), false))
 	}
 
+	fileName := ""
+	if self.highlighter != nil && !self.plain {
+		fileName = self.patch.FilePath()
+	}
+
 	for _, hunk := range self.patch.hunks {
 		appendLine(
 			self.formatLineAux(
@@ -95,13 +125,30 @@ func (self *patchPresenter) format() string {
 				),
 		)
 
-		for _, line := range hunk.bodyLines {
+		var wordDiffSpans map[int][]wordDiffSpan
+		if self.highlightWordDiff {
+			wordDiffSpans = wordDiffSpansForHunkLines(hunk.bodyLines)
+		}
+		syntaxTokens := syntaxTokensForHunk(self.highlighter, fileName, hunk)
+
+		if self.sideBySideWidth > 0 {
+			// rows don't correspond to patch lines one to one, so we keep
+			// counting patch lines rather than rows
+			for _, row := range self.formatSideBySideHunkBody(hunk, lineIdx, syntaxTokens, wordDiffSpans) {
+				_, _ = stringBuilder.WriteString(row + "\n")
+			}
+			lineIdx += len(hunk.bodyLines)
+			continue
+		}
+
+		for i, line := range hunk.bodyLines {
 			style := self.patchLineStyle(line)
-			if line.IsChange() {
-				appendLine(self.formatLine(line.Content, style, lineIdx))
-			} else {
-				appendLine(self.formatLineAux(line.Content, style, false))
+			included := line.IsChange() && self.incLineIndices.Includes(lineIdx)
+			var tokens []syntax.Token
+			if syntaxTokens != nil {
+				tokens = syntaxTokens[i]
 			}
+			appendLine(self.formatLine(line.Content, style, included, tokens, wordDiffSpans[i]))
 		}
 	}
 
@@ -119,10 +166,25 @@ func (self *patchPresenter) patchLineStyle(patchLine *PatchLine) style.TextStyle
 	}
 }
 
-func (self *patchPresenter) formatLine(str string, textStyle style.TextStyle, index int) string {
-	included := self.incLineIndices.Includes(index)
+func (self *patchPresenter) formatLine(str string, textStyle style.TextStyle, included bool, syntaxTokens []syntax.Token, wordDiffSpans []wordDiffSpan) string {
+	// blank context lines may not even have the leading space
+	if (len(wordDiffSpans) == 0 && syntaxTokens == nil) || self.plain || len(str) < 2 {
+		return self.formatLineAux(str, textStyle, included)
+	}
+
+	firstCharStyle := textStyle
+	if included {
+		firstCharStyle = firstCharStyle.MergeStyle(style.BgGreen)
+	}
 
-	return self.formatLineAux(str, textStyle, included)
+	stringBuilder := &strings.Builder{}
+	stringBuilder.WriteString(firstCharStyle.Sprint(str[:1]))
+	// the tokens and spans are relative to the content after the first character
+	for _, segment := range contentSegments(str[1:], textStyle, syntaxTokens, wordDiffSpans) {
+		stringBuilder.WriteString(segment.style.Sprint(segment.text))
+	}
+
+	return stringBuilder.String()
 }
 
 // 'selected' means you've got it highlighted with your cursor
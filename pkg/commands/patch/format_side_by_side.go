@@ -0,0 +1,237 @@
+package patch
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/syntax"
+	"github.com/jesseduffield/lazygit/pkg/theme"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/mattn/go-runewidth"
+	"github.com/samber/lo"
+)
+
+// In side-by-side mode the old version of the file is shown in the left column
+// and the new version in the right one. Context lines are shown in both
+// columns, and each block of deleted lines is shown next to the block of added
+// lines that follows it, row by row, so that the old and new versions of an
+// edited line end up next to each other. This means that a row can show two
+// patch lines; see SideBySideLineIndices for how rows map to patch lines.
+const sideBySideSeparator = " │ "
+
+// A row of the side-by-side rendering of a hunk, with the indices (within the
+// hunk's body) of the lines shown in the left and the right column, or -1 if a
+// column is empty. Context lines and "\ No newline at end of file" messages
+// have the same index on both sides.
+type sideBySideRow struct {
+	left  int
+	right int
+}
+
+func sideBySideRows(lines []*PatchLine) []sideBySideRow {
+	rows := []sideBySideRow{}
+	deletions, additions, messages := []int{}, []int{}, []int{}
+	flushBlock := func() {
+		for i := range max(len(deletions), len(additions)) {
+			row := sideBySideRow{left: -1, right: -1}
+			if i < len(deletions) {
+				row.left = deletions[i]
+			}
+			if i < len(additions) {
+				row.right = additions[i]
+			}
+			rows = append(rows, row)
+		}
+		for _, idx := range messages {
+			rows = append(rows, sideBySideRow{left: idx, right: idx})
+		}
+		deletions, additions, messages = []int{}, []int{}, []int{}
+	}
+
+	for i, line := range lines {
+		switch line.Kind {
+		case DELETION:
+			// deletions after additions start a new block
+			if len(additions) > 0 {
+				flushBlock()
+			}
+			deletions = append(deletions, i)
+		case ADDITION:
+			additions = append(additions, i)
+		case NEWLINE_MESSAGE:
+			messages = append(messages, i)
+		default:
+			flushBlock()
+			rows = append(rows, sideBySideRow{left: i, right: i})
+		}
+	}
+	flushBlock()
+
+	return rows
+}
+
+// Returns, for each patch line, the index of the view line that it is shown in
+// when the patch is rendered side by side, and for each view line the index of
+// the first patch line shown in it
+func (self *Patch) SideBySideLineIndices() ([]int, []int) {
+	viewLineIndices := make([]int, 0, self.LineCount())
+	patchLineIndices := []int{}
+	appendLine := func() {
+		viewLineIndices = append(viewLineIndices, len(patchLineIndices))
+		patchLineIndices = append(patchLineIndices, len(viewLineIndices)-1)
+	}
+
+	for range self.header {
+		appendLine()
+	}
+
+	for _, hunk := range self.hunks {
+		appendLine()
+
+		firstLineIdx := len(viewLineIndices)
+		bodyViewLineIndices := make([]int, len(hunk.bodyLines))
+		for _, row := range sideBySideRows(hunk.bodyLines) {
+			firstIdx := lo.Ternary(row.left != -1, row.left, row.right)
+			for _, idx := range []int{row.left, row.right} {
+				if idx != -1 {
+					bodyViewLineIndices[idx] = len(patchLineIndices)
+				}
+			}
+			patchLineIndices = append(patchLineIndices, firstLineIdx+firstIdx)
+		}
+		viewLineIndices = append(viewLineIndices, bodyViewLineIndices...)
+	}
+
+	return viewLineIndices, patchLineIndices
+}
+
+func (self *patchPresenter) sideBySideColumnWidth() int {
+	// each column starts with the '+' or '-' marker of its line
+	return max((self.sideBySideWidth-runewidth.StringWidth(sideBySideSeparator))/2, 2)
+}
+
+// Returns the number of digits needed for the largest line number in the patch
+func (self *patchPresenter) lineNumberWidth() int {
+	maxLineNumber := 0
+	for _, hunk := range self.patch.hunks {
+		maxLineNumber = max(maxLineNumber, hunk.oldStart+hunk.oldLength(), hunk.newStart+hunk.newLength())
+	}
+	return len(fmt.Sprint(maxLineNumber))
+}
+
+func (self *patchPresenter) formatSideBySideHunkBody(hunk *Hunk, firstLineIdx int, syntaxTokens [][]syntax.Token, wordDiffSpans map[int][]wordDiffSpan) []string {
+	columnWidth := self.sideBySideColumnWidth()
+	lineNumberWidth := self.lineNumberWidth()
+	blankColumn := strings.Repeat(" ", columnWidth)
+
+	// the line numbers of each line in the old and the new file
+	oldLineNumbers := make([]int, len(hunk.bodyLines))
+	newLineNumbers := make([]int, len(hunk.bodyLines))
+	oldLineNumber, newLineNumber := hunk.oldStart, hunk.newStart
+	for i, line := range hunk.bodyLines {
+		if line.Kind == CONTEXT || line.Kind == DELETION {
+			oldLineNumbers[i] = oldLineNumber
+			oldLineNumber++
+		}
+		if line.Kind == CONTEXT || line.Kind == ADDITION {
+			newLineNumbers[i] = newLineNumber
+			newLineNumber++
+		}
+	}
+
+	formatColumn := func(idx int, lineNumbers []int, marker string, pad bool) string {
+		line := hunk.bodyLines[idx]
+		textStyle := self.patchLineStyle(line)
+		markerStyle := textStyle
+		if line.IsChange() && self.incLineIndices.Includes(firstLineIdx+idx) {
+			markerStyle = markerStyle.MergeStyle(style.BgGreen)
+		}
+
+		var tokens []syntax.Token
+		if syntaxTokens != nil {
+			tokens = syntaxTokens[idx]
+		}
+		segments := contentSegments(line.contentWithoutMarker(), textStyle, tokens, wordDiffSpans[idx])
+
+		return markerStyle.Sprint(marker) +
+			formatSideBySideColumn(lineNumbers[idx], lineNumberWidth, segments, columnWidth-1, self.tabWidth, pad)
+	}
+
+	rows := []string{}
+	for _, row := range sideBySideRows(hunk.bodyLines) {
+		if row.left != -1 && hunk.bodyLines[row.left].Kind == NEWLINE_MESSAGE {
+			rows = append(rows, self.formatLineAux(hunk.bodyLines[row.left].Content, theme.DefaultTextColor, false))
+			continue
+		}
+
+		left, right := blankColumn, ""
+		if row.left != -1 {
+			left = formatColumn(row.left, oldLineNumbers, lo.Ternary(row.left == row.right, " ", "-"), true)
+		}
+		if row.right != -1 {
+			right = formatColumn(row.right, newLineNumbers, lo.Ternary(row.left == row.right, " ", "+"), false)
+		}
+
+		rows = append(rows, left+style.FgBlackLighter.Sprint(sideBySideSeparator)+right)
+	}
+
+	return rows
+}
+
+// Renders the line number followed by as much of the content as fits into the
+// given width, optionally padded with spaces to exactly that width
+func formatSideBySideColumn(
+	lineNumber int,
+	lineNumberWidth int,
+	segments []styledSegment,
+	width int,
+	tabWidth int,
+	pad bool,
+) string {
+	lineNumberStr := utils.WithPadding(fmt.Sprint(lineNumber), lineNumberWidth, utils.AlignRight) + " "
+	stringBuilder := &strings.Builder{}
+	stringBuilder.WriteString(style.FgBlackLighter.Sprint(runewidth.Truncate(lineNumberStr, width, "")))
+
+	contentStart := runewidth.StringWidth(lineNumberStr)
+	column := contentStart
+	for _, segment := range segments {
+		if column >= width {
+			break
+		}
+		text := expandTabs(segment.text, column-contentStart, tabWidth)
+		truncated := runewidth.Truncate(text, width-column, "")
+		stringBuilder.WriteString(segment.style.Sprint(truncated))
+		column += runewidth.StringWidth(truncated)
+		if truncated != text {
+			break
+		}
+	}
+
+	if pad && column < width {
+		stringBuilder.WriteString(strings.Repeat(" ", width-column))
+	}
+
+	return stringBuilder.String()
+}
+
+// Replaces tabs with spaces, given that the text starts at the given column of
+// the file's line
+func expandTabs(text string, column int, tabWidth int) string {
+	if !strings.Contains(text, "\t") || tabWidth <= 0 {
+		return text
+	}
+
+	stringBuilder := &strings.Builder{}
+	for _, r := range text {
+		if r == '\t' {
+			spaces := tabWidth - column%tabWidth
+			stringBuilder.WriteString(strings.Repeat(" ", spaces))
+			column += spaces
+			continue
+		}
+		stringBuilder.WriteRune(r)
+		column += runewidth.RuneWidth(r)
+	}
+	return stringBuilder.String()
+}
@@ -0,0 +1,151 @@
+package patch
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/samber/lo"
+)
+
+// Splits a patch that may contain the diffs of several files into one patch
+// per file. Anything before the first diff header (e.g. the commit message of
+// a patch created with `git format-patch`) is dropped.
+func SplitByFile(patchStr string) []*Patch {
+	filePatches := []string{}
+	for _, line := range strings.SplitAfter(patchStr, "\n") {
+		if strings.HasPrefix(line, "diff --git ") {
+			filePatches = append(filePatches, "")
+		}
+		if len(filePatches) > 0 {
+			filePatches[len(filePatches)-1] += line
+		}
+	}
+
+	return lo.Map(filePatches, func(filePatch string, _ int) *Patch { return Parse(filePatch) })
+}
+
+// Returns the path of the file that the patch is about, taken from its
+// header; for a deleted file this is the old path.
+func (self *Patch) FilePath() string {
+	if newPath := self.NewPath(); newPath != "" {
+		return newPath
+	}
+	return self.OldPath()
+}
+
+// Returns the path of the file before the change, or an empty string if the
+// file was added
+func (self *Patch) OldPath() string {
+	return self.path("--- ", "a/", "rename from ", "new file mode ")
+}
+
+// Returns the path of the file after the change, or an empty string if the
+// file was deleted
+func (self *Patch) NewPath() string {
+	return self.path("+++ ", "b/", "rename to ", "deleted file mode ")
+}
+
+func (self *Patch) path(fileLinePrefix string, pathPrefix string, renamePrefix string, missingFilePrefix string) string {
+	for _, line := range self.header {
+		if rest, ok := strings.CutPrefix(line, fileLinePrefix); ok {
+			// git appends a tab to paths containing spaces
+			path := unquotePath(strings.TrimSuffix(rest, "\t"))
+			if path == "/dev/null" {
+				return ""
+			}
+			return strings.TrimPrefix(path, pathPrefix)
+		}
+	}
+
+	// Patches without hunks (e.g. for binary files, or for renames and mode
+	// changes without any changes of the content) don't have the lines above
+	for _, line := range self.header {
+		if strings.HasPrefix(line, missingFilePrefix) {
+			return ""
+		}
+		if rest, ok := strings.CutPrefix(line, renamePrefix); ok {
+			return unquotePath(rest)
+		}
+	}
+
+	// Since the file wasn't renamed, both paths in the diff line are the same
+	if len(self.header) > 0 {
+		if rest, ok := strings.CutPrefix(self.header[0], "diff --git "); ok {
+			oldPath := rest[:(len(rest)-1)/2]
+			if quoted, err := strconv.QuotedPrefix(rest); err == nil {
+				oldPath = quoted
+			}
+			return strings.TrimPrefix(unquotePath(oldPath), "a/")
+		}
+	}
+	return ""
+}
+
+// git quotes paths containing unusual characters, using C-style escapes
+func unquotePath(path string) string {
+	if strings.HasPrefix(path, `"`) {
+		if unquoted, err := strconv.Unquote(path); err == nil {
+			return unquoted
+		}
+	}
+	return path
+}
+
+// Identifies a changed line independently of the amount of context in the
+// patch and of which other changes are included in it: the old side of a
+// patch is the same no matter which of its changes are selected, so we use
+// the line number in the old file (for added lines, the number of the old line
+// that they are inserted before).
+type changeKey struct {
+	oldLineNumber int
+	content       string
+}
+
+func (self *Patch) changeKeys() map[changeKey][]int {
+	result := map[changeKey][]int{}
+	lineIdx := len(self.header)
+	for _, hunk := range self.hunks {
+		oldLineNumber := hunk.oldStart
+		if hunk.oldLength() == 0 {
+			// a hunk without old lines is inserted after its start line
+			oldLineNumber++
+		}
+
+		// skip the hunk header
+		lineIdx++
+		for _, line := range hunk.bodyLines {
+			if line.IsChange() {
+				key := changeKey{oldLineNumber: oldLineNumber, content: line.Content}
+				result[key] = append(result[key], lineIdx)
+			}
+			if line.Kind == CONTEXT || line.Kind == DELETION {
+				oldLineNumber++
+			}
+			lineIdx++
+		}
+	}
+	return result
+}
+
+// Returns the indices (as in Lines()) of the changed lines of this patch that
+// are also contained in the other patch, which must be a patch of the same
+// file against the same old version, e.g. one that only contains some of the
+// changes of this patch. The second return value is false if some of the
+// other patch's changes are not part of this patch.
+func (self *Patch) IndicesOfChangesIn(other *Patch) ([]int, bool) {
+	available := self.changeKeys()
+	result := []int{}
+	allFound := true
+	for key, otherIndices := range other.changeKeys() {
+		indices := available[key]
+		if len(indices) < len(otherIndices) {
+			allFound = false
+		}
+		result = append(result, indices[:min(len(indices), len(otherIndices))]...)
+	}
+
+	result = lo.Uniq(result)
+	sort.Ints(result)
+	return result, allFound
+}
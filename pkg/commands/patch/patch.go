@@ -1,6 +1,8 @@
 package patch
 
 import (
+	"regexp"
+
 	"github.com/samber/lo"
 )
 
@@ -104,6 +106,34 @@ func (self *Patch) LineNumberOfLine(idx int) int {
 	return hunk.newStart + offset
 }
 
+// The inverse of LineNumberOfLine: takes a line number in the new file and
+// returns the patch line index of that line. If the line is not part of any
+// hunk, returns the index of the first line after it that is, or the index of
+// the last line of the patch if there is none. Returns -1 if the patch has no
+// hunks.
+func (self *Patch) LineIdxOfLineNumber(lineNumber int) int {
+	if len(self.hunks) == 0 {
+		return -1
+	}
+
+	for hunkIdx, hunk := range self.hunks {
+		newLineNumber := hunk.newStart
+		for i, line := range hunk.bodyLines {
+			if line.Kind != ADDITION && line.Kind != CONTEXT {
+				continue
+			}
+
+			if newLineNumber >= lineNumber {
+				// +1 for the hunk header line
+				return self.HunkStartIdx(hunkIdx) + 1 + i
+			}
+			newLineNumber++
+		}
+	}
+
+	return self.LineCount() - 1
+}
+
 // Returns hunk index containing the line at the given patch line index
 func (self *Patch) HunkContainingLine(idx int) int {
 	for hunkIdx, hunk := range self.hunks {
@@ -152,6 +182,18 @@ func (self *Patch) GetNextChangeIdx(idx int) int {
 	return result
 }
 
+// Returns the patch line indices of all additions and deletions whose content
+// (i.e. without the leading '+' or '-') matches the given regex
+func (self *Patch) ChangeLineIndicesMatching(re *regexp.Regexp) []int {
+	result := []int{}
+	for i, line := range self.Lines() {
+		if line.IsChange() && re.MatchString(line.Content[1:]) {
+			result = append(result, i)
+		}
+	}
+	return result
+}
+
 // Returns the length of the patch in lines
 func (self *Patch) LineCount() int {
 	count := len(self.header)
@@ -166,6 +208,42 @@ func (self *Patch) HunkCount() int {
 	return len(self.hunks)
 }
 
+// Returns the first line number and the number of lines of the given hunk in
+// the old version of the file
+func (self *Patch) HunkOldLineRange(hunkIndex int) (int, int) {
+	hunk := self.hunks[hunkIndex]
+	return hunk.oldStart, hunk.oldLength()
+}
+
+// Returns a patch containing only the given hunks, to be applied to a version
+// of the file to which the hunks in appliedHunkIndices have already been
+// applied; the line numbers of the hunks are adjusted accordingly. This is
+// meant for patches without context lines (as produced by `git diff -U0`),
+// which need to be applied with `git apply --unidiff-zero`.
+func (self *Patch) FormatHunksPlain(hunkIndices []int, appliedHunkIndices []int) string {
+	hunks := []*Hunk{}
+	oldOffset, newOffset := 0, 0
+	for i, hunk := range self.hunks {
+		delta := hunk.newLength() - hunk.oldLength()
+		switch {
+		case lo.Contains(hunkIndices, i):
+			hunks = append(hunks, &Hunk{
+				oldStart:      hunk.oldStart + oldOffset,
+				newStart:      hunk.newStart + newOffset,
+				headerContext: hunk.headerContext,
+				bodyLines:     hunk.bodyLines,
+			})
+		case lo.Contains(appliedHunkIndices, i):
+			oldOffset += delta
+		default:
+			// this hunk is not part of the new version of the file either
+			newOffset -= delta
+		}
+	}
+
+	return formatPlain(&Patch{header: self.header, hunks: hunks})
+}
+
 // Adjust the given line number (one-based) according to the current patch. The
 // patch is supposed to be a diff of an old file state against the working
 // directory; the line number is a line number in that old file, and the
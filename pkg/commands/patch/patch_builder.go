@@ -169,6 +169,50 @@ func (p *PatchBuilder) RemoveFileLineRange(filename string, lineIndices []int) e
 	return nil
 }
 
+// Adds those changes of the given patch to the custom patch that are part of
+// the diff that the patch builder was started for. This is used for loading
+// a saved patch or importing a patch file. Returns the paths of the files
+// whose changes couldn't all be added.
+func (p *PatchBuilder) AddPatch(patchStr string) ([]string, error) {
+	notAdded := []string{}
+	for _, filePatch := range SplitByFile(patchStr) {
+		path := filePatch.FilePath()
+		info, err := p.getFileInfo(path)
+		if err != nil {
+			return nil, err
+		}
+
+		diff := Parse(info.diff)
+		if filePatch.HunkCount() == 0 {
+			// e.g. a binary file or a mode change; we can only add these as a
+			// whole
+			if info.diff != "" && diff.HunkCount() == 0 {
+				p.addFileWhole(info)
+			} else {
+				notAdded = append(notAdded, path)
+			}
+			continue
+		}
+
+		lineIndices, allFound := diff.IndicesOfChangesIn(filePatch)
+		if !allFound {
+			notAdded = append(notAdded, path)
+		}
+		if len(lineIndices) == 0 {
+			continue
+		}
+
+		if len(lineIndices) == nLinesWithKind(diff.Lines(), []PatchLineKind{ADDITION, DELETION}) {
+			p.addFileWhole(info)
+		} else {
+			info.mode = PART
+			info.includedLineIndices = lo.Union(info.includedLineIndices, lineIndices)
+		}
+	}
+
+	return notAdded, nil
+}
+
 type RenderPatchForFileOpts struct {
 	Filename                               string
 	Plain                                  bool
@@ -22,6 +22,16 @@ func (self *PatchLine) IsChange() bool {
 	return self.Kind == ADDITION || self.Kind == DELETION
 }
 
+// Returns the content without the leading '+', '-' or ' '. Blank context lines
+// can come without the leading space (e.g. with diff.suppressBlankEmpty), so
+// the content may be empty.
+func (self *PatchLine) contentWithoutMarker() string {
+	if self.Content == "" {
+		return ""
+	}
+	return self.Content[1:]
+}
+
 // Returns the number of lines in the given slice that have one of the given kinds
 func nLinesWithKind(lines []*PatchLine, kinds []PatchLineKind) int {
 	return lo.CountBy(lines, func(line *PatchLine) bool {
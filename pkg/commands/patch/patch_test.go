@@ -1,9 +1,17 @@
 package patch
 
 import (
+	"regexp"
+	"strings"
 	"testing"
 
+	"github.com/gookit/color"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/syntax"
+	"github.com/jesseduffield/lazygit/pkg/theme"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
+	"github.com/xo/terminfo"
 )
 
 const simpleDiff = `diff --git a/filename b/filename
@@ -627,6 +635,39 @@ func TestLineNumberOfLine(t *testing.T) {
 	}
 }
 
+func TestLineIdxOfLineNumber(t *testing.T) {
+	type scenario struct {
+		testName    string
+		patchStr    string
+		lineNumbers []int
+		expecteds   []int
+	}
+
+	scenarios := []scenario{
+		{
+			testName:    "twoHunks",
+			patchStr:    twoHunks,
+			lineNumbers: []int{0, 1, 2, 3, 5, 6, 8, 11, 12, 15, 1000},
+			expecteds:   []int{5, 5, 7, 8, 10, 12, 12, 15, 16, 19, 19},
+		},
+		{
+			testName:    "noHunks",
+			patchStr:    "",
+			lineNumbers: []int{1},
+			expecteds:   []int{-1},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			patch := Parse(s.patchStr)
+			for i, lineNumber := range s.lineNumbers {
+				assert.Equal(t, s.expecteds[i], patch.LineIdxOfLineNumber(lineNumber))
+			}
+		})
+	}
+}
+
 func TestGetNextStageableLineIndex(t *testing.T) {
 	type scenario struct {
 		testName  string
@@ -771,3 +812,693 @@ func TestIsSingleHunkForWholeFile(t *testing.T) {
 		})
 	}
 }
+
+func TestWordDiffSpansForHunkLines(t *testing.T) {
+	type scenario struct {
+		testName string
+		lines    []string
+		expected map[int][]string
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "single changed word",
+			lines: []string{
+				" context",
+				"-the quick brown fox",
+				"+the quick red fox",
+			},
+			expected: map[int][]string{
+				1: {"brown"},
+				2: {"red"},
+			},
+		},
+		{
+			testName: "adjacent changed words are merged into one span",
+			lines: []string{
+				"-timeout: 30 seconds",
+				"+timeout: 2 whole minutes",
+			},
+			expected: map[int][]string{
+				0: {"30 seconds"},
+				1: {"2 whole minutes"},
+			},
+		},
+		{
+			testName: "lines are paired in order",
+			lines: []string{
+				"-first line here",
+				"-second line here",
+				"+first line there",
+				"+second line there",
+			},
+			expected: map[int][]string{
+				0: {"here"},
+				1: {"here"},
+				2: {"there"},
+				3: {"there"},
+			},
+		},
+		{
+			testName: "unrelated lines are not highlighted",
+			lines: []string{
+				"-completely different",
+				"+nothing alike at all",
+			},
+			expected: map[int][]string{},
+		},
+		{
+			testName: "additions without deletions are not highlighted",
+			lines: []string{
+				" context",
+				"+added line",
+				" context",
+			},
+			expected: map[int][]string{},
+		},
+		{
+			testName: "no newline marker between deletions and additions",
+			lines: []string{
+				"-last line",
+				"\\ No newline at end of file",
+				"+last line!",
+			},
+			expected: map[int][]string{
+				0: {},
+				2: {"!"},
+			},
+		},
+	}
+
+	kinds := map[byte]PatchLineKind{'+': ADDITION, '-': DELETION, ' ': CONTEXT, '\\': NEWLINE_MESSAGE}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			lines := lo.Map(s.lines, func(line string, _ int) *PatchLine {
+				return &PatchLine{Kind: kinds[line[0]], Content: line}
+			})
+
+			result := map[int][]string{}
+			for idx, spans := range wordDiffSpansForHunkLines(lines) {
+				result[idx] = lo.Map(spans, func(span wordDiffSpan, _ int) string {
+					return lines[idx].Content[1:][span.start:span.end]
+				})
+			}
+
+			assert.Equal(t, s.expected, result)
+		})
+	}
+}
+
+func TestFormatViewSideBySide(t *testing.T) {
+	oldColorLevel := color.ForceSetColorLevel(terminfo.ColorLevelNone)
+	defer color.ForceSetColorLevel(oldColorLevel)
+
+	scenarios := []struct {
+		testName string
+		patch    string
+		expected string
+	}{
+		{
+			testName: "edited line",
+			patch:    simpleDiff,
+			expected: `diff --git a/filename b/filename
+index dcd3485..1ba5540 100644
+--- a/filename
++++ b/filename
+@@ -1,5 +1,5 @@
+ 1 apple   │  1 apple
+-2 orange  │ +2 grape
+ 3 ...     │  3 ...
+ 4 ...     │  4 ...
+ 5 ...     │  5 ...
+`,
+		},
+		{
+			testName: "blocks of different lengths and a blank context line",
+			patch: "diff --git a/filename b/filename\n" +
+				"index dcd3485..1ba5540 100644\n" +
+				"--- a/filename\n" +
+				"+++ b/filename\n" +
+				"@@ -1,6 +1,5 @@\n" +
+				" a\n" +
+				"\n" +
+				"-b\n" +
+				"-c\n" +
+				"-d\n" +
+				"+B\n" +
+				"+C\n" +
+				" e\n" +
+				"+f\n",
+			expected: "diff --git a/filename b/filename\n" +
+				"index dcd3485..1ba5540 100644\n" +
+				"--- a/filename\n" +
+				"+++ b/filename\n" +
+				"@@ -1,6 +1,6 @@\n" +
+				" 1 a       │  1 a\n" +
+				" 2         │  2 \n" +
+				"-3 b       │ +3 B\n" +
+				"-4 c       │ +4 C\n" +
+				"-5 d       │ \n" +
+				" 6 e       │  5 e\n" +
+				"           │ +6 f\n",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			result := Parse(s.patch).FormatView(FormatViewOpts{SideBySideWidth: 24, TabWidth: 4})
+			assert.Equal(t, s.expected, result)
+		})
+	}
+}
+
+func TestSideBySideLineIndices(t *testing.T) {
+	patch := Parse(`diff --git a/filename b/filename
+index dcd3485..1ba5540 100644
+--- a/filename
++++ b/filename
+@@ -1,4 +1,3 @@
+ a
+-b
+-c
++B
+ d
+-e
+\ No newline at end of file
++E
+\ No newline at end of file
+`)
+
+	viewLineIndices, patchLineIndices := patch.SideBySideLineIndices()
+
+	// each deleted line shares its row with the added line next to it, and the
+	// "no newline" messages get rows of their own after their block
+	assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 6, 7, 6, 8, 9, 10, 9, 11}, viewLineIndices)
+	assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 6, 7, 9, 10, 11, 13}, patchLineIndices)
+}
+
+func TestFormatViewSyntaxHighlighting(t *testing.T) {
+	oldColorLevel := color.ForceSetColorLevel(terminfo.ColorLevelMillions)
+	defer color.ForceSetColorLevel(oldColorLevel)
+
+	oldHighlighter := theme.SyntaxHighlighter
+	theme.SyntaxHighlighter = syntax.NewHighlighter("monokai")
+	defer func() { theme.SyntaxHighlighter = oldHighlighter }()
+
+	patch := Parse(`diff --git a/main.go b/main.go
+index dcd3485..1ba5540 100644
+--- a/main.go
++++ b/main.go
+@@ -1,3 +1,3 @@
+ /* a comment
+-spanning return lines */
++spanning two lines */
+ return
+`)
+	keywordStyle := theme.SyntaxHighlighter.HighlightLine("main.go", "return")[0].Style
+	commentStyle := theme.SyntaxHighlighter.HighlightLine("main.go", "/* */")[0].Style
+
+	result := strings.Split(patch.FormatView(FormatViewOpts{HighlightWordDiff: true}), "\n")
+
+	// the header is not highlighted
+	assert.Equal(t, theme.DefaultTextColor.SetBold().Sprint("+")+theme.DefaultTextColor.SetBold().Sprint("++ b/main.go"), result[3])
+	// the comment spanning several lines is recognised in both versions, and
+	// changed words are highlighted on top of the syntax highlighting
+	assert.Equal(t,
+		style.FgRed.Sprint("-")+
+			style.FgRed.MergeStyle(*commentStyle).Sprint("spanning ")+
+			style.FgRed.SetReverse().Sprint("return")+
+			style.FgRed.MergeStyle(*commentStyle).Sprint(" lines */"),
+		result[6])
+	assert.Equal(t,
+		style.FgGreen.Sprint("+")+
+			style.FgGreen.MergeStyle(*commentStyle).Sprint("spanning ")+
+			style.FgGreen.SetReverse().Sprint("two")+
+			style.FgGreen.MergeStyle(*commentStyle).Sprint(" lines */"),
+		result[7])
+	assert.Equal(t,
+		theme.DefaultTextColor.Sprint(" ")+
+			theme.DefaultTextColor.MergeStyle(*keywordStyle).Sprint("return"),
+		result[8])
+}
+
+func TestSyntaxTokensForHunkWithOnlyAdditionsOrDeletions(t *testing.T) {
+	highlighter := syntax.NewHighlighter("monokai")
+	keywordStyle := highlighter.HighlightLine("main.go", "return")[0].Style
+
+	scenarios := []struct {
+		testName string
+		hunk     string
+	}{
+		{
+			testName: "only additions",
+			hunk: `@@ -0,0 +1,2 @@
++return
++return
+`,
+		},
+		{
+			testName: "only deletions",
+			hunk: `@@ -1,2 +0,0 @@
+-return
+-return
+`,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			patch := Parse(`diff --git a/main.go b/main.go
+index dcd3485..1ba5540 100644
+--- a/main.go
++++ b/main.go
+` + s.hunk)
+
+			tokens := syntaxTokensForHunk(highlighter, "main.go", patch.hunks[0])
+			assert.Len(t, tokens, 2)
+			for _, lineTokens := range tokens {
+				assert.Equal(t, keywordStyle, lineTokens[0].Style)
+			}
+		})
+	}
+}
+
+func TestFormatViewSyntaxHighlightingWithBlankContextLine(t *testing.T) {
+	oldColorLevel := color.ForceSetColorLevel(terminfo.ColorLevelMillions)
+	defer color.ForceSetColorLevel(oldColorLevel)
+
+	oldHighlighter := theme.SyntaxHighlighter
+	theme.SyntaxHighlighter = syntax.NewHighlighter("monokai")
+	defer func() { theme.SyntaxHighlighter = oldHighlighter }()
+
+	// with diff.suppressBlankEmpty, blank context lines have no leading space
+	patch := Parse("diff --git a/main.go b/main.go\n" +
+		"index dcd3485..1ba5540 100644\n" +
+		"--- a/main.go\n" +
+		"+++ b/main.go\n" +
+		"@@ -1,3 +1,3 @@\n" +
+		" package main\n" +
+		"\n" +
+		"-return\n" +
+		"+return nil\n")
+	keywordStyle := theme.SyntaxHighlighter.HighlightLine("main.go", "return")[0].Style
+
+	result := strings.Split(patch.FormatView(FormatViewOpts{}), "\n")
+
+	assert.Equal(t, theme.DefaultTextColor.Sprint(""), result[6])
+	assert.Equal(t,
+		style.FgRed.Sprint("-")+style.FgRed.MergeStyle(*keywordStyle).Sprint("return"),
+		result[7])
+}
+
+func TestExpandTabs(t *testing.T) {
+	assert.Equal(t, "    a", expandTabs("\ta", 0, 4))
+	assert.Equal(t, "ab  c", expandTabs("ab\tc", 0, 4))
+	assert.Equal(t, "  c", expandTabs("\tc", 2, 4))
+	assert.Equal(t, "no tabs", expandTabs("no tabs", 3, 4))
+}
+
+func TestChangeLineIndicesMatching(t *testing.T) {
+	scenarios := []struct {
+		testName string
+		patchStr string
+		regex    string
+		expected []int
+	}{
+		{
+			testName: "matches additions and deletions",
+			patchStr: simpleDiff,
+			regex:    "ape|ora",
+			expected: []int{6, 7},
+		},
+		{
+			testName: "ignores context lines",
+			patchStr: simpleDiff,
+			regex:    "apple",
+			expected: []int{},
+		},
+		{
+			testName: "the leading '+' or '-' is not part of the content",
+			patchStr: simpleDiff,
+			regex:    "^grape$",
+			expected: []int{7},
+		},
+		{
+			testName: "multiple hunks",
+			patchStr: twoHunks,
+			regex:    "grape|lemon",
+			expected: []int{6, 16},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			patch := Parse(s.patchStr)
+			assert.Equal(t, s.expected, patch.ChangeLineIndicesMatching(regexp.MustCompile(s.regex)))
+		})
+	}
+}
+
+const zeroContextDiff = `diff --git a/f b/f
+index f00c965..ef8858d 100644
+--- a/f
++++ b/f
+@@ -2 +2 @@
+-2
++two
+@@ -5,0 +6,2 @@
++new a
++new b
+@@ -8 +9,0 @@
+-8
+`
+
+func TestFormatHunksPlain(t *testing.T) {
+	scenarios := []struct {
+		testName           string
+		hunkIndices        []int
+		appliedHunkIndices []int
+		expected           string
+	}{
+		{
+			testName:           "hunks after hunks that are left out",
+			hunkIndices:        []int{0, 2},
+			appliedHunkIndices: []int{},
+			expected: `diff --git a/f b/f
+index f00c965..ef8858d 100644
+--- a/f
++++ b/f
+@@ -2,1 +2 @@
+-2
++two
+@@ -8,1 +7,0 @@
+-8
+`,
+		},
+		{
+			testName:           "hunk between hunks that were already applied",
+			hunkIndices:        []int{1},
+			appliedHunkIndices: []int{0, 2},
+			expected: `diff --git a/f b/f
+index f00c965..ef8858d 100644
+--- a/f
++++ b/f
+@@ -5,0 +6,2 @@
++new a
++new b
+`,
+		},
+		{
+			testName:           "hunk after hunks that were already applied",
+			hunkIndices:        []int{2},
+			appliedHunkIndices: []int{0, 1},
+			expected: `diff --git a/f b/f
+index f00c965..ef8858d 100644
+--- a/f
++++ b/f
+@@ -10,1 +9,0 @@
+-8
+`,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			patch := Parse(zeroContextDiff)
+			assert.Equal(t, s.expected, patch.FormatHunksPlain(s.hunkIndices, s.appliedHunkIndices))
+		})
+	}
+}
+
+func TestEditedHunkPatch(t *testing.T) {
+	scenarios := []struct {
+		testName      string
+		editedBody    string
+		staged        bool
+		expected      string
+		expectedError *EditHunkError
+	}{
+		{
+			testName:   "unstaged hunk with edited added line",
+			editedBody: " apple\n-orange\n+grapefruit\n+kiwi\n ...\n ...\n ...\n",
+			staged:     false,
+			expected: `diff --git a/filename b/filename
+index dcd3485..1ba5540 100644
+--- a/filename
++++ b/filename
+@@ -1,5 +1,6 @@
+ apple
+-orange
++grapefruit
++kiwi
+ ...
+ ...
+ ...
+`,
+		},
+		{
+			testName:   "staged hunk with edited added line",
+			editedBody: " apple\n-orange\n+grapefruit\n ...\n ...\n ...\n",
+			staged:     true,
+			expected: `diff --git a/filename b/filename
+index dcd3485..1ba5540 100644
+--- a/filename
++++ b/filename
+@@ -1,5 +1,5 @@
+ apple
+-grape
++grapefruit
+ ...
+ ...
+ ...
+`,
+		},
+		{
+			testName:   "all added lines removed",
+			editedBody: " apple\n-orange\n ...\n ...\n ...\n",
+			staged:     false,
+			expected: `diff --git a/filename b/filename
+index dcd3485..1ba5540 100644
+--- a/filename
++++ b/filename
+@@ -1,5 +1,4 @@
+ apple
+-orange
+ ...
+ ...
+ ...
+`,
+		},
+		{
+			testName:      "invalid prefix",
+			editedBody:    " apple\n-orange\n+grape\nbanana\n ...\n ...\n",
+			staged:        false,
+			expectedError: &EditHunkError{LineIdx: 3, InvalidPrefix: true},
+		},
+		{
+			testName:      "edited context line",
+			editedBody:    " apple\n-orange\n+grape\n ...\n ...\n ..\n",
+			staged:        false,
+			expectedError: &EditHunkError{LineIdx: 5},
+		},
+		{
+			testName:      "removed deleted line",
+			editedBody:    " apple\n+grape\n ...\n ...\n ...\n",
+			staged:        false,
+			expectedError: &EditHunkError{LineIdx: 2},
+		},
+		{
+			testName:      "missing context line at end",
+			editedBody:    " apple\n-orange\n+grape\n ...\n ...\n",
+			staged:        false,
+			expectedError: &EditHunkError{LineIdx: 5},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			patch := Parse(simpleDiff)
+			result, err := patch.EditedHunkPatch(0, s.editedBody, s.staged)
+			if s.expectedError != nil {
+				assert.Equal(t, s.expectedError, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, s.expected, result)
+		})
+	}
+}
+
+func TestIndicesOfChangesIn(t *testing.T) {
+	scenarios := []struct {
+		testName         string
+		patchStr         string
+		otherPatch       func(patch *Patch) *Patch
+		expected         []int
+		expectedAllFound bool
+	}{
+		{
+			testName: "partial patch created from the same diff",
+			patchStr: twoHunks,
+			otherPatch: func(patch *Patch) *Patch {
+				return patch.Transform(TransformOpts{IncludedLineIndices: []int{6, 15}})
+			},
+			expected:         []int{6, 15},
+			expectedAllFound: true,
+		},
+		{
+			testName: "patch without context",
+			patchStr: twoHunks,
+			otherPatch: func(*Patch) *Patch {
+				return Parse("diff --git a/filename b/filename\n--- a/filename\n+++ b/filename\n@@ -2 +2 @@\n-grape\n+orange\n@@ -10,0 +11 @@\n+pear\n")
+			},
+			expected:         []int{6, 7, 15},
+			expectedAllFound: true,
+		},
+		{
+			testName: "patch with changes that are not part of the diff",
+			patchStr: twoHunks,
+			otherPatch: func(*Patch) *Patch {
+				return Parse("diff --git a/filename b/filename\n--- a/filename\n+++ b/filename\n@@ -1,2 +1,3 @@\n apple\n+banana\n-grape\n+orange\n")
+			},
+			expected:         []int{6, 7},
+			expectedAllFound: false,
+		},
+		{
+			testName: "partial patch of an added file",
+			patchStr: newFile,
+			otherPatch: func(patch *Patch) *Patch {
+				return patch.Transform(TransformOpts{IncludedLineIndices: []int{7}, TurnAddedFilesIntoDiffAgainstEmptyFile: true})
+			},
+			expected:         []int{7},
+			expectedAllFound: true,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			patch := Parse(s.patchStr)
+			result, allFound := patch.IndicesOfChangesIn(s.otherPatch(patch))
+			assert.Equal(t, s.expected, result)
+			assert.Equal(t, s.expectedAllFound, allFound)
+		})
+	}
+}
+
+func TestSplitByFile(t *testing.T) {
+	patchStr := "From 1234 Mon Sep 17 00:00:00 2001\nSubject: [PATCH] test\n\n" + simpleDiff + newFile + deletedFile
+	patches := SplitByFile(patchStr)
+	assert.Equal(t, []string{"filename", "newfile", "newfile"}, lo.Map(patches, func(patch *Patch, _ int) string { return patch.FilePath() }))
+	assert.Equal(t, simpleDiff, patches[0].FormatPlain())
+}
+
+func TestOldAndNewPath(t *testing.T) {
+	scenarios := []struct {
+		testName        string
+		patchStr        string
+		expectedOldPath string
+		expectedNewPath string
+	}{
+		{
+			testName:        "modified file",
+			patchStr:        simpleDiff,
+			expectedOldPath: "filename",
+			expectedNewPath: "filename",
+		},
+		{
+			testName:        "added file",
+			patchStr:        newFile,
+			expectedOldPath: "",
+			expectedNewPath: "newfile",
+		},
+		{
+			testName: "deleted file",
+			patchStr: `diff --git a/oldfile b/oldfile
+deleted file mode 100644
+index 1234567..0000000
+--- a/oldfile
++++ /dev/null
+@@ -1 +0,0 @@
+-line
+`,
+			expectedOldPath: "oldfile",
+			expectedNewPath: "",
+		},
+		{
+			testName: "path starting with the prefix of a file line",
+			patchStr: `diff --git a/a/--- b/b/+++
+index 1234567..89abcde 100644
+--- a/a/---
++++ b/b/+++
+@@ -1 +1 @@
+-old
++new
+`,
+			expectedOldPath: "a/---",
+			expectedNewPath: "b/+++",
+		},
+		{
+			testName: "quoted paths with spaces and special characters",
+			patchStr: `diff --git "a/caf\303\251 menu.txt" "b/caf\303\251 menu.txt"
+index 1234567..89abcde 100644
+--- "a/caf\303\251 menu.txt"	
++++ "b/caf\303\251 menu.txt"	
+@@ -1 +1 @@
+-old
++new
+`,
+			expectedOldPath: "café menu.txt",
+			expectedNewPath: "café menu.txt",
+		},
+		{
+			testName: "unquoted path with spaces",
+			patchStr: "diff --git a/my file.txt b/my file.txt\n" +
+				"index 1234567..89abcde 100644\n" +
+				"--- a/my file.txt\t\n" +
+				"+++ b/my file.txt\t\n" +
+				"@@ -1 +1 @@\n" +
+				"-old\n" +
+				"+new\n",
+			expectedOldPath: "my file.txt",
+			expectedNewPath: "my file.txt",
+		},
+		{
+			testName: "renamed file without changes",
+			patchStr: `diff --git a/old name b/new name
+similarity index 100%
+rename from old name
+rename to new name
+`,
+			expectedOldPath: "old name",
+			expectedNewPath: "new name",
+		},
+		{
+			testName: "binary file",
+			patchStr: `diff --git a/image b/image
+index 1234567..89abcde 100644
+Binary files a/image and b/image differ
+`,
+			expectedOldPath: "image",
+			expectedNewPath: "image",
+		},
+		{
+			testName: "added binary file",
+			patchStr: `diff --git "a/\303\251.png" "b/\303\251.png"
+new file mode 100644
+index 0000000..89abcde
+Binary files /dev/null and "b/\303\251.png" differ
+`,
+			expectedOldPath: "",
+			expectedNewPath: "é.png",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			patch := Parse(s.patchStr)
+			assert.Equal(t, s.expectedOldPath, patch.OldPath())
+			assert.Equal(t, s.expectedNewPath, patch.NewPath())
+		})
+	}
+}
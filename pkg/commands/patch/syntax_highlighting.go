@@ -0,0 +1,93 @@
+package patch
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/syntax"
+)
+
+// Returns the syntax highlighting tokens of the content of each body line of
+// the hunk (excluding the leading marker), or nil if no highlighting should be
+// done. The old and the new version of the hunk are highlighted separately,
+// since mixing added and deleted lines would confuse the lexer.
+func syntaxTokensForHunk(highlighter *syntax.Highlighter, fileName string, hunk *Hunk) [][]syntax.Token {
+	if highlighter == nil {
+		return nil
+	}
+
+	result := make([][]syntax.Token, len(hunk.bodyLines))
+	for _, kinds := range [][]PatchLineKind{{CONTEXT, DELETION}, {CONTEXT, ADDITION}} {
+		indices := []int{}
+		lines := []string{}
+		for i, line := range hunk.bodyLines {
+			if line.Kind == kinds[0] || line.Kind == kinds[1] {
+				indices = append(indices, i)
+				lines = append(lines, line.contentWithoutMarker())
+			}
+		}
+
+		// e.g. the deletions of a hunk that only adds lines
+		if len(lines) == 0 {
+			continue
+		}
+
+		tokens := highlighter.Highlight(fileName, lines)
+		if tokens == nil {
+			return nil
+		}
+		for i, idx := range indices {
+			result[idx] = tokens[i]
+		}
+	}
+
+	return result
+}
+
+type styledSegment struct {
+	text  string
+	style style.TextStyle
+}
+
+// Splits the content of a line (excluding the leading marker) into parts that
+// are rendered in different styles. Syntax highlighting is layered beneath the
+// colouring of the diff: tokens are rendered in their syntax colour unless
+// they are part of a changed word, which is highlighted the same way as
+// without syntax highlighting.
+func contentSegments(content string, textStyle style.TextStyle, tokens []syntax.Token, wordDiffSpans []wordDiffSpan) []styledSegment {
+	changedStyle := textStyle.SetReverse()
+	segments := []styledSegment{}
+	tokenIdx, tokenOffset := 0, 0
+	for _, wordSegment := range splitByWordDiffSpans(content, wordDiffSpans) {
+		if wordSegment.changed {
+			segments = append(segments, styledSegment{text: wordSegment.text, style: changedStyle})
+		} else if tokens == nil {
+			segments = append(segments, styledSegment{text: wordSegment.text, style: textStyle})
+			continue
+		}
+
+		// the tokens add up to the content, so we need to keep track of where
+		// we are in them even for the changed segments that we don't highlight
+		text := wordSegment.text
+		for text != "" && tokenIdx < len(tokens) {
+			token := tokens[tokenIdx]
+			n := min(len(text), len(token.Text)-tokenOffset)
+			if !wordSegment.changed {
+				segmentStyle := textStyle
+				if token.Style != nil {
+					segmentStyle = textStyle.MergeStyle(*token.Style)
+				}
+				segments = append(segments, styledSegment{text: text[:n], style: segmentStyle})
+			}
+
+			text = text[n:]
+			tokenOffset += n
+			if tokenOffset == len(token.Text) {
+				tokenIdx++
+				tokenOffset = 0
+			}
+		}
+		if text != "" && !wordSegment.changed {
+			segments = append(segments, styledSegment{text: text, style: textStyle})
+		}
+	}
+	return segments
+}
@@ -0,0 +1,226 @@
+package patch
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// A range of bytes within the content of a patch line (excluding the leading
+// '+' or '-') that differs from the line it was paired with
+type wordDiffSpan struct {
+	start int
+	end   int
+}
+
+// Above this number of token comparisons we don't bother computing a word
+// diff, to keep rendering of huge lines fast
+const maxWordDiffComparisons = 100_000
+
+// Pairs up the deleted and added lines of a hunk and returns, for every line
+// that got paired, the spans that were changed. Lines are paired in order
+// within each block of deletions that is directly followed by a block of
+// additions, which is what we get for lines that were edited in place.
+// Keys are indices into the given lines.
+func wordDiffSpansForHunkLines(lines []*PatchLine) map[int][]wordDiffSpan {
+	result := map[int][]wordDiffSpan{}
+
+	for i := 0; i < len(lines); {
+		deletions, additionsStart := collectLinesOfKind(lines, i, DELETION)
+		additions, next := collectLinesOfKind(lines, additionsStart, ADDITION)
+		if len(deletions) == 0 || len(additions) == 0 {
+			i = max(next, i+1)
+			continue
+		}
+
+		for j := range min(len(deletions), len(additions)) {
+			delIdx, addIdx := deletions[j], additions[j]
+			oldSpans, newSpans, ok := wordDiffSpans(lines[delIdx].Content[1:], lines[addIdx].Content[1:])
+			if ok {
+				result[delIdx] = oldSpans
+				result[addIdx] = newSpans
+			}
+		}
+
+		i = next
+	}
+
+	return result
+}
+
+// Returns the indices of the consecutive lines of the given kind starting at
+// startIdx, and the index of the first line after them. "No newline at end of
+// file" markers are skipped over since git puts them right after the line
+// they belong to.
+func collectLinesOfKind(lines []*PatchLine, startIdx int, kind PatchLineKind) ([]int, int) {
+	result := []int{}
+	i := startIdx
+	for ; i < len(lines); i++ {
+		if lines[i].Kind == NEWLINE_MESSAGE && len(result) > 0 {
+			continue
+		}
+		if lines[i].Kind != kind {
+			break
+		}
+		result = append(result, i)
+	}
+	return result, i
+}
+
+// Computes the changed spans of two versions of a line, based on the longest
+// common subsequence of their tokens. Returns false if the lines have so
+// little in common that highlighting the differences would just be noise.
+func wordDiffSpans(oldLine string, newLine string) ([]wordDiffSpan, []wordDiffSpan, bool) {
+	oldTokens := tokenizeForWordDiff(oldLine)
+	newTokens := tokenizeForWordDiff(newLine)
+	if len(oldTokens) == 0 || len(newTokens) == 0 || len(oldTokens)*len(newTokens) > maxWordDiffComparisons {
+		return nil, nil, false
+	}
+
+	oldCommon, newCommon := commonTokens(oldTokens, newTokens)
+
+	commonLength := 0
+	for i, common := range oldCommon {
+		if common && !isWhitespaceToken(oldTokens[i]) {
+			commonLength += len(oldTokens[i])
+		}
+	}
+	if commonLength*3 < min(nonWhitespaceLength(oldTokens), nonWhitespaceLength(newTokens)) {
+		return nil, nil, false
+	}
+
+	return changedSpans(oldTokens, oldCommon), changedSpans(newTokens, newCommon), true
+}
+
+// Marks the tokens that are part of the longest common subsequence
+func commonTokens(a []string, b []string) ([]bool, []bool) {
+	// lengths[i][j] is the length of the LCS of a[i:] and b[j:]
+	lengths := make([][]int, len(a)+1)
+	for i := range lengths {
+		lengths[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			} else {
+				lengths[i][j] = max(lengths[i+1][j], lengths[i][j+1])
+			}
+		}
+	}
+
+	aCommon := make([]bool, len(a))
+	bCommon := make([]bool, len(b))
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		switch {
+		case a[i] == b[j]:
+			aCommon[i] = true
+			bCommon[j] = true
+			i++
+			j++
+		case lengths[i+1][j] >= lengths[i][j+1]:
+			i++
+		default:
+			j++
+		}
+	}
+
+	return aCommon, bCommon
+}
+
+// Turns the tokens that aren't common into byte spans, merging adjacent ones.
+// Whitespace between two changed tokens is included so that a changed phrase
+// is highlighted as a whole.
+func changedSpans(tokens []string, common []bool) []wordDiffSpan {
+	spans := []wordDiffSpan{}
+	offset := 0
+	for i, token := range tokens {
+		start, end := offset, offset+len(token)
+		offset = end
+
+		if common[i] {
+			if isWhitespaceToken(token) && i > 0 && i < len(tokens)-1 && !common[i-1] && !common[i+1] {
+				spans[len(spans)-1].end = end
+			}
+			continue
+		}
+
+		if len(spans) > 0 && spans[len(spans)-1].end == start {
+			spans[len(spans)-1].end = end
+		} else {
+			spans = append(spans, wordDiffSpan{start: start, end: end})
+		}
+	}
+	return spans
+}
+
+type wordDiffSegment struct {
+	text    string
+	changed bool
+}
+
+// Splits the content of a line into the parts that are covered by the given
+// spans and the ones in between
+func splitByWordDiffSpans(content string, spans []wordDiffSpan) []wordDiffSegment {
+	segments := []wordDiffSegment{}
+	offset := 0
+	for _, span := range spans {
+		if span.start > offset {
+			segments = append(segments, wordDiffSegment{text: content[offset:span.start]})
+		}
+		segments = append(segments, wordDiffSegment{text: content[span.start:span.end], changed: true})
+		offset = span.end
+	}
+	if offset < len(content) {
+		segments = append(segments, wordDiffSegment{text: content[offset:]})
+	}
+	return segments
+}
+
+// Splits a line into words, runs of whitespace, and individual punctuation
+// characters
+func tokenizeForWordDiff(line string) []string {
+	tokens := []string{}
+	for i := 0; i < len(line); {
+		r, size := utf8.DecodeRuneInString(line[i:])
+		end := i + size
+		switch {
+		case isWordRune(r):
+			end = scanWhile(line, end, isWordRune)
+		case unicode.IsSpace(r):
+			end = scanWhile(line, end, unicode.IsSpace)
+		}
+		tokens = append(tokens, line[i:end])
+		i = end
+	}
+	return tokens
+}
+
+func scanWhile(line string, i int, pred func(rune) bool) int {
+	for i < len(line) {
+		r, size := utf8.DecodeRuneInString(line[i:])
+		if !pred(r) {
+			break
+		}
+		i += size
+	}
+	return i
+}
+
+func isWordRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+func isWhitespaceToken(token string) bool {
+	r, _ := utf8.DecodeRuneInString(token)
+	return unicode.IsSpace(r)
+}
+
+func nonWhitespaceLength(tokens []string) int {
+	result := 0
+	for _, token := range tokens {
+		if !isWhitespaceToken(token) {
+			result += len(token)
+		}
+	}
+	return result
+}
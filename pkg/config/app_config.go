@@ -1,6 +1,8 @@
 package config
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"log"
@@ -704,12 +706,63 @@ type AppState struct {
 	ShellCommandsHistory []string `yaml:"customcommandshistory"`
 
 	HideCommandLog bool
+
+	// The state of the gui in the most recently used repos, most recent first,
+	// so that lazygit reopens a repo where it was left
+	RepoSessions []*RepoSession
+
+	// Recorded keyboard macros by register, as lists of key labels (e.g. "j",
+	// "<enter>")
+	Macros map[string][]string
+}
+
+// The state of the gui in a repo at the time lazygit was quit or switched to a
+// different repo
+type RepoSession struct {
+	// Path of the worktree
+	Repo string
+	// Key of the focused side panel
+	CurrentContext string
+	// ID of the selected item (e.g. commit hash, branch name, or file path) by
+	// context key
+	SelectedItems map[string]string
+	// "normal", "half" or "full"
+	ScreenMode   string
+	FilterPath   string
+	FilterAuthor string
+	DiffRef      string
+	DiffReverse  bool
+	// Collapsed directories of the files panel
+	CollapsedPaths []string
+	// Search and filter strings by context key, most recent first
+	SearchHistory map[string][]string
+}
+
+// The maximum number of repos whose session we remember
+const maxRepoSessions = 20
+
+func (self *AppState) GetRepoSession(repo string) *RepoSession {
+	session, _ := lo.Find(self.RepoSessions, func(session *RepoSession) bool { return session.Repo == repo })
+	return session
+}
+
+// Adds or replaces the session of the session's repo
+func (self *AppState) SetRepoSession(session *RepoSession) {
+	sessions := lo.Reject(self.RepoSessions, func(s *RepoSession, _ int) bool { return s.Repo == session.Repo })
+	sessions = append([]*RepoSession{session}, sessions...)
+	self.RepoSessions = sessions[:min(len(sessions), maxRepoSessions)]
 }
 
 func getDefaultAppState() *AppState {
 	return &AppState{}
 }
 
+// The file that the command log of the repo at the given path is persisted to
+func CommandLogPath(repoPath string) (string, error) {
+	hash := sha256.Sum256([]byte(repoPath))
+	return stateFilePath(filepath.Join("command_logs", hex.EncodeToString(hash[:8])+".jsonl"))
+}
+
 func LogPath() (string, error) {
 	if os.Getenv("LAZYGIT_LOG_PATH") != "" {
 		return os.Getenv("LAZYGIT_LOG_PATH"), nil
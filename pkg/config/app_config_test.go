@@ -1,6 +1,7 @@
 package config
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -1184,3 +1185,21 @@ func TestPagerMigration(t *testing.T) {
 		})
 	}
 }
+
+func TestSetRepoSession(t *testing.T) {
+	appState := &AppState{}
+	for i := range maxRepoSessions + 2 {
+		appState.SetRepoSession(&RepoSession{Repo: fmt.Sprintf("repo%d", i)})
+	}
+
+	assert.Len(t, appState.RepoSessions, maxRepoSessions)
+	assert.Equal(t, fmt.Sprintf("repo%d", maxRepoSessions+1), appState.RepoSessions[0].Repo)
+	assert.Nil(t, appState.GetRepoSession("repo0"))
+	assert.Nil(t, appState.GetRepoSession("repo1"))
+
+	appState.SetRepoSession(&RepoSession{Repo: "repo5", CurrentContext: "commits"})
+
+	assert.Len(t, appState.RepoSessions, maxRepoSessions)
+	assert.Equal(t, "repo5", appState.RepoSessions[0].Repo)
+	assert.Equal(t, "commits", appState.GetRepoSession("repo5").CurrentContext)
+}
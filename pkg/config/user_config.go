@@ -15,6 +15,11 @@ type UserConfig struct {
 	Update UpdateConfig `yaml:"update"`
 	// Background refreshes
 	Refresher RefresherConfig `yaml:"refresher"`
+	// Other repositories to show in the workspace panel, next to the files panel
+	Workspace WorkspaceConfig `yaml:"workspace"`
+	// Starlark scripts that add keybindings and menu items
+	// See https://github.com/jesseduffield/lazygit/blob/master/docs/Plugins.md
+	Plugins PluginsConfig `yaml:"plugins"`
 	// If true, show a confirmation popup before quitting Lazygit
 	ConfirmOnQuit bool `yaml:"confirmOnQuit"`
 	// If true, exit Lazygit when the user presses escape in a context where there is nothing to cancel/close
@@ -51,6 +56,35 @@ type RefresherConfig struct {
 	FetchInterval int `yaml:"fetchInterval" jsonschema:"minimum=0"`
 }
 
+type WorkspaceConfig struct {
+	// Paths of repositories to show in the workspace panel. A leading '~' is
+	// expanded to the home directory; relative paths are relative to the
+	// current repo.
+	Repos []string `yaml:"repos"`
+	// Directories whose direct subdirectories are shown in the workspace panel
+	// if they are git repositories.
+	ScanDirs []string `yaml:"scanDirs"`
+	// Refresh interval of the workspace panel in seconds. The panel is only
+	// shown if at least one repo or scan dir is configured.
+	// Background refreshing of the panel can be disabled by setting this to 0.
+	RefreshInterval int `yaml:"refreshInterval" jsonschema:"minimum=0"`
+}
+
+// The workspace panel is only shown if there is something to show in it
+func (c *WorkspaceConfig) IsEnabled() bool {
+	return len(c.Repos) > 0 || len(c.ScanDirs) > 0
+}
+
+// Controls which Starlark plugins are loaded to add keybindings and menu items
+type PluginsConfig struct {
+	// If false, no plugins are loaded.
+	Enabled bool `yaml:"enabled"`
+	// If true, plugins are also loaded from the '.lazygit/plugins' directory of
+	// the worktree. Since these are part of the repo's content, only enable this
+	// for repos you trust, by setting it in the repo's '.git/lazygit.yml'.
+	LoadFromWorktree bool `yaml:"loadFromWorktree"`
+}
+
 type GuiConfig struct {
 	// See https://github.com/jesseduffield/lazygit/blob/master/docs/Config.md#custom-author-color
 	AuthorColors map[string]string `yaml:"authorColors"`
@@ -110,6 +144,10 @@ type GuiConfig struct {
 	WrapLinesInStagingView bool `yaml:"wrapLinesInStagingView"`
 	// If true, hunk selection mode will be enabled by default when entering the staging view.
 	UseHunkModeInStagingView bool `yaml:"useHunkModeInStagingView"`
+	// If true, the words that changed within an edited line are highlighted in the staging and custom patch views. This makes small edits in long lines (e.g. in prose or config files) much easier to spot.
+	HighlightWordDiff bool `yaml:"highlightWordDiff"`
+	// If true, the staging and custom patch views show the old version of the file on the left and the new version on the right, rather than a unified diff. Can be toggled for the current session with the `toggleSideBySideView` keybinding.
+	SideBySideStagingView bool `yaml:"sideBySideStagingView"`
 	// One of 'auto' (default) | 'en' | 'zh-CN' | 'zh-TW' | 'pl' | 'nl' | 'ja' | 'ko' | 'ru' | 'pt'
 	Language string `yaml:"language" jsonschema:"enum=auto,enum=en,enum=zh-TW,enum=zh-CN,enum=pl,enum=nl,enum=ja,enum=ko,enum=ru"`
 	// Format used when displaying time e.g. commit time.
@@ -222,6 +260,12 @@ type ThemeConfig struct {
 	UnstagedChangesColor []string `yaml:"unstagedChangesColor" jsonschema:"minItems=1,uniqueItems=true"`
 	// Default text color
 	DefaultFgColor []string `yaml:"defaultFgColor" jsonschema:"minItems=1,uniqueItems=true"`
+	// Style used for syntax highlighting of diffs when no pager is configured
+	// (see 'git.pagers'), e.g. 'monokai', 'dracula' or 'github-dark'. Syntax
+	// highlighting is shown beneath the colouring of added and removed lines.
+	// Leave empty to disable it.
+	// See https://xyproto.github.io/splash/docs/ for a preview of the available styles.
+	SyntaxHighlighting string `yaml:"syntaxHighlighting"`
 }
 
 type CommitLengthConfig struct {
@@ -267,6 +311,8 @@ type GitConfig struct {
 	Merging MergingConfig `yaml:"merging"`
 	// list of branches that are considered 'main' branches, used when displaying commits
 	MainBranches []string `yaml:"mainBranches" jsonschema:"uniqueItems=true"`
+	// Number of days without new commits after which the branch cleanup menu suggests deleting a branch. Set to 0 to disable.
+	StaleBranchDays int `yaml:"staleBranchDays" jsonschema:"minimum=0"`
 	// Prefix to use when skipping hooks. E.g. if set to 'WIP', then pre-commit hooks will be skipped when the commit message starts with 'WIP'
 	SkipHookPrefix string `yaml:"skipHookPrefix"`
 	// If true, periodically fetch from remote
@@ -305,6 +351,8 @@ type GitConfig struct {
 	ParseEmoji bool `yaml:"parseEmoji"`
 	// Config for showing the log in the commits view
 	Log LogConfig `yaml:"log"`
+	// Config for getting the status of the working tree in the files view
+	Status StatusConfig `yaml:"status"`
 	// How branches are sorted in the local branches view.
 	// One of: 'date' (default) | 'recency' | 'alphabetical'
 	// Can be changed from within Lazygit with the Sort Order menu (`s`) in the branches panel.
@@ -376,6 +424,23 @@ type LogConfig struct {
 	ShowWholeGraph bool `yaml:"showWholeGraph"`
 }
 
+type StatusConfig struct {
+	// Which untracked files to show in the files view.
+	// One of: '' (use git's `status.showUntrackedFiles` config, or show all if that isn't set) | 'no' | 'normal' | 'all'
+	// 'normal' shows untracked directories without the files in them, which is much faster if they contain lots of files.
+	//
+	// Can be changed from within Lazygit with the untracked files menu (`U` in the files view by default).
+	UntrackedFiles string `yaml:"untrackedFiles" jsonschema:"enum=,enum=no,enum=normal,enum=all"`
+	// When showing all untracked files, untracked directories are shown collapsed, without the files in them, if they contain more than this many files altogether. This is useful for big directories of build output that aren't ignored; you may want to set it in the repo's '.git/lazygit.yml'. Set to 0 to always show all files.
+	MaxUntrackedFiles int `yaml:"maxUntrackedFiles" jsonschema:"minimum=0"`
+	// If true, use git's builtin file system monitor to speed up getting the status in big repos. This starts a background process per repo that watches for changes. Only supported on macOS and Windows, with git 2.37 or later.
+	UseFsmonitor bool `yaml:"useFsmonitor"`
+	// If true, use git's untracked cache to speed up finding untracked files in big repos. This requires a file system that updates the modification time of directories when files are added or removed in them, which most do.
+	UseUntrackedCache bool `yaml:"useUntrackedCache"`
+	// If getting the status takes at least this many milliseconds, show how long it took in the bottom line. Set to 0 to never show it.
+	SlowStatusThreshold int `yaml:"slowStatusThreshold" jsonschema:"minimum=0"`
+}
+
 type CommitPrefixConfig struct {
 	// pattern to match on. E.g. for 'feature/AB-123' to match on the AB-123 use "^\\w+\\/(\\w+-\\w+).*"
 	Pattern string `yaml:"pattern" jsonschema:"example=^\\w+\\/(\\w+-\\w+).*"`
@@ -402,7 +467,9 @@ type KeybindingConfig struct {
 	CommitFiles    KeybindingCommitFilesConfig    `yaml:"commitFiles"`
 	Main           KeybindingMainConfig           `yaml:"main"`
 	Submodules     KeybindingSubmodulesConfig     `yaml:"submodules"`
+	Workspace      KeybindingWorkspaceConfig      `yaml:"workspace"`
 	CommitMessage  KeybindingCommitMessageConfig  `yaml:"commitMessage"`
+	CommandLog     KeybindingCommandLogConfig     `yaml:"commandLog"`
 }
 
 // damn looks like we have some inconsistencies here with -alt and -alt1
@@ -472,6 +539,11 @@ type KeybindingUniversalConfig struct {
 	Undo                              string   `yaml:"undo"`
 	Redo                              string   `yaml:"redo"`
 	FilteringMenu                     string   `yaml:"filteringMenu"`
+	OpenGlobalFinder                  string   `yaml:"openGlobalFinder"`
+	OpenCommandPalette                string   `yaml:"openCommandPalette"`
+	OpenPluginsMenu                   string   `yaml:"openPluginsMenu"`
+	RecordMacro                       string   `yaml:"recordMacro"`
+	ReplayMacro                       string   `yaml:"replayMacro"`
 	DiffingMenu                       string   `yaml:"diffingMenu"`
 	DiffingMenuAlt                    string   `yaml:"diffingMenu-alt"`
 	CopyToClipboard                   string   `yaml:"copyToClipboard"`
@@ -493,25 +565,27 @@ type KeybindingStatusConfig struct {
 }
 
 type KeybindingFilesConfig struct {
-	CommitChanges            string `yaml:"commitChanges"`
-	CommitChangesWithoutHook string `yaml:"commitChangesWithoutHook"`
-	AmendLastCommit          string `yaml:"amendLastCommit"`
-	CommitChangesWithEditor  string `yaml:"commitChangesWithEditor"`
-	FindBaseCommitForFixup   string `yaml:"findBaseCommitForFixup"`
-	ConfirmDiscard           string `yaml:"confirmDiscard"`
-	IgnoreFile               string `yaml:"ignoreFile"`
-	RefreshFiles             string `yaml:"refreshFiles"`
-	StashAllChanges          string `yaml:"stashAllChanges"`
-	ViewStashOptions         string `yaml:"viewStashOptions"`
-	ToggleStagedAll          string `yaml:"toggleStagedAll"`
-	ViewResetOptions         string `yaml:"viewResetOptions"`
-	Fetch                    string `yaml:"fetch"`
-	ToggleTreeView           string `yaml:"toggleTreeView"`
-	OpenMergeOptions         string `yaml:"openMergeOptions"`
-	OpenStatusFilter         string `yaml:"openStatusFilter"`
-	CopyFileInfoToClipboard  string `yaml:"copyFileInfoToClipboard"`
-	CollapseAll              string `yaml:"collapseAll"`
-	ExpandAll                string `yaml:"expandAll"`
+	CommitChanges             string `yaml:"commitChanges"`
+	CommitChangesWithoutHook  string `yaml:"commitChangesWithoutHook"`
+	AmendLastCommit           string `yaml:"amendLastCommit"`
+	CommitChangesWithEditor   string `yaml:"commitChangesWithEditor"`
+	FindBaseCommitForFixup    string `yaml:"findBaseCommitForFixup"`
+	AbsorbStagedChanges       string `yaml:"absorbStagedChanges"`
+	ConfirmDiscard            string `yaml:"confirmDiscard"`
+	IgnoreFile                string `yaml:"ignoreFile"`
+	RefreshFiles              string `yaml:"refreshFiles"`
+	StashAllChanges           string `yaml:"stashAllChanges"`
+	ViewStashOptions          string `yaml:"viewStashOptions"`
+	ViewUntrackedFilesOptions string `yaml:"viewUntrackedFilesOptions"`
+	ToggleStagedAll           string `yaml:"toggleStagedAll"`
+	ViewResetOptions          string `yaml:"viewResetOptions"`
+	Fetch                     string `yaml:"fetch"`
+	ToggleTreeView            string `yaml:"toggleTreeView"`
+	OpenMergeOptions          string `yaml:"openMergeOptions"`
+	OpenStatusFilter          string `yaml:"openStatusFilter"`
+	CopyFileInfoToClipboard   string `yaml:"copyFileInfoToClipboard"`
+	CollapseAll               string `yaml:"collapseAll"`
+	ExpandAll                 string `yaml:"expandAll"`
 }
 
 type KeybindingBranchesConfig struct {
@@ -527,10 +601,18 @@ type KeybindingBranchesConfig struct {
 	MoveCommitsToNewBranch string `yaml:"moveCommitsToNewBranch"`
 	ViewGitFlowOptions     string `yaml:"viewGitFlowOptions"`
 	FastForward            string `yaml:"fastForward"`
+	CleanUpBranches        string `yaml:"cleanUpBranches"`
+	ViewStackOptions       string `yaml:"viewStackOptions"`
 	CreateTag              string `yaml:"createTag"`
 	PushTag                string `yaml:"pushTag"`
+	CreateSignedTag        string `yaml:"createSignedTag"`
+	CreateNextVersionTag   string `yaml:"createNextVersionTag"`
+	EditTagMessage         string `yaml:"editTagMessage"`
 	SetUpstream            string `yaml:"setUpstream"`
 	FetchRemote            string `yaml:"fetchRemote"`
+	PruneRemote            string `yaml:"pruneRemote"`
+	EditRemotePushUrl      string `yaml:"editRemotePushUrl"`
+	SetRemoteHead          string `yaml:"setRemoteHead"`
 	SortOrder              string `yaml:"sortOrder"`
 }
 
@@ -549,6 +631,7 @@ type KeybindingCommitsConfig struct {
 	MoveDownCommit                 string `yaml:"moveDownCommit"`
 	MoveUpCommit                   string `yaml:"moveUpCommit"`
 	AmendToCommit                  string `yaml:"amendToCommit"`
+	SplitCommit                    string `yaml:"splitCommit"`
 	ResetCommitAuthor              string `yaml:"resetCommitAuthor"`
 	PickCommit                     string `yaml:"pickCommit"`
 	RevertCommit                   string `yaml:"revertCommit"`
@@ -560,6 +643,7 @@ type KeybindingCommitsConfig struct {
 	ResetCherryPick                string `yaml:"resetCherryPick"`
 	CopyCommitAttributeToClipboard string `yaml:"copyCommitAttributeToClipboard"`
 	OpenLogMenu                    string `yaml:"openLogMenu"`
+	ToggleWholeGitGraph            string `yaml:"toggleWholeGitGraph"`
 	OpenInBrowser                  string `yaml:"openInBrowser"`
 	ViewBisectOptions              string `yaml:"viewBisectOptions"`
 	StartInteractiveRebase         string `yaml:"startInteractiveRebase"`
@@ -579,12 +663,16 @@ type KeybindingStashConfig struct {
 
 type KeybindingCommitFilesConfig struct {
 	CheckoutCommitFile string `yaml:"checkoutCommitFile"`
+	LoadCustomPatch    string `yaml:"loadCustomPatch"`
 }
 
 type KeybindingMainConfig struct {
-	ToggleSelectHunk string `yaml:"toggleSelectHunk"`
-	PickBothHunks    string `yaml:"pickBothHunks"`
-	EditSelectHunk   string `yaml:"editSelectHunk"`
+	ToggleSelectHunk     string `yaml:"toggleSelectHunk"`
+	PickBothHunks        string `yaml:"pickBothHunks"`
+	EditSelectHunk       string `yaml:"editSelectHunk"`
+	EditHunkInline       string `yaml:"editHunkInline"`
+	ToggleSideBySideView string `yaml:"toggleSideBySideView"`
+	StageMatchingLines   string `yaml:"stageMatchingLines"`
 }
 
 type KeybindingSubmodulesConfig struct {
@@ -593,10 +681,20 @@ type KeybindingSubmodulesConfig struct {
 	BulkMenu string `yaml:"bulkMenu"`
 }
 
+type KeybindingWorkspaceConfig struct {
+	FetchAllRepos string `yaml:"fetchAllRepos"`
+	PullAllRepos  string `yaml:"pullAllRepos"`
+}
+
 type KeybindingCommitMessageConfig struct {
 	CommitMenu string `yaml:"commitMenu"`
 }
 
+type KeybindingCommandLogConfig struct {
+	RerunCommand string `yaml:"rerunCommand"`
+	ExportLog    string `yaml:"exportLog"`
+}
+
 // OSConfig contains config on the level of the os
 type OSConfig struct {
 	// Command for editing a file. Should contain "{{filename}}".
@@ -776,6 +874,8 @@ func GetDefaultConfig() *UserConfig {
 			EnlargedSideViewLocation: "left",
 			WrapLinesInStagingView:   true,
 			UseHunkModeInStagingView: true,
+			HighlightWordDiff:        true,
+			SideBySideStagingView:    false,
 			Language:                 "auto",
 			TimeFormat:               "02 Jan 06",
 			ShortTimeFormat:          time.Kitchen,
@@ -845,10 +945,18 @@ func GetDefaultConfig() *UserConfig {
 				ShowGraph:      "always",
 				ShowWholeGraph: false,
 			},
+			Status: StatusConfig{
+				UntrackedFiles:      "",
+				MaxUntrackedFiles:   0,
+				UseFsmonitor:        false,
+				UseUntrackedCache:   false,
+				SlowStatusThreshold: 1000,
+			},
 			LocalBranchSortOrder:         "date",
 			RemoteBranchSortOrder:        "date",
 			SkipHookPrefix:               "WIP",
 			MainBranches:                 []string{"master", "main"},
+			StaleBranchDays:              90,
 			AutoFetch:                    true,
 			AutoRefresh:                  true,
 			AutoForwardBranches:          "onlyMainBranches",
@@ -869,6 +977,15 @@ func GetDefaultConfig() *UserConfig {
 			RefreshInterval: 10,
 			FetchInterval:   60,
 		},
+		Workspace: WorkspaceConfig{
+			Repos:           []string{},
+			ScanDirs:        []string{},
+			RefreshInterval: 30,
+		},
+		Plugins: PluginsConfig{
+			Enabled:          true,
+			LoadFromWorktree: false,
+		},
 		Update: UpdateConfig{
 			Method: "prompt",
 			Days:   14,
@@ -949,6 +1066,11 @@ func GetDefaultConfig() *UserConfig {
 				Undo:                              "z",
 				Redo:                              "Z",
 				FilteringMenu:                     "<c-s>",
+				OpenGlobalFinder:                  "<c-g>",
+				OpenCommandPalette:                "<c-x>",
+				OpenPluginsMenu:                   "!",
+				RecordMacro:                       "<c-q>",
+				ReplayMacro:                       "<c-a>",
 				DiffingMenu:                       "W",
 				DiffingMenuAlt:                    "<c-e>",
 				CopyToClipboard:                   "<c-o>",
@@ -967,25 +1089,27 @@ func GetDefaultConfig() *UserConfig {
 				AllBranchesLogGraph: "a",
 			},
 			Files: KeybindingFilesConfig{
-				CommitChanges:            "c",
-				CommitChangesWithoutHook: "w",
-				AmendLastCommit:          "A",
-				CommitChangesWithEditor:  "C",
-				FindBaseCommitForFixup:   "<c-f>",
-				IgnoreFile:               "i",
-				RefreshFiles:             "r",
-				StashAllChanges:          "s",
-				ViewStashOptions:         "S",
-				ToggleStagedAll:          "a",
-				ViewResetOptions:         "D",
-				Fetch:                    "f",
-				ToggleTreeView:           "`",
-				OpenMergeOptions:         "M",
-				OpenStatusFilter:         "<c-b>",
-				ConfirmDiscard:           "x",
-				CopyFileInfoToClipboard:  "y",
-				CollapseAll:              "-",
-				ExpandAll:                "=",
+				CommitChanges:             "c",
+				CommitChangesWithoutHook:  "w",
+				AmendLastCommit:           "A",
+				CommitChangesWithEditor:   "C",
+				FindBaseCommitForFixup:    "<c-f>",
+				AbsorbStagedChanges:       "F",
+				IgnoreFile:                "i",
+				RefreshFiles:              "r",
+				StashAllChanges:           "s",
+				ViewStashOptions:          "S",
+				ViewUntrackedFilesOptions: "U",
+				ToggleStagedAll:           "a",
+				ViewResetOptions:          "D",
+				Fetch:                     "f",
+				ToggleTreeView:            "`",
+				OpenMergeOptions:          "M",
+				OpenStatusFilter:          "<c-b>",
+				ConfirmDiscard:            "x",
+				CopyFileInfoToClipboard:   "y",
+				CollapseAll:               "-",
+				ExpandAll:                 "=",
 			},
 			Branches: KeybindingBranchesConfig{
 				CopyPullRequestURL:     "<c-y>",
@@ -1000,10 +1124,18 @@ func GetDefaultConfig() *UserConfig {
 				MoveCommitsToNewBranch: "N",
 				ViewGitFlowOptions:     "i",
 				FastForward:            "f",
+				CleanUpBranches:        "C",
+				ViewStackOptions:       "S",
 				CreateTag:              "T",
 				PushTag:                "P",
+				CreateSignedTag:        "S",
+				CreateNextVersionTag:   "N",
+				EditTagMessage:         "r",
 				SetUpstream:            "u",
 				FetchRemote:            "f",
+				PruneRemote:            "F",
+				EditRemotePushUrl:      "E",
+				SetRemoteHead:          "s",
 				SortOrder:              "s",
 			},
 			Worktrees: KeybindingWorktreesConfig{
@@ -1020,6 +1152,7 @@ func GetDefaultConfig() *UserConfig {
 				MoveDownCommit:                 "<c-j>",
 				MoveUpCommit:                   "<c-k>",
 				AmendToCommit:                  "A",
+				SplitCommit:                    "<c-b>",
 				ResetCommitAuthor:              "a",
 				PickCommit:                     "p",
 				RevertCommit:                   "t",
@@ -1031,6 +1164,7 @@ func GetDefaultConfig() *UserConfig {
 				ResetCherryPick:                "<c-R>",
 				CopyCommitAttributeToClipboard: "y",
 				OpenLogMenu:                    "<c-l>",
+				ToggleWholeGitGraph:            "G",
 				OpenInBrowser:                  "o",
 				ViewBisectOptions:              "b",
 				StartInteractiveRebase:         "i",
@@ -1047,20 +1181,32 @@ func GetDefaultConfig() *UserConfig {
 			},
 			CommitFiles: KeybindingCommitFilesConfig{
 				CheckoutCommitFile: "c",
+				LoadCustomPatch:    "I",
 			},
 			Main: KeybindingMainConfig{
-				ToggleSelectHunk: "a",
-				PickBothHunks:    "b",
-				EditSelectHunk:   "E",
+				ToggleSelectHunk:     "a",
+				PickBothHunks:        "b",
+				EditSelectHunk:       "E",
+				EditHunkInline:       "I",
+				ToggleSideBySideView: "V",
+				StageMatchingLines:   "*",
 			},
 			Submodules: KeybindingSubmodulesConfig{
 				Init:     "i",
 				Update:   "u",
 				BulkMenu: "b",
 			},
+			Workspace: KeybindingWorkspaceConfig{
+				FetchAllRepos: "f",
+				PullAllRepos:  "p",
+			},
 			CommitMessage: KeybindingCommitMessageConfig{
 				CommitMenu: "<c-o>",
 			},
+			CommandLog: KeybindingCommandLogConfig{
+				RerunCommand: "r",
+				ExportLog:    "e",
+			},
 		},
 	}
 }
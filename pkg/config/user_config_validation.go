@@ -7,6 +7,7 @@ import (
 	"slices"
 	"strings"
 
+	"github.com/alecthomas/chroma/v2/styles"
 	"github.com/jesseduffield/lazygit/pkg/constants"
 )
 
@@ -39,6 +40,14 @@ func (config *UserConfig) Validate() error {
 		[]string{"always", "never", "when-maximised"}); err != nil {
 		return err
 	}
+	if err := validateEnum("git.status.untrackedFiles", config.Git.Status.UntrackedFiles,
+		[]string{"", "no", "normal", "all"}); err != nil {
+		return err
+	}
+	if err := validateEnum("gui.theme.syntaxHighlighting", config.Gui.Theme.SyntaxHighlighting,
+		append([]string{""}, styles.Names()...)); err != nil {
+		return err
+	}
 	if err := validateKeybindings(config.Keybinding); err != nil {
 		return err
 	}
@@ -69,6 +69,31 @@ func TestUserConfigValidate_enums(t *testing.T) {
 				{value: "invalid_value", valid: false},
 			},
 		},
+		{
+			name: "Git.Status.UntrackedFiles",
+			setup: func(config *UserConfig, value string) {
+				config.Git.Status.UntrackedFiles = value
+			},
+			testCases: []testCase{
+				{value: "", valid: true},
+				{value: "no", valid: true},
+				{value: "normal", valid: true},
+				{value: "all", valid: true},
+				{value: "invalid_value", valid: false},
+			},
+		},
+		{
+			name: "Gui.Theme.SyntaxHighlighting",
+			setup: func(config *UserConfig, value string) {
+				config.Gui.Theme.SyntaxHighlighting = value
+			},
+			testCases: []testCase{
+				{value: "", valid: true},
+				{value: "monokai", valid: true},
+				{value: "github-dark", valid: true},
+				{value: "invalid_value", valid: false},
+			},
+		},
 		{
 			name: "Git.RemoteBranchSortOrder",
 			setup: func(config *UserConfig, value string) {
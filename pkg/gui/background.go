@@ -48,6 +48,10 @@ func (self *BackgroundRoutineMgr) startBackgroundRoutines() {
 		}
 	}
 
+	if workspaceRefreshInterval := userConfig.Workspace.RefreshInterval; workspaceRefreshInterval > 0 {
+		go utils.Safe(func() { self.startBackgroundWorkspaceRefresh(workspaceRefreshInterval) })
+	}
+
 	if self.gui.Config.GetDebug() {
 		self.goEvery(time.Second*time.Duration(10), self.gui.stopChan, func() error {
 			formatBytes := func(b uint64) string {
@@ -98,6 +102,19 @@ func (self *BackgroundRoutineMgr) startBackgroundFilesRefresh(refreshInterval in
 	})
 }
 
+func (self *BackgroundRoutineMgr) startBackgroundWorkspaceRefresh(refreshInterval int) {
+	self.gui.waitForIntro.Wait()
+
+	self.goEvery(time.Second*time.Duration(refreshInterval), self.gui.stopChan, func() error {
+		// checked on every tick rather than once, because switching to another
+		// repo can change the config
+		if self.gui.UserConfig().Workspace.IsEnabled() {
+			self.gui.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.WORKSPACE}})
+		}
+		return nil
+	})
+}
+
 func (self *BackgroundRoutineMgr) goEvery(interval time.Duration, stop chan struct{}, function func() error) {
 	done := make(chan struct{})
 	go utils.Safe(func() {
@@ -3,10 +3,14 @@ package gui
 import (
 	"fmt"
 	"math/rand"
+	"os"
 	"strings"
 	"time"
 
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/jesseduffield/lazygit/pkg/constants"
+	"github.com/jesseduffield/lazygit/pkg/gui/commandlog"
 	"github.com/jesseduffield/lazygit/pkg/gui/keybindings"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/theme"
@@ -24,6 +28,10 @@ import (
 // We pass logCommand to our OSCommand struct so that it can handle logging commands
 // for us.
 func (gui *Gui) LogAction(action string) {
+	if commandLog := gui.commandLog(); commandLog != nil {
+		commandLog.SetAction(action)
+	}
+
 	if gui.Views.Extras == nil {
 		return
 	}
@@ -35,6 +43,41 @@ func (gui *Gui) LogAction(action string) {
 }
 
 func (gui *Gui) LogCommand(cmdStr string, commandLine bool) {
+	if commandLog := gui.commandLog(); commandLog != nil {
+		if commandLine {
+			commandLog.AddCommandWithoutResult(cmdStr)
+		} else {
+			commandLog.AddMessage(cmdStr)
+		}
+	}
+
+	gui.printToCommandLog(cmdStr, commandLine)
+}
+
+// Logs a command that we're about to run, and returns a function to call with
+// its result once it has finished
+func (gui *Gui) logCmdObj(cmdObj *oscommands.CmdObj) func(oscommands.CmdResult) {
+	return gui.logCmdObjWithArgs(cmdObj, commandlog.RerunnableArgs(cmdObj, os.Environ()))
+}
+
+// Like logCmdObj, but for commands that run in a subprocess and interact with
+// the user, so they can't be re-run from the command log
+func (gui *Gui) logSubprocessCmdObj(cmdObj *oscommands.CmdObj) func(oscommands.CmdResult) {
+	return gui.logCmdObjWithArgs(cmdObj, nil)
+}
+
+func (gui *Gui) logCmdObjWithArgs(cmdObj *oscommands.CmdObj, args []string) func(oscommands.CmdResult) {
+	cmdStr := cmdObj.ToString()
+	gui.printToCommandLog(cmdStr, true)
+
+	if commandLog := gui.commandLog(); commandLog != nil {
+		return commandLog.AddCommand(cmdStr, args)
+	}
+
+	return func(oscommands.CmdResult) {}
+}
+
+func (gui *Gui) printToCommandLog(cmdStr string, commandLine bool) {
 	if gui.Views.Extras == nil {
 		return
 	}
@@ -52,6 +95,25 @@ func (gui *Gui) LogCommand(cmdStr string, commandLine bool) {
 	fmt.Fprint(gui.Views.Extras, "\n"+textStyle.Sprint(indentedCmdStr))
 }
 
+// nil while no repo is open yet
+func (gui *Gui) commandLog() *commandlog.Store {
+	if gui.State == nil {
+		return nil
+	}
+
+	return gui.State.Model.CommandLog
+}
+
+func (gui *Gui) newCommandLogStore(repoPath string) *commandlog.Store {
+	path, err := config.CommandLogPath(repoPath)
+	if err != nil {
+		gui.Log.Errorf("Failed to determine command log path: %v", err)
+		path = ""
+	}
+
+	return commandlog.NewStore(path, gui.Log)
+}
+
 func (gui *Gui) printCommandLogHeader() {
 	introStr := fmt.Sprintf(
 		gui.c.Tr.CommandLogHeader,
@@ -0,0 +1,261 @@
+package commandlog
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+// When the log file gets bigger than this, we rotate it: the file is renamed
+// to <name>.1, replacing the one that was rotated before, and a new file is
+// started. So we keep between one and two times this much history.
+const maxFileSize = 1024 * 1024
+
+// The number of entries that we keep in memory
+const maxEntries = 2000
+
+// Store holds the command log of a repo, and persists it to a file (in JSON
+// lines format) so that it survives restarts of lazygit.
+type Store struct {
+	// empty if the log isn't persisted
+	path string
+	log  *logrus.Entry
+
+	mutex   sync.Mutex
+	entries []*models.CommandLogEntry
+	// the most recently logged action; commands are attributed to it
+	action string
+}
+
+// Creates a store that persists the log to the given path, and loads the
+// entries that were persisted before. If path is empty, nothing is persisted.
+func NewStore(path string, log *logrus.Entry) *Store {
+	self := &Store{path: path, log: log}
+	if path != "" {
+		self.entries = self.load()
+	}
+	return self
+}
+
+func (self *Store) load() []*models.CommandLogEntry {
+	entries := []*models.CommandLogEntry{}
+	for _, path := range []string{rotatedPath(self.path), self.path} {
+		file, err := os.Open(path)
+		if err != nil {
+			if !os.IsNotExist(err) {
+				self.log.Errorf("Failed to read command log: %v", err)
+			}
+			continue
+		}
+
+		scanner := bufio.NewScanner(file)
+		scanner.Buffer(nil, maxFileSize)
+		for scanner.Scan() {
+			var entry models.CommandLogEntry
+			if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+				// most likely a line that was cut off when lazygit was killed
+				continue
+			}
+			entries = append(entries, &entry)
+		}
+		file.Close()
+	}
+
+	return lastN(entries, maxEntries)
+}
+
+func (self *Store) SetAction(action string) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	self.action = action
+}
+
+// Adds a message about something that was done without running a command
+func (self *Store) AddMessage(message string) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	entry := &models.CommandLogEntry{
+		Time:    time.Now(),
+		Action:  self.action,
+		Command: message,
+	}
+	self.add(entry)
+	self.persist(entry)
+}
+
+// Adds a command that is about to be run. The returned function must be called
+// with its result once it has finished; only then is it persisted.
+func (self *Store) AddCommand(command string, args []string) func(oscommands.CmdResult) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	entry := &models.CommandLogEntry{
+		Time:          time.Now(),
+		Action:        self.action,
+		Command:       command,
+		Args:          args,
+		IsCommandLine: true,
+	}
+	self.add(entry)
+
+	return func(result oscommands.CmdResult) {
+		self.mutex.Lock()
+		defer self.mutex.Unlock()
+
+		entry.Finished = true
+		entry.Duration = result.Duration
+		entry.ExitCode = result.ExitCode
+		entry.Stderr = strings.TrimSpace(result.Stderr)
+		self.persist(entry)
+	}
+}
+
+// Adds a command whose result we don't get to know, e.g. because it is run as
+// part of a pipe. It is persisted right away, without a duration or exit code.
+func (self *Store) AddCommandWithoutResult(command string) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	entry := &models.CommandLogEntry{
+		Time:          time.Now(),
+		Action:        self.action,
+		Command:       command,
+		IsCommandLine: true,
+	}
+	self.add(entry)
+	self.persist(entry)
+}
+
+// Environment variables that lazygit adds to every git command, or while
+// detecting credential requests; they don't stop a command from being re-run
+var rerunnableEnvVars = []string{"GIT_OPTIONAL_LOCKS=0", "LANG=C", "LC_ALL=C", "LC_MESSAGES=C"}
+
+// Returns the args of the given command if it can be re-run from the command
+// log, or nil if it can't. That's only the case for plain git commands: ones
+// that run in the current directory, don't read from stdin, and don't need any
+// environment variables other than the given environ (normally os.Environ())
+// and the ones that lazygit adds to all git commands. Anything else (e.g. a
+// rebase that relies on the daemon, or a commit with a custom author) would
+// behave differently when re-run with just its args.
+func RerunnableArgs(cmdObj *oscommands.CmdObj, environ []string) []string {
+	args := cmdObj.Args()
+	if len(args) == 0 || args[0] != "git" {
+		return nil
+	}
+
+	cmd := cmdObj.GetCmd()
+	if cmd.Dir != "" || cmd.Stdin != nil {
+		return nil
+	}
+
+	extraEnvVars, _ := lo.Difference(cmdObj.GetEnvVars(), environ)
+	if len(lo.Without(extraEnvVars, rerunnableEnvVars...)) > 0 {
+		return nil
+	}
+
+	return args
+}
+
+func (self *Store) add(entry *models.CommandLogEntry) {
+	self.entries = lastN(append(self.entries, entry), maxEntries)
+}
+
+func (self *Store) persist(entry *models.CommandLogEntry) {
+	if self.path == "" {
+		return
+	}
+
+	if err := self.appendToFile(entry); err != nil {
+		self.log.Errorf("Failed to persist command log entry: %v", err)
+	}
+}
+
+func (self *Store) appendToFile(entry *models.CommandLogEntry) error {
+	if info, err := os.Stat(self.path); err == nil && info.Size() > maxFileSize {
+		if err := os.Rename(self.path, rotatedPath(self.path)); err != nil {
+			return err
+		}
+	}
+
+	if err := os.MkdirAll(filepath.Dir(self.path), 0o755); err != nil {
+		return err
+	}
+
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(self.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = file.Write(append(line, '\n'))
+	return err
+}
+
+// Returns copies of the entries, oldest first, so that they can be used while
+// commands keep finishing in the background
+func (self *Store) Entries() []*models.CommandLogEntry {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	return lo.Map(self.entries, func(entry *models.CommandLogEntry, _ int) *models.CommandLogEntry {
+		entryCopy := *entry
+		return &entryCopy
+	})
+}
+
+func rotatedPath(path string) string {
+	return path + ".1"
+}
+
+func lastN[T any](items []T, n int) []T {
+	return items[max(0, len(items)-n):]
+}
+
+// Formats the given entries so that they can be read by humans, or run as a
+// shell script; everything except the commands is commented out
+func Export(entries []*models.CommandLogEntry) string {
+	var builder strings.Builder
+	for _, entry := range entries {
+		header := entry.Time.Format(time.DateTime)
+		if entry.Action != "" {
+			header += "  " + entry.Action
+		}
+		if entry.Finished {
+			header += fmt.Sprintf("  (exit code %d, %s)", entry.ExitCode, entry.Duration.Round(time.Millisecond))
+		}
+		fmt.Fprintf(&builder, "# %s\n", header)
+
+		if entry.IsCommandLine {
+			fmt.Fprintln(&builder, entry.Command)
+		} else {
+			fmt.Fprintln(&builder, commentOut(entry.Command))
+		}
+
+		if entry.Stderr != "" {
+			fmt.Fprintln(&builder, commentOut(entry.Stderr))
+		}
+	}
+
+	return builder.String()
+}
+
+func commentOut(str string) string {
+	return "# " + strings.ReplaceAll(str, "\n", "\n# ")
+}
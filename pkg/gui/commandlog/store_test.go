@@ -0,0 +1,166 @@
+package commandlog
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStorePersistsEntries(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "command_logs", "repo.jsonl")
+
+	store := NewStore(path, utils.NewDummyLog())
+	store.SetAction("Stage file")
+	store.AddCommand("git add -- file", []string{"git", "add", "--", "file"})(
+		oscommands.CmdResult{Duration: time.Second, ExitCode: 0})
+	store.SetAction("Push")
+	store.AddCommand("git push", []string{"git", "push"})(
+		oscommands.CmdResult{Duration: 2 * time.Second, ExitCode: 1, Stderr: "rejected\n"})
+	store.AddMessage("Deleting path 'file'")
+	store.AddCommandWithoutResult("git diff | less")
+	// not persisted because it hasn't finished
+	store.AddCommand("git fetch", []string{"git", "fetch"})
+
+	entries := NewStore(path, utils.NewDummyLog()).Entries()
+	assert.Len(t, entries, 4)
+
+	assert.Equal(t, "Stage file", entries[0].Action)
+	assert.Equal(t, []string{"git", "add", "--", "file"}, entries[0].Args)
+	assert.True(t, entries[0].Finished)
+	assert.False(t, entries[0].Failed())
+
+	assert.Equal(t, "git push", entries[1].Command)
+	assert.Equal(t, 2*time.Second, entries[1].Duration)
+	assert.True(t, entries[1].Failed())
+	assert.Equal(t, "rejected", entries[1].Stderr)
+
+	assert.Equal(t, "Push", entries[2].Action)
+	assert.False(t, entries[2].IsCommandLine)
+	assert.False(t, entries[2].CanRerun())
+
+	assert.Equal(t, "git diff | less", entries[3].Command)
+	assert.True(t, entries[3].IsCommandLine)
+	assert.False(t, entries[3].Finished)
+	assert.Equal(t, "", entries[3].ExitCodeString())
+}
+
+func TestRerunnableArgs(t *testing.T) {
+	builder := oscommands.NewDummyCmdObjBuilder(oscommands.NewFakeRunner(t))
+	environ := []string{"HOME=/home/user"}
+	newCmdObj := func(args ...string) *oscommands.CmdObj {
+		return builder.NewWithEnviron(args, environ).AddEnvVars("GIT_OPTIONAL_LOCKS=0")
+	}
+
+	scenarios := []struct {
+		testName string
+		cmdObj   *oscommands.CmdObj
+		expected []string
+	}{
+		{
+			testName: "plain git command",
+			cmdObj:   newCmdObj("git", "push"),
+			expected: []string{"git", "push"},
+		},
+		{
+			testName: "git command with credential detection",
+			cmdObj:   newCmdObj("git", "fetch").AddEnvVars("LANG=C", "LC_ALL=C", "LC_MESSAGES=C"),
+			expected: []string{"git", "fetch"},
+		},
+		{
+			testName: "not a git command",
+			cmdObj:   newCmdObj("sh", "-c", "make"),
+			expected: nil,
+		},
+		{
+			testName: "custom environment",
+			cmdObj:   newCmdObj("git", "rebase", "-i", "HEAD~2").AddEnvVars("GIT_SEQUENCE_EDITOR=lazygit"),
+			expected: nil,
+		},
+		{
+			testName: "custom working directory",
+			cmdObj:   newCmdObj("git", "commit").SetWd("/path/to/worktree"),
+			expected: nil,
+		},
+		{
+			testName: "reads from stdin",
+			cmdObj:   newCmdObj("git", "apply", "--cached").SetStdin("patch"),
+			expected: nil,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			assert.Equal(t, s.expected, RerunnableArgs(s.cmdObj, environ))
+		})
+	}
+}
+
+func TestStoreSkipsCorruptLines(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "repo.jsonl")
+	content := `{"time":"2024-01-01T00:00:00Z","command":"git status","isCommandLine":true,"finished":true}
+{"time":"2024-01-01T00:00:01Z","comma`
+	assert.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+	entries := NewStore(path, utils.NewDummyLog()).Entries()
+	assert.Len(t, entries, 1)
+	assert.Equal(t, "git status", entries[0].Command)
+}
+
+func TestStoreRotatesLogFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "repo.jsonl")
+	assert.NoError(t, os.WriteFile(path, []byte(strings.Repeat("x", maxFileSize+1)+"\n"), 0o600))
+
+	store := NewStore(path, utils.NewDummyLog())
+	store.AddMessage("after rotation")
+
+	_, err := os.Stat(rotatedPath(path))
+	assert.NoError(t, err)
+
+	entries := NewStore(path, utils.NewDummyLog()).Entries()
+	assert.Len(t, entries, 1)
+	assert.Equal(t, "after rotation", entries[0].Command)
+}
+
+func TestStoreWithoutPath(t *testing.T) {
+	store := NewStore("", utils.NewDummyLog())
+	store.AddMessage("not persisted")
+
+	assert.Len(t, store.Entries(), 1)
+}
+
+func TestExport(t *testing.T) {
+	start := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	entries := []*models.CommandLogEntry{
+		{
+			Time:          start,
+			Action:        "Push",
+			Command:       "git push",
+			Args:          []string{"git", "push"},
+			IsCommandLine: true,
+			Finished:      true,
+			Duration:      1500 * time.Millisecond,
+			ExitCode:      1,
+			Stderr:        "rejected\nhint: fetch first",
+		},
+		{
+			Time:    start.Add(time.Second),
+			Command: "Deleting path 'file'",
+		},
+	}
+
+	expected := `# 2024-01-02 03:04:05  Push  (exit code 1, 1.5s)
+git push
+# rejected
+# hint: fetch first
+# 2024-01-02 03:04:06
+# Deleting path 'file'
+`
+	assert.Equal(t, expected, Export(entries))
+}
@@ -0,0 +1,65 @@
+package context
+
+import (
+	"slices"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+// A full-screen popup listing the entries of the repo's command log, newest
+// first
+type CommandLogEntriesContext struct {
+	*FilteredListViewModel[*models.CommandLogEntry]
+	*ListContextTrait
+
+	// a snapshot of the command log, taken when the popup is opened
+	entries []*models.CommandLogEntry
+}
+
+var _ types.IListContext = (*CommandLogEntriesContext)(nil)
+
+func NewCommandLogEntriesContext(c *ContextCommon) *CommandLogEntriesContext {
+	self := &CommandLogEntriesContext{}
+
+	viewModel := NewFilteredListViewModel(
+		func() []*models.CommandLogEntry { return self.entries },
+		func(entry *models.CommandLogEntry) []string {
+			return []string{entry.Action, entry.Command, entry.Stderr}
+		},
+	)
+
+	getDisplayStrings := func(_ int, _ int) [][]string {
+		return presentation.GetCommandLogEntryDisplayStrings(viewModel.GetFilteredList())
+	}
+
+	self.FilteredListViewModel = viewModel
+	self.ListContextTrait = &ListContextTrait{
+		Context: NewSimpleContext(NewBaseContext(NewBaseContextOpts{
+			View:                  c.Views().CommandLogEntries,
+			WindowName:            "commandLogEntries",
+			Key:                   COMMAND_LOG_ENTRIES_CONTEXT_KEY,
+			Kind:                  types.PERSISTENT_POPUP,
+			Focusable:             true,
+			HasUncontrolledBounds: true,
+		})),
+		ListRenderer: ListRenderer{
+			list:              viewModel,
+			getDisplayStrings: getDisplayStrings,
+			getColumnAlignments: func() []utils.Alignment {
+				return []utils.Alignment{utils.AlignLeft, utils.AlignLeft, utils.AlignLeft, utils.AlignRight, utils.AlignRight}
+			},
+		},
+		c: c,
+	}
+
+	return self
+}
+
+// Takes the given entries, which are ordered oldest first
+func (self *CommandLogEntriesContext) SetEntries(entries []*models.CommandLogEntry) {
+	self.entries = slices.Clone(entries)
+	slices.Reverse(self.entries)
+}
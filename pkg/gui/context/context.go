@@ -15,6 +15,7 @@ const (
 	LOCAL_BRANCHES_CONTEXT_KEY           types.ContextKey = "localBranches"
 	REMOTES_CONTEXT_KEY                  types.ContextKey = "remotes"
 	WORKTREES_CONTEXT_KEY                types.ContextKey = "worktrees"
+	WORKSPACE_CONTEXT_KEY                types.ContextKey = "workspace"
 	REMOTE_BRANCHES_CONTEXT_KEY          types.ContextKey = "remoteBranches"
 	TAGS_CONTEXT_KEY                     types.ContextKey = "tags"
 	LOCAL_COMMITS_CONTEXT_KEY            types.ContextKey = "commits"
@@ -39,15 +40,17 @@ const (
 	STATUS_SPACER1_CONTEXT_KEY types.ContextKey = "statusSpacer1"
 	STATUS_SPACER2_CONTEXT_KEY types.ContextKey = "statusSpacer2"
 
-	MENU_CONTEXT_KEY               types.ContextKey = "menu"
-	CONFIRMATION_CONTEXT_KEY       types.ContextKey = "confirmation"
-	PROMPT_CONTEXT_KEY             types.ContextKey = "prompt"
-	SEARCH_CONTEXT_KEY             types.ContextKey = "search"
-	COMMIT_MESSAGE_CONTEXT_KEY     types.ContextKey = "commitMessage"
-	COMMIT_DESCRIPTION_CONTEXT_KEY types.ContextKey = "commitDescription"
-	SUBMODULES_CONTEXT_KEY         types.ContextKey = "submodules"
-	SUGGESTIONS_CONTEXT_KEY        types.ContextKey = "suggestions"
-	COMMAND_LOG_CONTEXT_KEY        types.ContextKey = "cmdLog"
+	MENU_CONTEXT_KEY                types.ContextKey = "menu"
+	CONFIRMATION_CONTEXT_KEY        types.ContextKey = "confirmation"
+	PROMPT_CONTEXT_KEY              types.ContextKey = "prompt"
+	SEARCH_CONTEXT_KEY              types.ContextKey = "search"
+	COMMIT_MESSAGE_CONTEXT_KEY      types.ContextKey = "commitMessage"
+	COMMIT_DESCRIPTION_CONTEXT_KEY  types.ContextKey = "commitDescription"
+	HUNK_EDITOR_CONTEXT_KEY         types.ContextKey = "hunkEditor"
+	COMMAND_LOG_ENTRIES_CONTEXT_KEY types.ContextKey = "commandLogEntries"
+	SUBMODULES_CONTEXT_KEY          types.ContextKey = "submodules"
+	SUGGESTIONS_CONTEXT_KEY         types.ContextKey = "suggestions"
+	COMMAND_LOG_CONTEXT_KEY         types.ContextKey = "cmdLog"
 )
 
 var AllContextKeys = []types.ContextKey{
@@ -57,6 +60,7 @@ var AllContextKeys = []types.ContextKey{
 	LOCAL_BRANCHES_CONTEXT_KEY,
 	REMOTES_CONTEXT_KEY,
 	WORKTREES_CONTEXT_KEY,
+	WORKSPACE_CONTEXT_KEY,
 	REMOTE_BRANCHES_CONTEXT_KEY,
 	TAGS_CONTEXT_KEY,
 	LOCAL_COMMITS_CONTEXT_KEY,
@@ -77,6 +81,8 @@ var AllContextKeys = []types.ContextKey{
 	PROMPT_CONTEXT_KEY,
 	SEARCH_CONTEXT_KEY,
 	COMMIT_MESSAGE_CONTEXT_KEY,
+	HUNK_EDITOR_CONTEXT_KEY,
+	COMMAND_LOG_ENTRIES_CONTEXT_KEY,
 	SUBMODULES_CONTEXT_KEY,
 	SUGGESTIONS_CONTEXT_KEY,
 	COMMAND_LOG_CONTEXT_KEY,
@@ -94,6 +100,7 @@ type ContextTree struct {
 	CommitFiles                 *CommitFilesContext
 	Remotes                     *RemotesContext
 	Worktrees                   *WorktreesContext
+	Workspace                   *WorkspaceContext
 	Submodules                  *SubmodulesContext
 	RemoteBranches              *RemoteBranchesContext
 	ReflogCommits               *ReflogCommitsContext
@@ -111,6 +118,8 @@ type ContextTree struct {
 	Prompt                      *PromptContext
 	CommitMessage               *CommitMessageContext
 	CommitDescription           types.Context
+	HunkEditor                  *HunkEditorContext
+	CommandLogEntries           *CommandLogEntriesContext
 	CommandLog                  types.Context
 
 	// display contexts
@@ -132,6 +141,7 @@ func (self *ContextTree) Flatten() []types.Context {
 		self.Snake,
 		self.Submodules,
 		self.Worktrees,
+		self.Workspace,
 		self.Files,
 		self.SubCommits,
 		self.Remotes,
@@ -147,6 +157,8 @@ func (self *ContextTree) Flatten() []types.Context {
 		self.Prompt,
 		self.CommitMessage,
 		self.CommitDescription,
+		self.HunkEditor,
+		self.CommandLogEntries,
 
 		self.MergeConflicts,
 		self.StagingSecondary,
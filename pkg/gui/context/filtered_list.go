@@ -1,6 +1,7 @@
 package context
 
 import (
+	"sort"
 	"strings"
 
 	"github.com/jesseduffield/lazygit/pkg/utils"
@@ -15,6 +16,7 @@ type FilteredList[T any] struct {
 	getList          func() []T
 	getFilterFields  func(T) []string
 	preprocessFilter func(string) string
+	getGroup         func(T) int
 	filter           string
 
 	mutex deadlock.Mutex
@@ -31,6 +33,12 @@ func (self *FilteredList[T]) SetPreprocessFilterFunc(preprocessFilter func(strin
 	self.preprocessFilter = preprocessFilter
 }
 
+// Keeps the filtered items grouped by the value returned by getGroup, in
+// ascending order; within a group, they are still sorted by best match.
+func (self *FilteredList[T]) SetGetGroupFunc(getGroup func(T) int) {
+	self.getGroup = getGroup
+}
+
 func (self *FilteredList[T]) GetFilter() string {
 	return self.filter
 }
@@ -101,6 +109,12 @@ func (self *FilteredList[T]) applyFilter(useFuzzySearch bool) {
 		self.filteredIndices = lo.Map(matches, func(match fuzzy.Match, _ int) int {
 			return match.Index
 		})
+
+		if self.getGroup != nil {
+			sort.SliceStable(self.filteredIndices, func(i, j int) bool {
+				return self.getGroup(source.list[self.filteredIndices[i]]) < self.getGroup(source.list[self.filteredIndices[j]])
+			})
+		}
 	}
 }
 
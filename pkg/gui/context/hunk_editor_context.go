@@ -0,0 +1,46 @@
+package context
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/commands/patch"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/tasks"
+)
+
+// The hunk editor is shown on top of the staging view and lets the user edit
+// the added lines of a hunk in place
+type HunkEditorContext struct {
+	*SimpleContext
+	c *ContextCommon
+
+	State *HunkEditorContextState
+}
+
+type HunkEditorContextState struct {
+	// a patch containing only the hunk being edited
+	Patch *patch.Patch
+	// true if the hunk comes from the diff of staged changes
+	Staged bool
+	// used for validating the edited hunk in the background while typing
+	AsyncHandler *tasks.AsyncHandler
+}
+
+var _ types.Context = (*HunkEditorContext)(nil)
+
+func NewHunkEditorContext(
+	c *ContextCommon,
+) *HunkEditorContext {
+	return &HunkEditorContext{
+		c: c,
+		State: &HunkEditorContextState{
+			AsyncHandler: tasks.NewAsyncHandler(c.OnWorker),
+		},
+		SimpleContext: NewSimpleContext(NewBaseContext(NewBaseContextOpts{
+			View:                  c.Views().HunkEditor,
+			WindowName:            "hunkEditor",
+			Key:                   HUNK_EDITOR_CONTEXT_KEY,
+			Kind:                  types.PERSISTENT_POPUP,
+			Focusable:             true,
+			HasUncontrolledBounds: true,
+		})),
+	}
+}
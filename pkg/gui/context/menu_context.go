@@ -2,6 +2,7 @@ package context
 
 import (
 	"errors"
+	"slices"
 	"strings"
 
 	"github.com/jesseduffield/lazygit/pkg/gui/keybindings"
@@ -56,6 +57,9 @@ type MenuViewModel struct {
 	promptLines               []string
 	columnAlignment           []utils.Alignment
 	allowFilteringKeybindings bool
+	filterTooltips            bool
+	keepSectionsWhenFiltering bool
+	sectionIndices            map[*types.MenuSection]int
 	*FilteredListViewModel[*types.MenuItem]
 }
 
@@ -74,6 +78,10 @@ func NewMenuViewModel(c *ContextCommon) *MenuViewModel {
 				return []string{keybindings.LabelFromKey(item.Key)}
 			}
 
+			if self.filterTooltips {
+				return append(slices.Clone(item.LabelColumns), item.Tooltip)
+			}
+
 			return item.LabelColumns
 		},
 	)
@@ -88,12 +96,27 @@ func NewMenuViewModel(c *ContextCommon) *MenuViewModel {
 		return filter
 	})
 
+	self.FilteredListViewModel.SetGetGroupFunc(func(item *types.MenuItem) int {
+		if !self.keepSectionsWhenFiltering {
+			return 0
+		}
+
+		return self.sectionIndices[item.Section]
+	})
+
 	return self
 }
 
 func (self *MenuViewModel) SetMenuItems(items []*types.MenuItem, columnAlignment []utils.Alignment) {
 	self.menuItems = items
 	self.columnAlignment = columnAlignment
+
+	self.sectionIndices = map[*types.MenuSection]int{}
+	for _, item := range items {
+		if _, ok := self.sectionIndices[item.Section]; !ok {
+			self.sectionIndices[item.Section] = len(self.sectionIndices)
+		}
+	}
 }
 
 func (self *MenuViewModel) GetPrompt() string {
@@ -117,6 +140,14 @@ func (self *MenuViewModel) SetAllowFilteringKeybindings(allow bool) {
 	self.allowFilteringKeybindings = allow
 }
 
+func (self *MenuViewModel) SetFilterTooltips(filterTooltips bool) {
+	self.filterTooltips = filterTooltips
+}
+
+func (self *MenuViewModel) SetKeepSectionsWhenFiltering(keep bool) {
+	self.keepSectionsWhenFiltering = keep
+}
+
 // TODO: move into presentation package
 func (self *MenuViewModel) GetDisplayStrings(_ int, _ int) [][]string {
 	menuItems := self.FilteredListViewModel.GetItems()
@@ -163,8 +194,9 @@ func (self *MenuViewModel) GetNonModelItems() []*NonModelItem {
 
 	// Don't display section headers when we are filtering, and the filter mode
 	// is fuzzy. The reason is that filtering changes the order of the items
-	// (they are sorted by best match), so all the sections would be messed up.
-	if self.FilteredListViewModel.IsFiltering() && self.c.UserConfig().Gui.UseFuzzySearch() {
+	// (they are sorted by best match), so all the sections would be messed up;
+	// unless the menu keeps the items grouped by section.
+	if self.FilteredListViewModel.IsFiltering() && self.c.UserConfig().Gui.UseFuzzySearch() && !self.keepSectionsWhenFiltering {
 		return result
 	}
 
@@ -2,6 +2,7 @@ package context
 
 import (
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/patch"
 	"github.com/jesseduffield/lazygit/pkg/gui/patch_exploring"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	deadlock "github.com/sasha-s/go-deadlock"
@@ -120,7 +121,15 @@ func (self *PatchExplorerContext) GetContentToRender() string {
 		return ""
 	}
 
-	return self.GetState().RenderForLineIndices(self.GetIncludedLineIndices())
+	opts := patch.FormatViewOpts{
+		HighlightWordDiff: self.c.UserConfig().Gui.HighlightWordDiff,
+	}
+	if self.c.State().GetSideBySideStagingView() {
+		opts.SideBySideWidth = self.GetView().InnerWidth()
+		opts.TabWidth = self.GetView().TabWidth
+	}
+
+	return self.GetState().RenderForLineIndices(self.GetIncludedLineIndices(), opts)
 }
 
 func (self *PatchExplorerContext) NavigateTo(selectedLineIdx int) {
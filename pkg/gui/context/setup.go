@@ -30,6 +30,7 @@ func NewContextTree(c *ContextCommon) *ContextTree {
 		Menu:            NewMenuContext(c),
 		Remotes:         NewRemotesContext(c),
 		Worktrees:       NewWorktreesContext(c),
+		Workspace:       NewWorkspaceContext(c),
 		RemoteBranches:  NewRemoteBranchesContext(c),
 		LocalCommits:    NewLocalCommitsContext(c),
 		CommitFiles:     commitFilesContext,
@@ -96,6 +97,8 @@ func NewContextTree(c *ContextCommon) *ContextTree {
 				HasUncontrolledBounds: true,
 			}),
 		),
+		HunkEditor:        NewHunkEditorContext(c),
+		CommandLogEntries: NewCommandLogEntriesContext(c),
 		Search: NewSimpleContext(
 			NewBaseContext(NewBaseContextOpts{
 				Kind:       types.PERSISTENT_POPUP,
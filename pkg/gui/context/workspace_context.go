@@ -0,0 +1,48 @@
+package context
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+)
+
+type WorkspaceContext struct {
+	*FilteredListViewModel[*models.WorkspaceRepo]
+	*ListContextTrait
+}
+
+var _ types.IListContext = (*WorkspaceContext)(nil)
+
+func NewWorkspaceContext(c *ContextCommon) *WorkspaceContext {
+	viewModel := NewFilteredListViewModel(
+		func() []*models.WorkspaceRepo { return c.Model().WorkspaceRepos },
+		func(repo *models.WorkspaceRepo) []string {
+			return []string{repo.Name, repo.Branch}
+		},
+	)
+
+	getDisplayStrings := func(_ int, _ int) [][]string {
+		return presentation.GetWorkspaceRepoDisplayStrings(
+			c.Tr,
+			viewModel.GetFilteredList(),
+		)
+	}
+
+	return &WorkspaceContext{
+		FilteredListViewModel: viewModel,
+		ListContextTrait: &ListContextTrait{
+			Context: NewSimpleContext(NewBaseContext(NewBaseContextOpts{
+				View:       c.Views().Workspace,
+				WindowName: "files",
+				Key:        WORKSPACE_CONTEXT_KEY,
+				Kind:       types.SIDE_CONTEXT,
+				Focusable:  true,
+			})),
+			ListRenderer: ListRenderer{
+				list:              viewModel,
+				getDisplayStrings: getDisplayStrings,
+			},
+			c: c,
+		},
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/gui/controllers"
 	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
 	"github.com/jesseduffield/lazygit/pkg/gui/services/custom_commands"
+	"github.com/jesseduffield/lazygit/pkg/gui/services/plugins"
 	"github.com/jesseduffield/lazygit/pkg/gui/status"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 )
@@ -90,9 +91,12 @@ func (gui *Gui) resetHelpersAndControllers() {
 		modeHelper,
 	)
 
+	branchesHelper := helpers.NewBranchesHelper(helperCommon, worktreeHelper)
+	hostHelper := helpers.NewHostHelper(helperCommon)
+
 	gui.helpers = &helpers.Helpers{
 		Refs:            refsHelper,
-		Host:            helpers.NewHostHelper(helperCommon),
+		Host:            hostHelper,
 		PatchBuilding:   patchBuildingHelper,
 		Staging:         stagingHelper,
 		Bisect:          bisectHelper,
@@ -100,14 +104,18 @@ func (gui *Gui) resetHelpersAndControllers() {
 		Files:           helpers.NewFilesHelper(helperCommon),
 		WorkingTree:     helpers.NewWorkingTreeHelper(helperCommon, refsHelper, commitsHelper, gpgHelper, rebaseHelper),
 		Tags:            helpers.NewTagsHelper(helperCommon, commitsHelper, gpgHelper),
-		BranchesHelper:  helpers.NewBranchesHelper(helperCommon, worktreeHelper),
+		BranchesHelper:  branchesHelper,
+		BranchCleanup:   helpers.NewBranchCleanupHelper(helperCommon, branchesHelper),
+		BranchStack:     helpers.NewBranchStackHelper(helperCommon, rebaseHelper, hostHelper),
 		GPG:             helpers.NewGpgHelper(helperCommon),
 		MergeAndRebase:  rebaseHelper,
 		MergeConflicts:  mergeConflictsHelper,
 		CherryPick:      cherryPickHelper,
 		Upstream:        helpers.NewUpstreamHelper(helperCommon, suggestionsHelper.GetRemoteBranchesSuggestionsFunc),
 		AmendHelper:     helpers.NewAmendHelper(helperCommon, gpgHelper),
-		FixupHelper:     helpers.NewFixupHelper(helperCommon),
+		FixupHelper:     helpers.NewFixupHelper(helperCommon, rebaseHelper),
+		SplitCommit:     helpers.NewSplitCommitHelper(helperCommon, rebaseHelper, commitsHelper),
+		HunkEditor:      helpers.NewHunkEditorHelper(helperCommon),
 		Commits:         commitsHelper,
 		SuspendResume:   helpers.NewSuspendResumeHelper(helperCommon),
 		Snake:           helpers.NewSnakeHelper(helperCommon),
@@ -131,6 +139,7 @@ func (gui *Gui) resetHelpersAndControllers() {
 		Search:     searchHelper,
 		Worktree:   worktreeHelper,
 		SubCommits: helpers.NewSubCommitsHelper(helperCommon, refreshHelper),
+		Macro:      helpers.NewMacroHelper(helperCommon, gui.hasRunningWorkers),
 	}
 
 	gui.CustomCommandsClient = custom_commands.NewClient(
@@ -138,6 +147,11 @@ func (gui *Gui) resetHelpersAndControllers() {
 		gui.helpers,
 	)
 
+	gui.PluginsClient = plugins.NewClient(
+		helperCommon,
+		gui.helpers,
+	)
+
 	common := controllers.NewControllerCommon(helperCommon, gui)
 
 	syncController := controllers.NewSyncController(
@@ -156,6 +170,9 @@ func (gui *Gui) resetHelpersAndControllers() {
 		common,
 	)
 
+	hunkEditorController := controllers.NewHunkEditorController(common)
+	commandLogEntriesController := controllers.NewCommandLogEntriesController(common)
+
 	remoteBranchesController := controllers.NewRemoteBranchesController(common)
 
 	menuController := controllers.NewMenuController(common)
@@ -170,8 +187,10 @@ func (gui *Gui) resetHelpersAndControllers() {
 		func(branches []*models.RemoteBranch) { gui.State.Model.RemoteBranches = branches },
 	)
 	worktreesController := controllers.NewWorktreesController(common)
+	workspaceController := controllers.NewWorkspaceController(common)
 	undoController := controllers.NewUndoController(common)
 	globalController := controllers.NewGlobalController(common)
+	macrosController := controllers.NewMacrosController(common)
 	contextLinesController := controllers.NewContextLinesController(common)
 	renameSimilarityThresholdController := controllers.NewRenameSimilarityThresholdController(common)
 	verticalScrollControllerFactory := controllers.NewVerticalScrollControllerFactory(common)
@@ -226,6 +245,7 @@ func (gui *Gui) resetHelpersAndControllers() {
 		gui.State.Contexts.Status,
 		gui.State.Contexts.Remotes,
 		gui.State.Contexts.Worktrees,
+		gui.State.Contexts.Workspace,
 		gui.State.Contexts.Tags,
 		gui.State.Contexts.Branches,
 		gui.State.Contexts.RemoteBranches,
@@ -367,6 +387,10 @@ func (gui *Gui) resetHelpersAndControllers() {
 		worktreesController,
 	)
 
+	controllers.AttachControllers(gui.State.Contexts.Workspace,
+		workspaceController,
+	)
+
 	controllers.AttachControllers(gui.State.Contexts.Stash,
 		stashController,
 	)
@@ -384,6 +408,14 @@ func (gui *Gui) resetHelpersAndControllers() {
 		verticalScrollControllerFactory.Create(gui.State.Contexts.CommitDescription),
 	)
 
+	controllers.AttachControllers(gui.State.Contexts.HunkEditor,
+		hunkEditorController,
+	)
+
+	controllers.AttachControllers(gui.State.Contexts.CommandLogEntries,
+		commandLogEntriesController,
+	)
+
 	controllers.AttachControllers(gui.State.Contexts.RemoteBranches,
 		remoteBranchesController,
 	)
@@ -419,6 +451,7 @@ func (gui *Gui) resetHelpersAndControllers() {
 		renameSimilarityThresholdController,
 		jumpToSideWindowController,
 		syncController,
+		macrosController,
 	)
 
 	controllers.AttachControllers(gui.State.Contexts.Snake,
@@ -110,6 +110,21 @@ func (self *BranchesController) GetKeybindings(opts types.KeybindingsOpts) []*ty
 			OpensMenu:         true,
 			DisplayOnScreen:   true,
 		},
+		{
+			Key:               opts.GetKey(opts.Config.Branches.ViewStackOptions),
+			Handler:           self.withItem(self.c.Helpers().BranchStack.OpenStackMenu),
+			GetDisabledReason: self.require(self.singleItemSelected(self.branchIsReal)),
+			Description:       self.c.Tr.ViewBranchStackOptions,
+			Tooltip:           self.c.Tr.ViewBranchStackOptionsTooltip,
+			OpensMenu:         true,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Branches.CleanUpBranches),
+			Handler:     self.c.Helpers().BranchCleanup.OpenCleanupMenu,
+			Description: self.c.Tr.CleanUpBranches,
+			Tooltip:     self.c.Tr.CleanUpBranchesTooltip,
+			OpensMenu:   true,
+		},
 		{
 			Key:               opts.GetKey(opts.Config.Branches.RebaseBranch),
 			Handler:           opts.Guards.OutsideFilterMode(self.withItem(self.rebase)),
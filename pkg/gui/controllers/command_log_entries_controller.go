@@ -0,0 +1,184 @@
+package controllers
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/commandlog"
+	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+// Lets you browse the persisted command log of the repo, see the details of
+// each entry, re-run commands, and export the log to a file
+type CommandLogEntriesController struct {
+	baseController
+	*ListControllerTrait[*models.CommandLogEntry]
+	c *ControllerCommon
+}
+
+var _ types.IController = &CommandLogEntriesController{}
+
+func NewCommandLogEntriesController(
+	c *ControllerCommon,
+) *CommandLogEntriesController {
+	return &CommandLogEntriesController{
+		baseController: baseController{},
+		ListControllerTrait: NewListControllerTrait(
+			c,
+			c.Contexts().CommandLogEntries,
+			c.Contexts().CommandLogEntries.GetSelected,
+			c.Contexts().CommandLogEntries.GetSelectedItems,
+		),
+		c: c,
+	}
+}
+
+func (self *CommandLogEntriesController) GetKeybindings(opts types.KeybindingsOpts) []*types.Binding {
+	bindings := []*types.Binding{
+		{
+			Key:             opts.GetKey(opts.Config.Universal.Return),
+			Handler:         self.close,
+			Description:     self.c.Tr.Close,
+			DisplayOnScreen: true,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Universal.GoInto),
+			Handler:           self.withItem(self.showDetails),
+			GetDisabledReason: self.require(self.singleItemSelected()),
+			Description:       self.c.Tr.CommandLogEntryDetailsTitle,
+			DisplayOnScreen:   true,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.CommandLog.RerunCommand),
+			Handler:           self.withItem(self.rerun),
+			GetDisabledReason: self.require(self.singleItemSelected(self.canRerun)),
+			Description:       self.c.Tr.RerunCommand,
+			Tooltip:           self.c.Tr.RerunCommandTooltip,
+			DisplayOnScreen:   true,
+		},
+		{
+			Key:             opts.GetKey(opts.Config.CommandLog.ExportLog),
+			Handler:         self.export,
+			Description:     self.c.Tr.ExportCommandLog,
+			Tooltip:         self.c.Tr.ExportCommandLogTooltip,
+			DisplayOnScreen: true,
+		},
+	}
+
+	return bindings
+}
+
+func (self *CommandLogEntriesController) close() error {
+	if self.context().IsFiltering() {
+		self.c.Helpers().Search.Cancel()
+		return nil
+	}
+
+	self.c.Context().Pop()
+	return nil
+}
+
+func (self *CommandLogEntriesController) showDetails(entry *models.CommandLogEntry) error {
+	duration := ""
+	if entry.Finished {
+		duration = entry.Duration.Round(time.Millisecond).String()
+	}
+
+	details := utils.ResolvePlaceholderString(self.c.Tr.CommandLogEntryDetails,
+		map[string]string{
+			"time":     entry.Time.Format(time.DateTime),
+			"action":   entry.Action,
+			"command":  entry.Command,
+			"duration": duration,
+			"exitCode": entry.ExitCodeString(),
+		})
+	if entry.Stderr != "" {
+		details += fmt.Sprintf("\n\n%s\n%s", self.c.Tr.CommandLogEntryStderr, style.FgRed.Sprint(entry.Stderr))
+	}
+
+	self.c.Alert(self.c.Tr.CommandLogEntryDetailsTitle, details)
+	return nil
+}
+
+func (self *CommandLogEntriesController) canRerun(entry *models.CommandLogEntry) *types.DisabledReason {
+	if !entry.CanRerun() {
+		return &types.DisabledReason{Text: self.c.Tr.CannotRerunCommandLogEntry}
+	}
+
+	return nil
+}
+
+func (self *CommandLogEntriesController) rerun(entry *models.CommandLogEntry) error {
+	self.c.Confirm(types.ConfirmOpts{
+		Title: self.c.Tr.RerunCommand,
+		Prompt: utils.ResolvePlaceholderString(self.c.Tr.RerunCommandPrompt,
+			map[string]string{"command": entry.Command}),
+		HandleConfirm: func() error {
+			return self.c.WithWaitingStatus(self.c.Tr.RunningCommand, func(task gocui.Task) error {
+				self.c.LogAction(self.c.Tr.Actions.RerunCommand)
+				err := self.c.OS().Cmd.New(entry.Args).PromptOnCredentialRequest(task).Run()
+
+				self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC})
+				self.c.OnUIThread(func() error {
+					self.context().SetEntries(self.c.Model().CommandLog.Entries())
+					self.c.Helpers().Search.ReApplyFilter(self.context())
+					self.context().SetSelection(0)
+					self.c.PostRefreshUpdate(self.context())
+					return nil
+				})
+
+				return err
+			})
+		},
+	})
+
+	return nil
+}
+
+func (self *CommandLogEntriesController) export() error {
+	self.c.Prompt(types.PromptOpts{
+		Title: self.c.Tr.ExportCommandLogPrompt,
+		HandleConfirm: func(path string) error {
+			_, err := os.Stat(path)
+			return self.c.ConfirmIf(err == nil, types.ConfirmOpts{
+				Title:  self.c.Tr.OverwriteExportedFileTitle,
+				Prompt: utils.ResolvePlaceholderString(self.c.Tr.OverwriteExportedFilePrompt, map[string]string{"path": path}),
+				HandleConfirm: func() error {
+					return self.exportTo(path)
+				},
+			})
+		},
+	})
+
+	return nil
+}
+
+func (self *CommandLogEntriesController) exportTo(path string) error {
+	// the list shows the newest entries first, but the export should be in the
+	// order in which the commands were run
+	entries := slices.Clone(self.context().GetFilteredList())
+	slices.Reverse(entries)
+
+	// only readable by the user, since the stderr of commands and the remote
+	// urls in them might contain credentials
+	if err := os.WriteFile(path, []byte(commandlog.Export(entries)), 0o600); err != nil {
+		return err
+	}
+
+	// the file might have been written to the working tree
+	self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.FILES}})
+	self.c.Toast(utils.ResolvePlaceholderString(self.c.Tr.CommandLogExported,
+		map[string]string{"count": fmt.Sprint(len(entries)), "path": path}))
+	return nil
+}
+
+func (self *CommandLogEntriesController) context() *context.CommandLogEntriesContext {
+	return self.c.Contexts().CommandLogEntries
+}
@@ -0,0 +1,97 @@
+package controllers
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/gui/keybindings"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
+)
+
+// Shows a menu of the actions of all panels, including custom commands. Unlike
+// the keybindings menu, which only shows the actions of the current panel, the
+// command palette focuses the panel that an action belongs to before
+// executing it.
+type CommandPaletteAction struct {
+	c *ControllerCommon
+}
+
+func (self *CommandPaletteAction) Call() error {
+	bindings, _ := self.c.GetInitialKeybindingsWithCustomCommands()
+	bindings = lo.Filter(bindings, func(binding *types.Binding, _ int) bool {
+		return binding.GetDescription() != "" && binding.Handler != nil && binding.Tag != "navigation"
+	})
+
+	menuItems := []*types.MenuItem{}
+	appendBindings := func(bindings []*types.Binding, context types.Context, sectionTitle string) {
+		section := &types.MenuSection{Title: sectionTitle}
+		for _, binding := range uniqueBindings(bindings) {
+			var disabledReason *types.DisabledReason
+			if binding.GetDisabledReason != nil {
+				disabledReason = binding.GetDisabledReason()
+			}
+			menuItems = append(menuItems, &types.MenuItem{
+				LabelColumns: []string{
+					style.FgCyan.Sprint(keybindings.LabelFromKey(binding.Key)),
+					binding.GetDescription(),
+				},
+				OpensMenu:      binding.OpensMenu,
+				Tooltip:        binding.Tooltip,
+				DisabledReason: disabledReason,
+				Section:        section,
+				OnPress: func() error {
+					if context != nil && context.GetKey() != self.c.Context().Current().GetKey() {
+						self.c.Context().Push(context, types.OnFocusOpts{})
+					}
+
+					return self.c.IGuiCommon.CallKeybindingHandler(binding)
+				},
+			})
+		}
+	}
+
+	for _, context := range self.contexts() {
+		appendBindings(lo.Filter(bindings, func(binding *types.Binding, _ int) bool {
+			return binding.ViewName == context.GetViewName() && binding.Tag != "global"
+		}), context, context.GetView().Title)
+
+		if context.GetKey() == self.c.Context().CurrentSide().GetKey() {
+			appendBindings(lo.Filter(bindings, func(binding *types.Binding, _ int) bool {
+				return binding.ViewName == "" || binding.Tag == "global"
+			}), nil, self.c.Tr.KeybindingsMenuSectionGlobal)
+		}
+	}
+
+	if err := self.c.Menu(types.CreateMenuOptions{
+		Title:                     self.c.Tr.OpenCommandPalette,
+		Items:                     menuItems,
+		HideCancel:                true,
+		KeepSectionsWhenFiltering: true,
+		FilterTooltips:            true,
+	}); err != nil {
+		return err
+	}
+
+	return self.c.Helpers().Search.OpenFilterPrompt(self.c.Contexts().Menu)
+}
+
+// Returns the contexts whose actions are shown, starting with the current one.
+// Besides the current context, these are the contexts that can be focused at
+// any time; others, like the commit files, only make sense when reached from
+// one of these.
+func (self *CommandPaletteAction) contexts() []types.Context {
+	contexts := self.c.Contexts()
+	return lo.UniqBy([]types.Context{
+		self.c.Context().Current(),
+		self.c.Context().CurrentSide(),
+		contexts.Status,
+		contexts.Files,
+		contexts.Worktrees,
+		contexts.Submodules,
+		contexts.Branches,
+		contexts.Remotes,
+		contexts.Tags,
+		contexts.LocalCommits,
+		contexts.ReflogCommits,
+		contexts.Stash,
+	}, func(context types.Context) types.ContextKey { return context.GetKey() })
+}
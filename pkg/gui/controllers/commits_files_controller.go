@@ -14,6 +14,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
 	"github.com/jesseduffield/lazygit/pkg/gui/filetree"
 	"github.com/jesseduffield/lazygit/pkg/gui/keybindings"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/samber/lo"
@@ -105,6 +106,13 @@ func (self *CommitFilesController) GetKeybindings(opts types.KeybindingsOpts) []
 				map[string]string{"doc": constants.Links.Docs.CustomPatchDemo},
 			),
 		},
+		{
+			Key:         opts.GetKey(opts.Config.CommitFiles.LoadCustomPatch),
+			Handler:     self.openLoadCustomPatchMenu,
+			Description: self.c.Tr.LoadCustomPatch,
+			Tooltip:     self.c.Tr.LoadCustomPatchTooltip,
+			OpensMenu:   true,
+		},
 		{
 			Key:               opts.GetKey(opts.Config.Universal.GoInto),
 			Handler:           self.withItem(self.enter),
@@ -445,6 +453,105 @@ func (self *CommitFilesController) toggleAllForPatch(_ *filetree.CommitFileNode)
 	return self.toggleForPatch([]*filetree.CommitFileNode{root})
 }
 
+func (self *CommitFilesController) openLoadCustomPatchMenu() error {
+	savedPatches, err := self.c.Git().Patch.GetSavedPatches()
+	if err != nil {
+		return err
+	}
+
+	menuItems := lo.Map(savedPatches, func(savedPatch *git_commands.SavedPatch, _ int) *types.MenuItem {
+		return &types.MenuItem{
+			LabelColumns: []string{
+				savedPatch.Name,
+				style.FgYellow.Sprint(utils.ShortHash(savedPatch.To)),
+				style.FgBlackLighter.Sprint(utils.UnixToTimeAgo(savedPatch.ModTime.Unix())),
+			},
+			OnPress: func() error {
+				return self.loadCustomPatch(func() ([]string, error) {
+					self.c.LogAction(self.c.Tr.Actions.LoadCustomPatch)
+					return self.c.Git().Patch.LoadSavedPatch(savedPatch.Name)
+				})
+			},
+		}
+	})
+
+	menuItems = append(menuItems, &types.MenuItem{
+		Label:   self.c.Tr.ImportPatchFile,
+		Tooltip: self.c.Tr.ImportPatchFileTooltip,
+		Key:     'i',
+		OnPress: func() error {
+			self.c.Prompt(types.PromptOpts{
+				Title:               self.c.Tr.ImportPatchFilePrompt,
+				FindSuggestionsFunc: self.c.Helpers().Suggestions.GetFilePathSuggestionsFunc(),
+				HandleConfirm: func(path string) error {
+					return self.loadCustomPatch(func() ([]string, error) {
+						self.c.LogAction(self.c.Tr.Actions.ImportCustomPatch)
+						return self.c.Git().Patch.ImportPatchFile(path)
+					})
+				},
+			})
+			return nil
+		},
+	})
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.LoadCustomPatch,
+		Items: menuItems,
+	})
+}
+
+// Adds the changes of a saved or imported patch to the custom patch for the
+// commit whose files are shown
+func (self *CommitFilesController) loadCustomPatch(load func() ([]string, error)) error {
+	if self.c.UserConfig().Git.DiffContextSize == 0 {
+		return fmt.Errorf(self.c.Tr.Actions.NotEnoughContextForCustomPatch,
+			keybindings.Label(self.c.UserConfig().Keybinding.Universal.IncreaseContextInDiffView))
+	}
+
+	from, to, reverse := self.currentFromToReverseForPatchBuilding()
+	mustDiscardPatch := self.c.Git().Patch.PatchBuilder.Active() && self.c.Git().Patch.PatchBuilder.NewPatchRequired(from, to, reverse)
+	return self.c.ConfirmIf(mustDiscardPatch, types.ConfirmOpts{
+		Title:  self.c.Tr.DiscardPatch,
+		Prompt: self.c.Tr.DiscardPatchConfirm,
+		HandleConfirm: func() error {
+			return self.c.WithWaitingStatus(self.c.Tr.UpdatingPatch, func(gocui.Task) error {
+				if mustDiscardPatch {
+					self.c.Git().Patch.PatchBuilder.Reset()
+				}
+
+				if !self.c.Git().Patch.PatchBuilder.Active() {
+					if err := self.startPatchBuilder(); err != nil {
+						return err
+					}
+				}
+
+				notAddedPaths, err := load()
+				if self.c.Git().Patch.PatchBuilder.IsEmpty() {
+					self.c.Git().Patch.PatchBuilder.Reset()
+				}
+				self.c.OnUIThread(func() error {
+					self.c.PostRefreshUpdate(self.context())
+					return nil
+				})
+				if err != nil {
+					return err
+				}
+
+				if len(notAddedPaths) > 0 {
+					self.c.OnUIThread(func() error {
+						self.c.Alert(self.c.Tr.PatchChangesNotLoadedTitle, utils.ResolvePlaceholderString(
+							self.c.Tr.PatchChangesNotLoaded, map[string]string{"files": strings.Join(notAddedPaths, "\n")}))
+						return nil
+					})
+				} else {
+					self.c.Toast(self.c.Tr.CustomPatchLoaded)
+				}
+				return nil
+			})
+		},
+	})
+}
+
 func (self *CommitFilesController) startPatchBuilder() error {
 	commitFilesContext := self.context()
 
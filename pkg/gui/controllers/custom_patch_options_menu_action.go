@@ -3,9 +3,11 @@ package controllers
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/jesseduffield/generics/set"
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
@@ -45,6 +47,12 @@ func (self *CustomPatchOptionsMenuAction) Call() error {
 			OnPress: func() error { return self.handleApplyPatch(true) },
 			Key:     'r',
 		},
+		{
+			Label:   self.c.Tr.ApplyPatchOntoOtherBranch,
+			Tooltip: self.c.Tr.ApplyPatchOntoOtherBranchTooltip,
+			OnPress: func() error { return self.handleCommitPatchOnOtherBranch(false) },
+			Key:     'b',
+		},
 	}
 
 	if self.c.Git().Patch.PatchBuilder.CanRebase && self.c.Git().Status.WorkingTreeState().None() {
@@ -73,6 +81,12 @@ func (self *CustomPatchOptionsMenuAction) Call() error {
 				OnPress: self.handlePullPatchIntoNewCommitBefore,
 				Key:     'N',
 			},
+			{
+				Label:   self.c.Tr.MovePatchToOtherBranch,
+				Tooltip: self.c.Tr.MovePatchToOtherBranchTooltip,
+				OnPress: func() error { return self.handleCommitPatchOnOtherBranch(true) },
+				Key:     'B',
+			},
 		}...)
 
 		if self.c.Context().Current().GetKey() == self.c.Contexts().LocalCommits.GetKey() {
@@ -109,6 +123,18 @@ func (self *CustomPatchOptionsMenuAction) Call() error {
 			OnPress: func() error { return self.copyPatchToClipboard() },
 			Key:     'y',
 		},
+		{
+			Label:   self.c.Tr.SaveCustomPatch,
+			Tooltip: self.c.Tr.SaveCustomPatchTooltip,
+			OnPress: self.handleSavePatch,
+			Key:     's',
+		},
+		{
+			Label:   self.c.Tr.ExportCustomPatch,
+			Tooltip: self.c.Tr.ExportCustomPatchTooltip,
+			OnPress: self.handleExportPatch,
+			Key:     'e',
+		},
 	}...)
 
 	return self.c.Menu(types.CreateMenuOptions{Title: self.c.Tr.PatchOptionsTitle, Items: menuItems})
@@ -258,6 +284,80 @@ func (self *CustomPatchOptionsMenuAction) handlePullPatchIntoNewCommitBefore() e
 	return nil
 }
 
+// Commits the patch onto another branch, optionally removing it from the
+// commit it was built from
+func (self *CustomPatchOptionsMenuAction) handleCommitPatchOnOtherBranch(move bool) error {
+	if move {
+		if ok, err := self.validateNormalWorkingTreeState(); !ok {
+			return err
+		}
+	}
+
+	self.returnFocusFromPatchExplorerIfNecessary()
+
+	self.c.Prompt(types.PromptOpts{
+		Title:               lo.Ternary(move, self.c.Tr.MovePatchToOtherBranchPrompt, self.c.Tr.ApplyPatchOntoOtherBranchPrompt),
+		FindSuggestionsFunc: self.c.Helpers().Suggestions.GetBranchNameSuggestionsFunc(),
+		HandleConfirm: func(branchName string) error {
+			branch, ok := lo.Find(self.c.Model().Branches, func(branch *models.Branch) bool { return branch.Name == branchName })
+			if !ok {
+				return errors.New(utils.ResolvePlaceholderString(self.c.Tr.BranchNotFound, map[string]string{"branch": branchName}))
+			}
+			if worktree, ok := git_commands.WorktreeForBranch(branch, self.c.Model().Worktrees); ok {
+				return errors.New(utils.ResolvePlaceholderString(self.c.Tr.CannotCommitPatchOnCheckedOutBranch,
+					map[string]string{"branch": branchName, "worktree": worktree.Name}))
+			}
+
+			return self.promptForCommitMessageOnOtherBranch(branchName, move)
+		},
+	})
+
+	return nil
+}
+
+func (self *CustomPatchOptionsMenuAction) promptForCommitMessageOnOtherBranch(branchName string, move bool) error {
+	initialMessage, err := self.c.Git().Commit.GetCommitMessage(self.c.Git().Patch.PatchBuilder.To)
+	if err != nil {
+		return err
+	}
+
+	self.c.Helpers().Commits.OpenCommitMessagePanel(
+		&helpers.OpenCommitMessagePanelOpts{
+			CommitIndex:      self.getPatchCommitIndex(),
+			InitialMessage:   initialMessage,
+			SummaryTitle:     utils.ResolvePlaceholderString(self.c.Tr.CommitSummaryOnBranchTitle, map[string]string{"branch": branchName}),
+			DescriptionTitle: self.c.Tr.CommitDescriptionTitle,
+			PreserveMessage:  false,
+			OnConfirm: func(summary string, description string) error {
+				return self.c.WithWaitingStatus(self.c.Tr.CommittingStatus, func(gocui.Task) error {
+					self.c.Helpers().Commits.CloseCommitMessagePanel()
+					self.c.LogAction(lo.Ternary(move, self.c.Tr.Actions.MovePatchToOtherBranch, self.c.Tr.Actions.ApplyPatchOntoOtherBranch))
+					if err := self.c.Git().Patch.CommitPatchOnBranch(branchName, summary, description); err != nil {
+						return err
+					}
+
+					toast := utils.ResolvePlaceholderString(self.c.Tr.PatchCommittedOnBranch, map[string]string{"branch": branchName})
+					if !move {
+						self.c.Toast(toast)
+						self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.BRANCHES}})
+						return nil
+					}
+
+					self.c.LogAction(self.c.Tr.Actions.RemovePatchFromCommit)
+					err := self.c.Git().Patch.DeletePatchesFromCommit(self.c.Model().Commits, self.getPatchCommitIndex())
+					if err := self.c.Helpers().MergeAndRebase.CheckMergeOrRebase(err); err != nil {
+						return err
+					}
+					self.c.Toast(toast)
+					return nil
+				})
+			},
+		},
+	)
+
+	return nil
+}
+
 func (self *CustomPatchOptionsMenuAction) handleApplyPatch(reverse bool) error {
 	self.returnFocusFromPatchExplorerIfNecessary()
 
@@ -303,6 +403,53 @@ func (self *CustomPatchOptionsMenuAction) copyPatchToClipboard() error {
 	return nil
 }
 
+func (self *CustomPatchOptionsMenuAction) handleSavePatch() error {
+	self.c.Prompt(types.PromptOpts{
+		Title: self.c.Tr.SaveCustomPatchPrompt,
+		HandleConfirm: func(name string) error {
+			name = strings.TrimSpace(name)
+			if name == "" || strings.ContainsAny(name, "/\\") {
+				return errors.New(self.c.Tr.InvalidPatchName)
+			}
+
+			return self.c.ConfirmIf(self.c.Git().Patch.SavedPatchExists(name), types.ConfirmOpts{
+				Title:  self.c.Tr.OverwriteSavedPatchTitle,
+				Prompt: utils.ResolvePlaceholderString(self.c.Tr.OverwriteSavedPatchPrompt, map[string]string{"name": name}),
+				HandleConfirm: func() error {
+					self.c.LogAction(self.c.Tr.Actions.SaveCustomPatch)
+					if err := self.c.Git().Patch.SaveCustomPatch(name); err != nil {
+						return err
+					}
+
+					self.c.Toast(self.c.Tr.CustomPatchSaved)
+					return nil
+				},
+			})
+		},
+	})
+
+	return nil
+}
+
+func (self *CustomPatchOptionsMenuAction) handleExportPatch() error {
+	self.c.Prompt(types.PromptOpts{
+		Title:               self.c.Tr.ExportCustomPatchPrompt,
+		InitialContent:      utils.ShortHash(self.c.Git().Patch.PatchBuilder.To) + ".patch",
+		FindSuggestionsFunc: self.c.Helpers().Suggestions.GetFilePathSuggestionsFunc(),
+		HandleConfirm: func(path string) error {
+			self.c.LogAction(self.c.Tr.Actions.ExportCustomPatch)
+			if err := self.c.Git().Patch.ExportCustomPatch(path); err != nil {
+				return err
+			}
+
+			self.c.Toast(self.c.Tr.CustomPatchExported)
+			return nil
+		},
+	})
+
+	return nil
+}
+
 // Returns a list of files that have unstaged changes and are contained in the patch.
 func (self *CustomPatchOptionsMenuAction) getAffectedUnstagedFiles() []string {
 	unstagedFiles := set.NewFromSlice(lo.FilterMap(self.c.Model().Files, func(f *models.File, _ int) (string, bool) {
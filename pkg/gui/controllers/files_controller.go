@@ -12,6 +12,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
 	"github.com/jesseduffield/lazygit/pkg/gui/filetree"
+	"github.com/jesseduffield/lazygit/pkg/gui/keybindings"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/samber/lo"
@@ -88,6 +89,13 @@ func (self *FilesController) GetKeybindings(opts types.KeybindingsOpts) []*types
 			Description: self.c.Tr.FindBaseCommitForFixup,
 			Tooltip:     self.c.Tr.FindBaseCommitForFixupTooltip,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Files.AbsorbStagedChanges),
+			Handler:     self.c.Helpers().FixupHelper.HandleAbsorbPress,
+			Description: self.c.Tr.AbsorbStagedChanges,
+			Tooltip:     self.c.Tr.AbsorbStagedChangesTooltip,
+			OpensMenu:   true,
+		},
 		{
 			Key:               opts.GetKey(opts.Config.Universal.Edit),
 			Handler:           self.withItems(self.edit),
@@ -129,6 +137,13 @@ func (self *FilesController) GetKeybindings(opts types.KeybindingsOpts) []*types
 			Tooltip:     self.c.Tr.ViewStashOptionsTooltip,
 			OpensMenu:   true,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Files.ViewUntrackedFilesOptions),
+			Handler:     self.createUntrackedFilesMenu,
+			Description: self.c.Tr.UntrackedFilesOptions,
+			Tooltip:     self.c.Tr.UntrackedFilesOptionsTooltip,
+			OpensMenu:   true,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Files.ToggleStagedAll),
 			Handler:     self.toggleStagedAll,
@@ -282,6 +297,20 @@ func (self *FilesController) GetOnRenderToMain() func() {
 
 			self.c.Helpers().MergeConflicts.ResetMergeState()
 
+			if node.File != nil && node.File.IsUntrackedDirectory {
+				cmdObj := self.c.Git().WorkingTree.UntrackedFilesCmdObj(node.GetPath())
+				prefix := utils.ResolvePlaceholderString(self.c.Tr.UntrackedDirectory,
+					map[string]string{"key": keybindings.Label(self.c.UserConfig().Keybinding.Files.ViewUntrackedFilesOptions)})
+				self.c.RenderToMainViews(types.RefreshMainOpts{
+					Pair: self.c.MainViewPairs().Normal,
+					Main: &types.ViewUpdateOpts{
+						Title: self.c.Tr.UnstagedChanges,
+						Task:  types.NewRunCommandTaskWithPrefix(cmdObj.GetCmd(), prefix+"\n\n"),
+					},
+				})
+				return
+			}
+
 			split := self.c.UserConfig().Gui.SplitDiff == "always" || (node.GetHasUnstagedChanges() && node.GetHasStagedChanges())
 			mainShowsStaged := !split && node.GetHasStagedChanges()
 
@@ -555,6 +584,11 @@ func (self *FilesController) EnterFile(opts types.OnFocusOpts) error {
 		return self.handleNonInlineConflict(file)
 	}
 
+	if file.IsUntrackedDirectory {
+		return errors.New(utils.ResolvePlaceholderString(self.c.Tr.CannotEnterUntrackedDirectory,
+			map[string]string{"key": keybindings.Label(self.c.UserConfig().Keybinding.Files.ViewUntrackedFilesOptions)}))
+	}
+
 	context := lo.Ternary(opts.ClickedWindowName == "secondary", self.c.Contexts().StagingSecondary, self.c.Contexts().Staging)
 	self.c.Context().Push(context, opts)
 	self.c.Helpers().PatchBuilding.ShowHunkStagingHint()
@@ -875,6 +909,35 @@ func (self *FilesController) handleStatusFilterPressed() error {
 	})
 }
 
+func (self *FilesController) createUntrackedFilesMenu() error {
+	currentMode := self.context().UntrackedFilesMode()
+	if currentMode == "" {
+		currentMode = self.c.Git().Loaders.FileLoader.DefaultUntrackedFilesMode()
+	}
+
+	menuItem := func(label string, mode string, key types.Key) *types.MenuItem {
+		return &types.MenuItem{
+			Label: label,
+			OnPress: func() error {
+				self.context().SetUntrackedFilesMode(mode)
+				self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.FILES}, Mode: types.ASYNC})
+				return nil
+			},
+			Key:    key,
+			Widget: types.MakeMenuRadioButton(currentMode == mode),
+		}
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.UntrackedFilesMenuTitle,
+		Items: []*types.MenuItem{
+			menuItem(self.c.Tr.UntrackedFilesNo, "no", 'n'),
+			menuItem(self.c.Tr.UntrackedFilesNormal, "normal", 'd'),
+			menuItem(self.c.Tr.UntrackedFilesAll, "all", 'a'),
+		},
+	})
+}
+
 func (self *FilesController) filteringLabel(filter filetree.FileTreeDisplayFilter) string {
 	switch filter {
 	case filetree.DisplayAll:
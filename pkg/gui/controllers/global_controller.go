@@ -102,6 +102,20 @@ func (self *GlobalController) GetKeybindings(opts types.KeybindingsOpts) []*type
 			Tooltip:     self.c.Tr.OpenFilteringMenuTooltip,
 			OpensMenu:   true,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Universal.OpenGlobalFinder),
+			Handler:     opts.Guards.NoPopupPanel(self.openGlobalFinder),
+			Description: self.c.Tr.OpenGlobalFinder,
+			Tooltip:     self.c.Tr.OpenGlobalFinderTooltip,
+			OpensMenu:   true,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Universal.OpenCommandPalette),
+			Handler:     opts.Guards.NoPopupPanel(self.openCommandPalette),
+			Description: self.c.Tr.OpenCommandPalette,
+			Tooltip:     self.c.Tr.OpenCommandPaletteTooltip,
+			OpensMenu:   true,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Universal.DiffingMenu),
 			Handler:     opts.Guards.NoPopupPanel(self.createDiffingMenu),
@@ -220,6 +234,14 @@ func (self *GlobalController) createFilteringMenu() error {
 	return (&FilteringMenuAction{c: self.c}).Call()
 }
 
+func (self *GlobalController) openGlobalFinder() error {
+	return (&GlobalFinderAction{c: self.c}).Call()
+}
+
+func (self *GlobalController) openCommandPalette() error {
+	return (&CommandPaletteAction{c: self.c}).Call()
+}
+
 func (self *GlobalController) createDiffingMenu() error {
 	return (&DiffingMenuAction{c: self.c}).Call()
 }
@@ -0,0 +1,133 @@
+package controllers
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/filetree"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+// Shows a menu for finding branches, remote branches, tags, commits, stash
+// entries, files and worktrees at once. The menu is filtered as you type;
+// picking an item focuses the panel that shows it and selects it there.
+type GlobalFinderAction struct {
+	c *ControllerCommon
+}
+
+func (self *GlobalFinderAction) Call() error {
+	menuItems := []*types.MenuItem{}
+	addItems := func(title string, labelColumns [][]string, onPress func(int) error) {
+		section := &types.MenuSection{Title: title}
+		for i, columns := range labelColumns {
+			menuItems = append(menuItems, &types.MenuItem{
+				LabelColumns: columns,
+				Section:      section,
+				OnPress:      func() error { return onPress(i) },
+			})
+		}
+	}
+
+	model := self.c.Model()
+	contexts := self.c.Contexts()
+
+	addItems(self.c.Tr.LocalBranchesTitle,
+		lo.Map(model.Branches, func(branch *models.Branch, _ int) []string {
+			return []string{branch.Name, style.FgCyan.Sprint(branch.ShortUpstreamRefName())}
+		}),
+		func(i int) error { return self.selectItem(contexts.Branches, i) })
+
+	remoteBranches := lo.FlatMap(model.Remotes, func(remote *models.Remote, _ int) []*models.RemoteBranch {
+		return remote.Branches
+	})
+	addItems(self.c.Tr.RemoteBranchesTitle,
+		lo.Map(remoteBranches, func(branch *models.RemoteBranch, _ int) []string {
+			return []string{branch.FullName()}
+		}),
+		func(i int) error { return self.selectRemoteBranch(remoteBranches[i]) })
+
+	addItems(self.c.Tr.TagsTitle,
+		lo.Map(model.Tags, func(tag *models.Tag, _ int) []string {
+			return []string{tag.Name, style.FgBlackLighter.Sprint(tag.Message)}
+		}),
+		func(i int) error { return self.selectItem(contexts.Tags, i) })
+
+	addItems(self.c.Tr.CommitsTitle,
+		lo.Map(model.Commits, func(commit *models.Commit, _ int) []string {
+			return []string{utils.ShortHash(commit.Hash()), commit.Name}
+		}),
+		func(i int) error { return self.selectItem(contexts.LocalCommits, i) })
+
+	addItems(self.c.Tr.StashTitle,
+		lo.Map(model.StashEntries, func(stashEntry *models.StashEntry, _ int) []string {
+			return []string{stashEntry.RefName(), stashEntry.Name}
+		}),
+		func(i int) error { return self.selectItem(contexts.Stash, i) })
+
+	addItems(self.c.Tr.FilesTitle,
+		lo.Map(model.Files, func(file *models.File, _ int) []string {
+			return []string{file.Path, style.FgGreen.Sprint(file.ShortStatus)}
+		}),
+		func(i int) error { return self.selectFile(model.Files[i].Path) })
+
+	addItems(self.c.Tr.WorktreesTitle,
+		lo.Map(model.Worktrees, func(worktree *models.Worktree, _ int) []string {
+			return []string{worktree.Name, style.FgBlackLighter.Sprint(worktree.Path)}
+		}),
+		func(i int) error { return self.selectItem(contexts.Worktrees, i) })
+
+	if err := self.c.Menu(types.CreateMenuOptions{
+		Title:                     self.c.Tr.GlobalFinderTitle,
+		Items:                     menuItems,
+		HideCancel:                true,
+		KeepSectionsWhenFiltering: true,
+	}); err != nil {
+		return err
+	}
+
+	return self.c.Helpers().Search.OpenFilterPrompt(contexts.Menu)
+}
+
+func (self *GlobalFinderAction) selectItem(context types.IListContext, idx int) error {
+	if filterableContext, ok := context.(types.IFilterableContext); ok && filterableContext.IsFiltering() {
+		filterableContext.ClearFilter()
+	}
+
+	context.GetList().SetSelection(idx)
+	self.c.PostRefreshUpdate(context)
+	self.c.Context().Push(context, types.OnFocusOpts{})
+	return nil
+}
+
+func (self *GlobalFinderAction) selectRemoteBranch(remoteBranch *models.RemoteBranch) error {
+	remoteIdx := lo.IndexOf(lo.Map(self.c.Model().Remotes, func(remote *models.Remote, _ int) string { return remote.Name }),
+		remoteBranch.RemoteName)
+	if remoteIdx == -1 {
+		return nil
+	}
+	remote := self.c.Model().Remotes[remoteIdx]
+	remotesContext := self.c.Contexts().Remotes
+	remotesContext.SetSelection(remoteIdx)
+
+	self.c.Model().RemoteBranches = remote.Branches
+	remoteBranchesContext := self.c.Contexts().RemoteBranches
+	remoteBranchesContext.SetTitleRef(remote.Name)
+	remoteBranchesContext.SetParentContext(remotesContext)
+	remoteBranchesContext.GetView().TitlePrefix = remotesContext.GetView().TitlePrefix
+	return self.selectItem(remoteBranchesContext, lo.IndexOf(remote.Branches, remoteBranch))
+}
+
+func (self *GlobalFinderAction) selectFile(path string) error {
+	filesContext := self.c.Contexts().Files
+	treePath := filetree.InternalTreePathForFilePath(path, self.c.UserConfig().Gui.ShowRootItemInFileTree)
+	filesContext.ExpandToPath(treePath)
+	filesContext.SetTree()
+	if idx, ok := filesContext.GetIndexForPath(treePath); ok {
+		filesContext.SetSelection(idx)
+	}
+
+	self.c.PostRefreshUpdate(filesContext)
+	self.c.Context().Push(filesContext, types.OnFocusOpts{})
+	return nil
+}
@@ -0,0 +1,327 @@
+package helpers
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+// Helps with deleting local branches that are no longer needed: branches that
+// have been merged (or squash-merged) into a main branch, branches whose
+// upstream is gone, and branches that haven't been committed to for a while.
+type BranchCleanupHelper struct {
+	c              *HelperCommon
+	branchesHelper *BranchesHelper
+
+	// Finding out whether a branch was squash-merged takes several git calls,
+	// so we remember the results. Since the key contains the hashes of both the
+	// branch and the main branch, entries never become outdated.
+	squashMergedCache      map[squashMergedCacheKey]bool
+	squashMergedCacheMutex sync.Mutex
+}
+
+type squashMergedCacheKey struct {
+	branchHash     string
+	mainBranchHash string
+}
+
+func NewBranchCleanupHelper(c *HelperCommon, branchesHelper *BranchesHelper) *BranchCleanupHelper {
+	return &BranchCleanupHelper{
+		c:                 c,
+		branchesHelper:    branchesHelper,
+		squashMergedCache: map[squashMergedCacheKey]bool{},
+	}
+}
+
+type branchCleanupCandidate struct {
+	branch  *models.Branch
+	reasons []string
+	checked bool
+}
+
+func (self *BranchCleanupHelper) OpenCleanupMenu() error {
+	return self.c.WithWaitingStatus(self.c.Tr.FindingBranchesToCleanUp, func(gocui.Task) error {
+		candidates, err := self.findCandidates()
+		if err != nil {
+			return err
+		}
+
+		self.c.OnUIThread(func() error {
+			if len(candidates) == 0 {
+				self.c.Toast(self.c.Tr.NoBranchesToCleanUp)
+				return nil
+			}
+
+			return self.showMenu(candidates, 0)
+		})
+		return nil
+	})
+}
+
+func (self *BranchCleanupHelper) findCandidates() ([]*branchCleanupCandidate, error) {
+	mainBranchNames := self.c.UserConfig().Git.MainBranches
+	mainBranchRefs := self.c.Model().MainBranches.Get()
+
+	staleBranchDays := self.c.UserConfig().Git.StaleBranchDays
+	var lastCommitTimes map[string]time.Time
+	if staleBranchDays > 0 {
+		var err error
+		lastCommitTimes, err = self.c.Git().Branch.GetLastCommitTimes()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	mainBranchHashes := self.mainBranchHashes(mainBranchRefs)
+
+	candidates := []*branchCleanupCandidate{}
+	for _, branch := range self.c.Model().Branches {
+		if branch.Head || branch.DetachedHead || lo.Contains(mainBranchNames, branch.Name) ||
+			self.branchesHelper.checkedOutByOtherWorktree(branch) {
+			continue
+		}
+
+		reasons := []string{}
+
+		merged, err := self.mergedInto(branch, mainBranchRefs)
+		if err != nil {
+			return nil, err
+		}
+		if merged != "" {
+			reasons = append(reasons, utils.ResolvePlaceholderString(
+				self.c.Tr.BranchCleanupMergedInto, map[string]string{"branchName": merged}))
+		} else if squashMerged := self.squashMergedInto(branch, mainBranchRefs, mainBranchHashes); squashMerged != "" {
+			merged = squashMerged
+			reasons = append(reasons, utils.ResolvePlaceholderString(
+				self.c.Tr.BranchCleanupSquashMergedInto, map[string]string{"branchName": squashMerged}))
+		}
+
+		if branch.UpstreamGone {
+			reasons = append(reasons, self.c.Tr.BranchCleanupUpstreamGone)
+		}
+
+		stale := false
+		if lastCommitTime, ok := lastCommitTimes[branch.Name]; ok {
+			stale = time.Since(lastCommitTime) > time.Duration(staleBranchDays)*24*time.Hour
+		}
+		if stale {
+			reasons = append(reasons, utils.ResolvePlaceholderString(
+				self.c.Tr.BranchCleanupStale, map[string]string{"days": fmt.Sprint(staleBranchDays)}))
+		}
+
+		if len(reasons) > 0 {
+			candidates = append(candidates, &branchCleanupCandidate{
+				branch:  branch,
+				reasons: reasons,
+				// Stale branches might still contain work that we want to keep,
+				// so we let the user opt in to deleting those.
+				checked: merged != "" || branch.UpstreamGone,
+			})
+		}
+	}
+
+	return candidates, nil
+}
+
+// Returns the short name of the first main branch that contains all commits of
+// the given branch, or an empty string if there is none.
+func (self *BranchCleanupHelper) mergedInto(branch *models.Branch, mainBranchRefs []string) (string, error) {
+	for _, mainBranchRef := range mainBranchRefs {
+		isMerged, err := self.c.Git().Branch.IsMergedInto(branch.FullRefName(), mainBranchRef)
+		if err != nil {
+			return "", err
+		}
+		if isMerged {
+			return ShortBranchName(mainBranchRef), nil
+		}
+	}
+	return "", nil
+}
+
+// Like mergedInto, but for branches that were squash-merged. Errors are only
+// logged, since they are expected e.g. for branches that don't share any
+// history with the main branch.
+func (self *BranchCleanupHelper) squashMergedInto(branch *models.Branch, mainBranchRefs []string, mainBranchHashes map[string]string) string {
+	for _, mainBranchRef := range mainBranchRefs {
+		isMerged, err := self.isSquashMergedInto(branch, mainBranchRef, mainBranchHashes[mainBranchRef])
+		if err != nil {
+			self.c.Log.Warnf("Could not determine whether %s was squash-merged into %s: %v", branch.Name, mainBranchRef, err)
+			continue
+		}
+		if isMerged {
+			return ShortBranchName(mainBranchRef)
+		}
+	}
+	return ""
+}
+
+func (self *BranchCleanupHelper) isSquashMergedInto(branch *models.Branch, mainBranchRef string, mainBranchHash string) (bool, error) {
+	key := squashMergedCacheKey{branchHash: branch.CommitHash, mainBranchHash: mainBranchHash}
+	canCache := key.branchHash != "" && key.mainBranchHash != ""
+	if canCache {
+		self.squashMergedCacheMutex.Lock()
+		isMerged, ok := self.squashMergedCache[key]
+		self.squashMergedCacheMutex.Unlock()
+		if ok {
+			return isMerged, nil
+		}
+	}
+
+	isMerged, err := self.c.Git().Branch.IsSquashMergedInto(branch.FullRefName(), mainBranchRef)
+	if err != nil {
+		return false, err
+	}
+
+	if canCache {
+		self.squashMergedCacheMutex.Lock()
+		self.squashMergedCache[key] = isMerged
+		self.squashMergedCacheMutex.Unlock()
+	}
+	return isMerged, nil
+}
+
+// Returns the commit hash of each main branch, keyed by ref. Main branches
+// whose hash can't be determined are left out.
+func (self *BranchCleanupHelper) mainBranchHashes(mainBranchRefs []string) map[string]string {
+	result := map[string]string{}
+	for _, mainBranchRef := range mainBranchRefs {
+		hash, err := self.c.Git().Commit.GetCommitHash(mainBranchRef)
+		if err != nil {
+			self.c.Log.Warnf("Could not resolve %s: %v", mainBranchRef, err)
+			continue
+		}
+		result[mainBranchRef] = hash
+	}
+	return result
+}
+
+func (self *BranchCleanupHelper) showMenu(candidates []*branchCleanupCandidate, selectedIdx int) error {
+	checkedBranches := func() []*models.Branch {
+		return lo.FilterMap(candidates, func(candidate *branchCleanupCandidate, _ int) (*models.Branch, bool) {
+			return candidate.branch, candidate.checked
+		})
+	}
+
+	var disabledReason *types.DisabledReason
+	if len(checkedBranches()) == 0 {
+		disabledReason = &types.DisabledReason{Text: self.c.Tr.NoBranchesSelected}
+	}
+
+	branchesSection := &types.MenuSection{Title: self.c.Tr.BranchCleanupBranchesSection}
+	actionsSection := &types.MenuSection{Title: self.c.Tr.BranchCleanupActionsSection}
+
+	menuItems := lo.Map(candidates, func(candidate *branchCleanupCandidate, i int) *types.MenuItem {
+		return &types.MenuItem{
+			LabelColumns: []string{
+				candidate.branch.Name,
+				style.FgBlackLighter.Sprint(strings.Join(candidate.reasons, ", ")),
+			},
+			Widget:  types.MakeMenuCheckBox(candidate.checked),
+			Section: branchesSection,
+			OnPress: func() error {
+				candidate.checked = !candidate.checked
+				return self.showMenu(candidates, i)
+			},
+		}
+	})
+
+	setAll := func(checked bool) func() error {
+		return func() error {
+			for _, candidate := range candidates {
+				candidate.checked = checked
+			}
+			return self.showMenu(candidates, len(candidates))
+		}
+	}
+
+	menuItems = append(menuItems,
+		&types.MenuItem{
+			Label:   self.c.Tr.SelectAllBranches,
+			Key:     'a',
+			Section: actionsSection,
+			OnPress: setAll(true),
+		},
+		&types.MenuItem{
+			Label:   self.c.Tr.SelectNoBranches,
+			Key:     'u',
+			Section: actionsSection,
+			OnPress: setAll(false),
+		},
+		&types.MenuItem{
+			Label:          self.c.Tr.DeleteSelectedBranchesLocally,
+			Key:            'd',
+			Section:        actionsSection,
+			DisabledReason: disabledReason,
+			OnPress: func() error {
+				return self.confirmDelete(checkedBranches(), false)
+			},
+		},
+		&types.MenuItem{
+			Label:          self.c.Tr.DeleteSelectedLocalAndRemoteBranches,
+			Key:            'D',
+			Section:        actionsSection,
+			DisabledReason: disabledReason,
+			OnPress: func() error {
+				return self.confirmDelete(checkedBranches(), true)
+			},
+		},
+	)
+
+	if err := self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.CleanUpBranches,
+		Items: menuItems,
+	}); err != nil {
+		return err
+	}
+
+	self.c.Contexts().Menu.SetSelection(selectedIdx)
+	self.c.Contexts().Menu.HandleFocus(types.OnFocusOpts{})
+	return nil
+}
+
+func (self *BranchCleanupHelper) confirmDelete(branches []*models.Branch, deleteRemote bool) error {
+	prompt := utils.ResolvePlaceholderString(
+		lo.Ternary(deleteRemote, self.c.Tr.CleanUpLocalAndRemoteBranchesPrompt, self.c.Tr.CleanUpBranchesPrompt),
+		map[string]string{"count": fmt.Sprint(len(branches))},
+	)
+
+	self.c.Confirm(types.ConfirmOpts{
+		Title:  self.c.Tr.CleanUpBranches,
+		Prompt: prompt,
+		HandleConfirm: func() error {
+			return self.c.WithWaitingStatus(self.c.Tr.DeletingStatus, func(task gocui.Task) error {
+				if deleteRemote {
+					// Delete the remote branches first so that we keep the local
+					// ones in case of failure
+					remoteBranches := lo.FilterMap(branches, func(branch *models.Branch, _ int) (*models.RemoteBranch, bool) {
+						return &models.RemoteBranch{Name: branch.UpstreamBranch, RemoteName: branch.UpstreamRemote},
+							branch.IsTrackingRemote() && !branch.UpstreamGone
+					})
+					if err := self.branchesHelper.deleteRemoteBranches(remoteBranches, task); err != nil {
+						return err
+					}
+				}
+
+				self.c.LogAction(self.c.Tr.Actions.DeleteLocalBranch)
+				branchNames := lo.Map(branches, func(branch *models.Branch, _ int) string { return branch.Name })
+				if err := self.c.Git().Branch.LocalDelete(branchNames, true); err != nil {
+					return err
+				}
+
+				self.c.Contexts().Branches.CollapseRangeSelectionToTop()
+				self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.BRANCHES, types.REMOTES}})
+				return nil
+			})
+		},
+	})
+
+	return nil
+}
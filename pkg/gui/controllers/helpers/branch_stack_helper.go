@@ -0,0 +1,209 @@
+package helpers
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+// Helps with stacks of branches, i.e. branches that are built on top of each
+// other, each of them typically being the head of a separate pull request.
+type BranchStackHelper struct {
+	c                    *HelperCommon
+	mergeAndRebaseHelper *MergeAndRebaseHelper
+	hostHelper           *HostHelper
+}
+
+func NewBranchStackHelper(
+	c *HelperCommon,
+	mergeAndRebaseHelper *MergeAndRebaseHelper,
+	hostHelper *HostHelper,
+) *BranchStackHelper {
+	return &BranchStackHelper{
+		c:                    c,
+		mergeAndRebaseHelper: mergeAndRebaseHelper,
+		hostHelper:           hostHelper,
+	}
+}
+
+func (self *BranchStackHelper) OpenStackMenu(selectedBranch *models.Branch) error {
+	stacks, err := self.c.Git().Branch.GetBranchStacks(self.c.Model().MainBranches)
+	if err != nil {
+		return err
+	}
+
+	node := models.FindBranchStackNode(stacks, selectedBranch.Name)
+	if node == nil || (node.Parent == nil && len(node.Children) == 0) {
+		return errors.New(self.c.Tr.BranchIsNotPartOfAStack)
+	}
+
+	root := node.Root()
+	nodes := root.Flatten()
+
+	baseBranch := ""
+	if rootBranch := self.findBranch(root.BranchName); rootBranch != nil {
+		baseBranch, err = self.c.Git().Loaders.BranchLoader.GetBaseBranch(rootBranch, self.c.Model().MainBranches)
+		if err != nil {
+			return err
+		}
+	}
+
+	var restackDisabledReason *types.DisabledReason
+	top := root.Top()
+	if baseBranch == "" {
+		restackDisabledReason = &types.DisabledReason{Text: self.c.Tr.CouldNotDetermineBaseBranch}
+	} else if top == nil {
+		restackDisabledReason = &types.DisabledReason{Text: self.c.Tr.CannotRestackForkedStack}
+	}
+
+	var pullRequestsDisabledReason *types.DisabledReason
+	if lo.SomeBy(nodes, func(node *models.BranchStackNode) bool {
+		branch := self.findBranch(node.BranchName)
+		return branch == nil || !branch.IsTrackingRemote()
+	}) {
+		pullRequestsDisabledReason = &types.DisabledReason{Text: self.c.Tr.StackNotPushed}
+	}
+
+	baseBranchName := lo.Ternary(baseBranch != "", ShortBranchName(baseBranch), self.c.Tr.CouldNotDetermineBaseBranch)
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title:  self.c.Tr.BranchStack,
+		Prompt: presentation.GetBranchStackTreeString(root, baseBranchName),
+		Items: []*types.MenuItem{
+			{
+				Label: utils.ResolvePlaceholderString(self.c.Tr.RestackBranches,
+					map[string]string{"baseBranch": baseBranchName}),
+				Tooltip:        self.c.Tr.RestackBranchesTooltip,
+				Key:            'r',
+				DisabledReason: restackDisabledReason,
+				OnPress: func() error {
+					return self.restack(top, baseBranch)
+				},
+			},
+			{
+				Label:   self.c.Tr.PushStack,
+				Tooltip: self.c.Tr.PushStackTooltip,
+				Key:     'p',
+				OnPress: func() error {
+					return self.push(nodes)
+				},
+			},
+			{
+				Label:          self.c.Tr.OpenStackPullRequests,
+				Tooltip:        self.c.Tr.OpenStackPullRequestsTooltip,
+				Key:            'o',
+				DisabledReason: pullRequestsDisabledReason,
+				OnPress: func() error {
+					return self.openPullRequests(nodes, baseBranch)
+				},
+			},
+		},
+	})
+}
+
+func (self *BranchStackHelper) restack(top *models.BranchStackNode, baseBranch string) error {
+	self.c.LogAction(self.c.Tr.Actions.RestackBranches)
+	return self.c.WithWaitingStatus(self.c.Tr.RebasingStatus, func(gocui.Task) error {
+		err := self.c.Git().Rebase.RebaseBranchStack(top.BranchName, baseBranch)
+		return self.mergeAndRebaseHelper.CheckMergeOrRebase(err)
+	})
+}
+
+type stackPushTarget struct {
+	remote      string
+	setUpstream bool
+}
+
+func (self *BranchStackHelper) push(nodes []*models.BranchStackNode) error {
+	defaultRemote := self.defaultRemote()
+
+	refspecsByTarget := map[stackPushTarget][]git_commands.PushRefspec{}
+	targets := []stackPushTarget{}
+	for _, node := range nodes {
+		branch := self.findBranch(node.BranchName)
+		if branch == nil {
+			continue
+		}
+
+		target := stackPushTarget{remote: branch.UpstreamRemote}
+		refspec := git_commands.PushRefspec{LocalBranch: branch.Name, RemoteBranch: branch.UpstreamBranch}
+		if !branch.IsTrackingRemote() {
+			if defaultRemote == "" {
+				return errors.New(self.c.Tr.NoRemotesToPushStackTo)
+			}
+			target = stackPushTarget{remote: defaultRemote, setUpstream: true}
+			refspec.RemoteBranch = branch.Name
+		}
+
+		if _, ok := refspecsByTarget[target]; !ok {
+			targets = append(targets, target)
+		}
+		refspecsByTarget[target] = append(refspecsByTarget[target], refspec)
+	}
+
+	self.c.LogAction(self.c.Tr.Actions.PushStack)
+	return self.c.WithWaitingStatus(self.c.Tr.PushingStatus, func(task gocui.Task) error {
+		for _, target := range targets {
+			if err := self.c.Git().Sync.PushBranchesWithLease(task, target.remote, refspecsByTarget[target], target.setUpstream); err != nil {
+				return err
+			}
+		}
+
+		self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.BRANCHES, types.REMOTES}})
+		return nil
+	})
+}
+
+// Opens a pull request for every branch in the stack, each one targeting the
+// branch below it (or the base branch for the bottom one).
+func (self *BranchStackHelper) openPullRequests(nodes []*models.BranchStackNode, baseBranch string) error {
+	self.c.LogAction(self.c.Tr.Actions.OpenPullRequest)
+	for _, node := range nodes {
+		from := self.findBranch(node.BranchName).UpstreamBranch
+
+		to := ""
+		if node.Parent != nil {
+			to = self.findBranch(node.Parent.BranchName).UpstreamBranch
+		} else if strings.HasPrefix(baseBranch, "refs/remotes/") {
+			// strip the remote name
+			_, to, _ = strings.Cut(ShortBranchName(baseBranch), "/")
+		} else {
+			to = ShortBranchName(baseBranch)
+		}
+
+		url, err := self.hostHelper.GetPullRequestURL(from, to)
+		if err != nil {
+			return err
+		}
+		if err := self.c.OS().OpenLink(url); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (self *BranchStackHelper) findBranch(name string) *models.Branch {
+	branch, _ := lo.Find(self.c.Model().Branches, func(branch *models.Branch) bool {
+		return branch.Name == name
+	})
+	return branch
+}
+
+func (self *BranchStackHelper) defaultRemote() string {
+	remotes := self.c.Model().Remotes
+	if lo.SomeBy(remotes, func(remote *models.Remote) bool { return remote.Name == "origin" }) {
+		return "origin"
+	}
+	if len(remotes) > 0 {
+		return remotes[0].Name
+	}
+	return ""
+}
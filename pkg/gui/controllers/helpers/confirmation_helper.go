@@ -9,6 +9,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/theme"
 	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
 type ConfirmationHelper struct {
@@ -296,6 +297,10 @@ func (self *ConfirmationHelper) ResizeCurrentPopupPanels() {
 			self.resizePromptPanel(parentPopupContext)
 		case self.c.Contexts().CommitMessage, self.c.Contexts().CommitDescription:
 			self.ResizeCommitMessagePanels(parentPopupContext)
+		case self.c.Contexts().HunkEditor:
+			self.resizeHunkEditor()
+		case self.c.Contexts().CommandLogEntries:
+			self.resizeCommandLogEntries()
 		}
 
 		parentPopupContext = c
@@ -396,6 +401,20 @@ func (self *ConfirmationHelper) ResizeCommitMessagePanels(parentPopupContext typ
 	_, _ = self.c.GocuiGui().SetView(self.c.Views().CommitDescription.Name(), x0, y0+summaryViewHeight, x1, y1+summaryViewHeight, 0)
 }
 
+// The hunk editor covers the staging view that the hunk comes from, so that
+// editing a hunk feels like editing it in place
+func (self *ConfirmationHelper) resizeHunkEditor() {
+	stagingView := lo.Ternary(self.c.Contexts().HunkEditor.State.Staged, self.c.Views().StagingSecondary, self.c.Views().Staging)
+	x0, y0, x1, y1 := stagingView.Dimensions()
+	_, _ = self.c.GocuiGui().SetView(self.c.Views().HunkEditor.Name(), x0, y0, x1, y1, 0)
+}
+
+// The command log entries are shown full-screen, so that long commands fit
+func (self *ConfirmationHelper) resizeCommandLogEntries() {
+	width, height := self.c.GocuiGui().Size()
+	_, _ = self.c.GocuiGui().SetView(self.c.Views().CommandLogEntries.Name(), 0, 0, width-1, height-2, 0)
+}
+
 func (self *ConfirmationHelper) IsPopupPanel(context types.Context) bool {
 	return context.GetKind() == types.PERSISTENT_POPUP || context.GetKind() == types.TEMPORARY_POPUP
 }
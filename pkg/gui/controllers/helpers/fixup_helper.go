@@ -4,25 +4,34 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"slices"
 	"strings"
 
 	"github.com/jesseduffield/generics/set"
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/patch"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/i18n"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/samber/lo"
 	"golang.org/x/sync/errgroup"
 )
 
 type FixupHelper struct {
-	c *HelperCommon
+	c              *HelperCommon
+	mergeAndRebase *MergeAndRebaseHelper
 }
 
 func NewFixupHelper(
 	c *HelperCommon,
+	mergeAndRebaseHelper *MergeAndRebaseHelper,
 ) *FixupHelper {
 	return &FixupHelper{
-		c: c,
+		c:              c,
+		mergeAndRebase: mergeAndRebaseHelper,
 	}
 }
 
@@ -43,34 +52,72 @@ type hunk struct {
 }
 
 func (self *FixupHelper) HandleFindBaseCommitForFixupPress() error {
-	diff, hasStagedChanges, err := self.getDiff()
+	base, err := FindBaseCommitForFixup(self.c.Git(), self.c.Tr, self.c.Model().Commits)
 	if err != nil {
 		return err
 	}
 
-	deletedLineHunks, addedLineHunks := parseDiff(diff)
+	return self.c.ConfirmIf(base.HasHunksWithOnlyAddedLines, types.ConfirmOpts{
+		Title:  self.c.Tr.FindBaseCommitForFixup,
+		Prompt: self.c.Tr.HunksWithOnlyAddedLinesWarning,
+		HandleConfirm: func() error {
+			if !base.HasStagedChanges {
+				if err := self.c.Git().WorkingTree.StageAll(true); err != nil {
+					return err
+				}
+				self.c.Refresh(types.RefreshOptions{Mode: types.SYNC, Scope: []types.RefreshableView{types.FILES}})
+			}
+
+			self.c.Contexts().LocalCommits.SetSelection(base.Index)
+			self.c.Context().Push(self.c.Contexts().LocalCommits, types.OnFocusOpts{})
+			return nil
+		},
+	})
+}
 
-	commits := self.c.Model().Commits
+// The commit that the current changes should be squashed into, as found by
+// FindBaseCommitForFixup
+type FixupBaseCommit struct {
+	Commit *models.Commit
+	// index of the commit in the list of commits that was passed in
+	Index int
+	// false if there were no staged changes, so the unstaged ones were used
+	HasStagedChanges bool
+	// if true, the changes also contain hunks with only added lines, which
+	// were ignored; they might belong to a different commit
+	HasHunksWithOnlyAddedLines bool
+}
+
+// Finds the commit of the current branch that the staged changes (or, if there
+// are none, the unstaged changes) should be squashed into, by blaming the lines
+// they touch. Returns an error if there isn't exactly one such commit.
+func FindBaseCommitForFixup(git *commands.GitCommand, tr *i18n.TranslationSet, commits []*models.Commit) (*FixupBaseCommit, error) {
+	diff, hasStagedChanges, err := getFixupDiff(git)
+	if err != nil {
+		return nil, err
+	}
+
+	deletedLineHunks, addedLineHunks := parseDiff(diff)
 
 	var hashes []string
 	warnAboutAddedLines := false
 
 	if len(deletedLineHunks) > 0 {
-		hashes, err = self.blameDeletedLines(deletedLineHunks)
+		hashes, err = blameDeletedLines(git, deletedLineHunks)
 		warnAboutAddedLines = len(addedLineHunks) > 0
 	} else if len(addedLineHunks) > 0 {
-		hashes, err = self.blameAddedLines(commits, addedLineHunks)
+		hashes, err = blameAddedLines(git, tr, commits, addedLineHunks)
 	} else {
-		return errors.New(self.c.Tr.NoChangedFiles)
+		return nil, errors.New(tr.NoChangedFiles)
 	}
 
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if len(hashes) == 0 {
 		// This should never happen
-		return errors.New(self.c.Tr.NoBaseCommitsFound)
+		return nil, errors.New(tr.NoBaseCommitsFound)
 	}
 
 	// If a commit can't be found, and the last known commit is already merged,
@@ -86,7 +133,7 @@ func (self *FixupHelper) HandleFindBaseCommitForFixupPress() error {
 
 	// Group the hashes into buckets by merged status
 	hashGroups := lo.GroupBy(hashes, func(hash string) int {
-		commit, _, ok := self.findCommit(commits, hash)
+		commit, _, ok := findCommit(commits, hash)
 		if ok {
 			return lo.Ternary(commit.Status == models.StatusMerged, MERGED, NOT_MERGED)
 		}
@@ -100,63 +147,53 @@ func (self *FixupHelper) HandleFindBaseCommitForFixupPress() error {
 		// branch. Both are so unlikely that we don't bother returning a more
 		// detailed error message (e.g. we could say something about the commits
 		// that *are* in the current branch, but it's not worth it).
-		return errors.New(self.c.Tr.BaseCommitIsNotInCurrentView)
+		return nil, errors.New(tr.BaseCommitIsNotInCurrentView)
 	}
 
 	if len(hashGroups[NOT_MERGED]) == 0 {
 		// If all the commits are merged, show the "already on main branch"
 		// error. It isn't worth doing a detailed report of which commits we
 		// found.
-		return errors.New(self.c.Tr.BaseCommitIsAlreadyOnMainBranch)
+		return nil, errors.New(tr.BaseCommitIsAlreadyOnMainBranch)
 	}
 
 	if len(hashGroups[NOT_MERGED]) > 1 {
 		// If there are multiple commits that could be the base commit, list
 		// them in the error message. But only the candidates from the current
 		// branch, not including any that are already merged.
-		subjects, err := self.c.Git().Commit.GetHashesAndCommitMessagesFirstLine(hashGroups[NOT_MERGED])
+		subjects, err := git.Commit.GetHashesAndCommitMessagesFirstLine(hashGroups[NOT_MERGED])
 		if err != nil {
-			return err
+			return nil, err
 		}
 		message := lo.Ternary(hasStagedChanges,
-			self.c.Tr.MultipleBaseCommitsFoundStaged,
-			self.c.Tr.MultipleBaseCommitsFoundUnstaged)
-		return fmt.Errorf("%s\n\n%s", message, subjects)
+			tr.MultipleBaseCommitsFoundStaged,
+			tr.MultipleBaseCommitsFoundUnstaged)
+		return nil, fmt.Errorf("%s\n\n%s", message, subjects)
 	}
 
 	// At this point we know that the NOT_MERGED bucket has exactly one commit,
-	// and that's the one we want to select.
-	_, index, _ := self.findCommit(commits, hashGroups[NOT_MERGED][0])
-
-	return self.c.ConfirmIf(warnAboutAddedLines, types.ConfirmOpts{
-		Title:  self.c.Tr.FindBaseCommitForFixup,
-		Prompt: self.c.Tr.HunksWithOnlyAddedLinesWarning,
-		HandleConfirm: func() error {
-			if !hasStagedChanges {
-				if err := self.c.Git().WorkingTree.StageAll(true); err != nil {
-					return err
-				}
-				self.c.Refresh(types.RefreshOptions{Mode: types.SYNC, Scope: []types.RefreshableView{types.FILES}})
-			}
-
-			self.c.Contexts().LocalCommits.SetSelection(index)
-			self.c.Context().Push(self.c.Contexts().LocalCommits, types.OnFocusOpts{})
-			return nil
-		},
-	})
+	// and that's the one we want.
+	commit, index, _ := findCommit(commits, hashGroups[NOT_MERGED][0])
+
+	return &FixupBaseCommit{
+		Commit:                     commit,
+		Index:                      index,
+		HasStagedChanges:           hasStagedChanges,
+		HasHunksWithOnlyAddedLines: warnAboutAddedLines,
+	}, nil
 }
 
-func (self *FixupHelper) getDiff() (string, bool, error) {
+func getFixupDiff(git *commands.GitCommand) (string, bool, error) {
 	args := []string{"-U0", "--ignore-submodules=all", "HEAD", "--"}
 
 	// Try staged changes first
 	hasStagedChanges := true
-	diff, err := self.c.Git().Diff.DiffIndexCmdObj(append([]string{"--cached"}, args...)...).RunWithOutput()
+	diff, err := git.Diff.DiffIndexCmdObj(append([]string{"--cached"}, args...)...).RunWithOutput()
 
 	if err == nil && diff == "" {
 		hasStagedChanges = false
 		// If there are no staged changes, try unstaged changes
-		diff, err = self.c.Git().Diff.DiffIndexCmdObj(args...).RunWithOutput()
+		diff, err = git.Diff.DiffIndexCmdObj(args...).RunWithOutput()
 	}
 
 	return diff, hasStagedChanges, err
@@ -215,13 +252,13 @@ func parseDiff(diff string) ([]*hunk, []*hunk) {
 }
 
 // returns the list of commit hashes that introduced the lines which have now been deleted
-func (self *FixupHelper) blameDeletedLines(deletedLineHunks []*hunk) ([]string, error) {
+func blameDeletedLines(git *commands.GitCommand, deletedLineHunks []*hunk) ([]string, error) {
 	errg := errgroup.Group{}
 	hashChan := make(chan string)
 
 	for _, h := range deletedLineHunks {
 		errg.Go(func() error {
-			blameOutput, err := self.c.Git().Blame.BlameLineRange(h.filename, "HEAD", h.startLineIdx, h.numLines)
+			blameOutput, err := git.Blame.BlameLineRange(h.filename, "HEAD", h.startLineIdx, h.numLines)
 			if err != nil {
 				return err
 			}
@@ -249,7 +286,7 @@ func (self *FixupHelper) blameDeletedLines(deletedLineHunks []*hunk) ([]string,
 	return result.ToSlice(), errg.Wait()
 }
 
-func (self *FixupHelper) blameAddedLines(commits []*models.Commit, addedLineHunks []*hunk) ([]string, error) {
+func blameAddedLines(git *commands.GitCommand, tr *i18n.TranslationSet, commits []*models.Commit, addedLineHunks []*hunk) ([]string, error) {
 	errg := errgroup.Group{}
 	hashesChan := make(chan []string)
 
@@ -266,7 +303,7 @@ func (self *FixupHelper) blameAddedLines(commits []*models.Commit, addedLineHunk
 
 			// Blame the line before this hunk, if there is one
 			if h.startLineIdx > 0 {
-				blameOutput, err := self.c.Git().Blame.BlameLineRange(h.filename, "HEAD", h.startLineIdx, 1)
+				blameOutput, err := git.Blame.BlameLineRange(h.filename, "HEAD", h.startLineIdx, 1)
 				if err != nil {
 					return err
 				}
@@ -276,7 +313,7 @@ func (self *FixupHelper) blameAddedLines(commits []*models.Commit, addedLineHunk
 			// Blame the line after this hunk. We don't know how many lines the
 			// file has, so we can't check if there is a line after the hunk;
 			// let the error tell us.
-			blameOutput, err := self.c.Git().Blame.BlameLineRange(h.filename, "HEAD", h.startLineIdx+1, 1)
+			blameOutput, err := git.Blame.BlameLineRange(h.filename, "HEAD", h.startLineIdx+1, 1)
 			if err != nil {
 				// If this fails, we're probably at the end of the file (we
 				// could have checked this beforehand, but it's expensive). If
@@ -312,8 +349,8 @@ func (self *FixupHelper) blameAddedLines(commits []*models.Commit, addedLineHunk
 			if hashes[0] == hashes[1] {
 				result.Add(hashes[0])
 			} else {
-				_, index1, ok1 := self.findCommit(commits, hashes[0])
-				_, index2, ok2 := self.findCommit(commits, hashes[1])
+				_, index1, ok1 := findCommit(commits, hashes[0])
+				_, index2, ok2 := findCommit(commits, hashes[1])
 				if ok1 && ok2 {
 					result.Add(lo.Ternary(index1 < index2, hashes[0], hashes[1]))
 				} else if ok1 {
@@ -321,7 +358,7 @@ func (self *FixupHelper) blameAddedLines(commits []*models.Commit, addedLineHunk
 				} else if ok2 {
 					result.Add(hashes[1])
 				} else {
-					return nil, errors.New(self.c.Tr.NoBaseCommitsFound)
+					return nil, errors.New(tr.NoBaseCommitsFound)
 				}
 			}
 		}
@@ -330,7 +367,302 @@ func (self *FixupHelper) blameAddedLines(commits []*models.Commit, addedLineHunk
 	return result.ToSlice(), errg.Wait()
 }
 
-func (self *FixupHelper) findCommit(commits []*models.Commit, hash string) (*models.Commit, int, bool) {
+// A file of the staged changes, diffed without context lines
+type absorbFile struct {
+	// empty for files that were added
+	oldPath string
+	// empty for files that were deleted
+	newPath string
+	patch   *patch.Patch
+	hunks   []*absorbHunk
+}
+
+type absorbHunk struct {
+	// the commit that the hunk is absorbed into; empty if the hunk can't be
+	// attributed to a single commit of the current branch, in which case
+	// reason says why
+	targetHash string
+	reason     string
+}
+
+func (self *FixupHelper) HandleAbsorbPress() error {
+	if !AnyStagedFiles(self.c.Model().Files) {
+		return errors.New(self.c.Tr.NoStagedChangesToAbsorb)
+	}
+
+	var squashDisabledReason *types.DisabledReason
+	if self.c.Model().WorkingTreeStateAtLastCommitRefresh.Any() {
+		squashDisabledReason = &types.DisabledReason{Text: self.c.Tr.AlreadyRebasing}
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.AbsorbStagedChanges,
+		Items: []*types.MenuItem{
+			{
+				Label:   self.c.Tr.AbsorbCreateFixupCommits,
+				Key:     'f',
+				OnPress: func() error { return self.absorb(false) },
+			},
+			{
+				Label:          self.c.Tr.AbsorbCreateAndSquashFixupCommits,
+				Key:            's',
+				DisabledReason: squashDisabledReason,
+				OnPress:        func() error { return self.absorb(true) },
+			},
+		},
+	})
+}
+
+func (self *FixupHelper) absorb(squash bool) error {
+	return self.c.WithWaitingStatus(self.c.Tr.AbsorbingStatus, func(gocui.Task) error {
+		files, err := self.attributeStagedHunks()
+		if err != nil {
+			return err
+		}
+
+		commits := self.c.Model().Commits
+		targetHashes := lo.Uniq(lo.FlatMap(files, func(file *absorbFile, _ int) []string {
+			return lo.FilterMap(file.hunks, func(hunk *absorbHunk, _ int) (string, bool) {
+				return hunk.targetHash, hunk.targetHash != ""
+			})
+		}))
+		// Create the fixup commits from the oldest target commit to the newest
+		slices.SortFunc(targetHashes, func(a, b string) int {
+			_, indexA, _ := findCommit(commits, a)
+			_, indexB, _ := findCommit(commits, b)
+			return indexB - indexA
+		})
+
+		unattributed := self.unattributedHunkDescriptions(files)
+		if len(targetHashes) == 0 {
+			return fmt.Errorf("%s\n\n%s", self.c.Tr.AbsorbNoHunksAttributed, strings.Join(unattributed, "\n"))
+		}
+
+		self.c.LogAction(self.c.Tr.Actions.AbsorbStagedChanges)
+		if err := self.createAbsorbFixupCommits(files, targetHashes); err != nil {
+			self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC})
+			return err
+		}
+
+		if squash && len(unattributed) == 0 {
+			oldestTarget, _, _ := findCommit(commits, targetHashes[0])
+			err := self.c.Git().Rebase.SquashAllAboveFixupCommits(oldestTarget)
+			if err := self.mergeAndRebase.CheckMergeOrRebase(err); err != nil {
+				return err
+			}
+		} else {
+			self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC})
+		}
+
+		if len(unattributed) == 0 {
+			self.c.Toast(utils.ResolvePlaceholderString(self.c.Tr.AbsorbedStagedChanges,
+				map[string]string{"count": fmt.Sprint(len(targetHashes))}))
+			return nil
+		}
+
+		message := self.c.Tr.AbsorbHunksLeftStaged
+		if squash {
+			message += " " + self.c.Tr.AbsorbFixupsNotSquashed
+		}
+		self.c.OnUIThread(func() error {
+			self.c.Alert(self.c.Tr.AbsorbStagedChanges, message+"\n\n"+strings.Join(unattributed, "\n"))
+			return nil
+		})
+		return nil
+	})
+}
+
+// Blames every hunk of the staged changes to find the commit it belongs to
+func (self *FixupHelper) attributeStagedHunks() ([]*absorbFile, error) {
+	diff, err := self.c.Git().Diff.DiffIndexCmdObj("--cached", "-U0", "--ignore-submodules=all", "HEAD", "--").RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	files := lo.Map(splitDiffByFile(diff), func(fileDiff string, _ int) *absorbFile {
+		file := &absorbFile{patch: patch.Parse(fileDiff)}
+		file.oldPath, file.newPath = file.patch.OldPath(), file.patch.NewPath()
+		file.hunks = lo.Times(file.patch.HunkCount(), func(int) *absorbHunk { return &absorbHunk{} })
+		return file
+	})
+
+	errg := errgroup.Group{}
+	for _, file := range files {
+		for i, hunk := range file.hunks {
+			if file.oldPath == "" {
+				hunk.reason = self.c.Tr.AbsorbReasonNewFile
+				continue
+			}
+
+			errg.Go(func() error {
+				return self.attributeHunk(file, i, hunk)
+			})
+		}
+	}
+
+	return files, errg.Wait()
+}
+
+func (self *FixupHelper) attributeHunk(file *absorbFile, hunkIdx int, hunk *absorbHunk) error {
+	hashes := set.New[string]()
+	addBlamedHashes := func(blameOutput string) {
+		for _, line := range strings.Split(strings.TrimSuffix(blameOutput, "\n"), "\n") {
+			hashes.Add(strings.Split(line, " ")[0])
+		}
+	}
+
+	startLine, numLines := file.patch.HunkOldLineRange(hunkIdx)
+	if numLines > 0 {
+		blameOutput, err := self.c.Git().Blame.BlameLineRange(file.oldPath, "HEAD", startLine, numLines)
+		if err != nil {
+			return err
+		}
+		addBlamedHashes(blameOutput)
+	} else {
+		// The hunk only adds lines after startLine; look at the lines around
+		// it. If the lines are added at the end of the file, there is no line
+		// after it, so we ignore that error.
+		if startLine > 0 {
+			blameOutput, err := self.c.Git().Blame.BlameLineRange(file.oldPath, "HEAD", startLine, 1)
+			if err != nil {
+				return err
+			}
+			addBlamedHashes(blameOutput)
+		}
+		if blameOutput, err := self.c.Git().Blame.BlameLineRange(file.oldPath, "HEAD", startLine+1, 1); err == nil {
+			addBlamedHashes(blameOutput)
+		}
+	}
+
+	if hashes.Len() != 1 {
+		hunk.reason = self.c.Tr.AbsorbReasonSeveralCommits
+		return nil
+	}
+
+	// Lines from the root commit are marked as boundary lines by git blame,
+	// with the hash prefixed by '^' and shortened by one character
+	hashPrefix := strings.TrimPrefix(hashes.ToSlice()[0], "^")
+	commit, ok := lo.Find(self.c.Model().Commits, func(commit *models.Commit) bool {
+		return strings.HasPrefix(commit.Hash(), hashPrefix)
+	})
+	if !ok || commit.Status == models.StatusMerged {
+		hunk.reason = self.c.Tr.AbsorbReasonNotInCurrentBranch
+		return nil
+	}
+
+	hunk.targetHash = commit.Hash()
+	return nil
+}
+
+// Creates one fixup commit per target commit, containing the hunks attributed
+// to it. Everything else that was staged is staged again afterwards.
+func (self *FixupHelper) createAbsorbFixupCommits(files []*absorbFile, targetHashes []string) (err error) {
+	isAttributed := func(file *absorbFile, _ int) bool {
+		return lo.SomeBy(file.hunks, func(hunk *absorbHunk) bool { return hunk.targetHash != "" })
+	}
+	attributedFiles, otherFiles := lo.Filter(files, isAttributed), lo.Reject(files, isAttributed)
+
+	// Files that we don't touch could contain binary changes, so we save them
+	// as a binary patch with context, which we can re-apply as is.
+	otherFilesPatch := ""
+	if len(otherFiles) > 0 {
+		otherPaths := lo.Map(otherFiles, func(file *absorbFile, _ int) string {
+			return lo.Ternary(file.newPath != "", file.newPath, file.oldPath)
+		})
+		otherFilesPatch, err = self.c.Git().Diff.DiffIndexCmdObj(
+			append([]string{"--cached", "--binary", "HEAD", "--"}, otherPaths...)...).RunWithOutput()
+		if err != nil {
+			return err
+		}
+	}
+
+	indexTree, err := self.c.Git().WorkingTree.WriteIndexTree()
+	if err != nil {
+		return err
+	}
+	// If anything goes wrong, restore the index so that nothing ends up
+	// unstaged. This is also right after some of the fixup commits have been
+	// made, since they only contain changes that were staged.
+	defer func() {
+		if err != nil {
+			if restoreErr := self.c.Git().WorkingTree.RestoreIndex(indexTree); restoreErr != nil {
+				self.c.Log.Error(restoreErr)
+			}
+		}
+	}()
+
+	if err := self.c.Git().WorkingTree.UnstageAll(); err != nil {
+		return err
+	}
+
+	appliedHunkIndices := map[*absorbFile][]int{}
+	stageHunksWithTarget := func(targetHash string) error {
+		stringBuilder := &strings.Builder{}
+		for _, file := range attributedFiles {
+			hunkIndices := lo.FilterMap(file.hunks, func(hunk *absorbHunk, i int) (int, bool) {
+				return i, hunk.targetHash == targetHash
+			})
+			if len(hunkIndices) == 0 {
+				continue
+			}
+			stringBuilder.WriteString(file.patch.FormatHunksPlain(hunkIndices, appliedHunkIndices[file]))
+			appliedHunkIndices[file] = append(appliedHunkIndices[file], hunkIndices...)
+		}
+
+		if stringBuilder.Len() == 0 {
+			return nil
+		}
+		return self.c.Git().Patch.ApplyPatch(stringBuilder.String(), git_commands.ApplyPatchOpts{Cached: true, UnidiffZero: true})
+	}
+
+	for _, targetHash := range targetHashes {
+		if err := stageHunksWithTarget(targetHash); err != nil {
+			return err
+		}
+		if err := self.c.Git().Commit.CreateFixupCommit(targetHash); err != nil {
+			return err
+		}
+	}
+
+	if err := stageHunksWithTarget(""); err != nil {
+		return err
+	}
+	if otherFilesPatch != "" {
+		return self.c.Git().Patch.ApplyPatch(otherFilesPatch, git_commands.ApplyPatchOpts{Cached: true})
+	}
+	return nil
+}
+
+// Returns something like "file.txt:12 (new file)" for each hunk that couldn't
+// be attributed to a commit
+func (self *FixupHelper) unattributedHunkDescriptions(files []*absorbFile) []string {
+	return lo.FlatMap(files, func(file *absorbFile, _ int) []string {
+		path := lo.Ternary(file.newPath != "", file.newPath, file.oldPath)
+		if len(file.hunks) == 0 {
+			// e.g. binary files, or changes of the file mode
+			return []string{fmt.Sprintf("%s (%s)", path, self.c.Tr.AbsorbReasonNoTextualChanges)}
+		}
+
+		return lo.FilterMap(file.hunks, func(hunk *absorbHunk, i int) (string, bool) {
+			startLine, _ := file.patch.HunkOldLineRange(i)
+			return fmt.Sprintf("%s:%d (%s)", path, max(startLine, 1), hunk.reason), hunk.targetHash == ""
+		})
+	})
+}
+
+// Splits the output of git diff into the diffs of the individual files
+func splitDiffByFile(diff string) []string {
+	result := []string{}
+	for _, line := range strings.SplitAfter(diff, "\n") {
+		if strings.HasPrefix(line, "diff --git ") || len(result) == 0 {
+			result = append(result, "")
+		}
+		result[len(result)-1] += line
+	}
+	return lo.Filter(result, func(fileDiff string, _ int) bool { return strings.HasPrefix(fileDiff, "diff --git ") })
+}
+
+func findCommit(commits []*models.Commit, hash string) (*models.Commit, int, bool) {
 	return lo.FindIndexOf(commits, func(commit *models.Commit) bool {
 		return commit.Hash() == hash
 	})
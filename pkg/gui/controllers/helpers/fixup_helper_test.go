@@ -135,3 +135,56 @@ index 9ce8efb33..0632e41b0 100644
 		})
 	}
 }
+
+func TestFixupHelper_splitDiffByFile(t *testing.T) {
+	scenarios := []struct {
+		name     string
+		diff     string
+		expected []string
+	}{
+		{
+			name:     "no diff",
+			diff:     "",
+			expected: []string{},
+		},
+		{
+			name: "several files",
+			diff: `diff --git a/file1.txt b/file1.txt
+index 9ce8efb33..aaf2a4666 100644
+--- a/file1.txt
++++ b/file1.txt
+@@ -3 +2,0 @@ bbb
+-xxx
+diff --git a/file2.txt b/file2.txt
+new file mode 100644
+index 000000000..aaf2a4666
+--- /dev/null
++++ b/file2.txt
+@@ -0,0 +1 @@
++yyy
+`,
+			expected: []string{
+				`diff --git a/file1.txt b/file1.txt
+index 9ce8efb33..aaf2a4666 100644
+--- a/file1.txt
++++ b/file1.txt
+@@ -3 +2,0 @@ bbb
+-xxx
+`,
+				`diff --git a/file2.txt b/file2.txt
+new file mode 100644
+index 000000000..aaf2a4666
+--- /dev/null
++++ b/file2.txt
+@@ -0,0 +1 @@
++yyy
+`,
+			},
+		},
+	}
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			assert.Equal(t, s.expected, splitDiffByFile(s.diff))
+		})
+	}
+}
@@ -25,6 +25,38 @@ func NewGpgHelper(c *HelperCommon) *GpgHelper {
 // we don't need to see a loading status if we're in a subprocess.
 func (self *GpgHelper) WithGpgHandling(cmdObj *oscommands.CmdObj, configKey git_commands.GpgConfigKey, waitingStatus string, onSuccess func() error, refreshScope []types.RefreshableView) error {
 	useSubprocess := self.c.Git().Config.NeedsGpgSubprocess(configKey)
+	return self.withGpgHandling(cmdObj, useSubprocess, waitingStatus, onSuccess, refreshScope)
+}
+
+// Like WithGpgHandling, but for commands that explicitly ask for a signature
+// (e.g. `git tag --sign`), so we don't consult the git config to find out
+// whether signing is enabled.
+func (self *GpgHelper) WithSigning(cmdObj *oscommands.CmdObj, waitingStatus string, onSuccess func() error, refreshScope []types.RefreshableView) error {
+	useSubprocess := !self.c.UserConfig().Git.OverrideGpg
+	return self.withGpgHandling(cmdObj, useSubprocess, waitingStatus, onSuccess, refreshScope)
+}
+
+// Like WithGpgHandling, but rather than showing an error if the command fails,
+// it calls onDone with the result once the command has finished. This is for
+// callers that need to know the outcome, e.g. remote clients or plugins. Must
+// be called on the UI thread.
+func (self *GpgHelper) WithGpgHandlingAndResult(cmdObj *oscommands.CmdObj, configKey git_commands.GpgConfigKey, waitingStatus string, refreshScope []types.RefreshableView, onDone func(error)) {
+	if self.c.Git().Config.NeedsGpgSubprocess(configKey) {
+		_, err := self.c.RunSubprocess(cmdObj)
+		self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: refreshScope})
+		onDone(err)
+		return
+	}
+
+	_ = self.c.WithWaitingStatus(waitingStatus, func(gocui.Task) error {
+		err := cmdObj.StreamOutput().Run()
+		self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: refreshScope})
+		onDone(err)
+		return nil
+	})
+}
+
+func (self *GpgHelper) withGpgHandling(cmdObj *oscommands.CmdObj, useSubprocess bool, waitingStatus string, onSuccess func() error, refreshScope []types.RefreshableView) error {
 	if useSubprocess {
 		success, err := self.c.RunSubprocess(cmdObj)
 		if success && onSuccess != nil {
@@ -23,6 +23,8 @@ type Helpers struct {
 	Files          *FilesHelper
 	WorkingTree    *WorkingTreeHelper
 	BranchesHelper *BranchesHelper
+	BranchCleanup  *BranchCleanupHelper
+	BranchStack    *BranchStackHelper
 	Tags           *TagsHelper
 	MergeAndRebase *MergeAndRebaseHelper
 	MergeConflicts *MergeConflictsHelper
@@ -34,6 +36,8 @@ type Helpers struct {
 	Upstream       *UpstreamHelper
 	AmendHelper    *AmendHelper
 	FixupHelper    *FixupHelper
+	SplitCommit    *SplitCommitHelper
+	HunkEditor     *HunkEditorHelper
 	Commits        *CommitsHelper
 	SuspendResume  *SuspendResumeHelper
 	Snake          *SnakeHelper
@@ -53,6 +57,7 @@ type Helpers struct {
 	Search            *SearchHelper
 	Worktree          *WorktreeHelper
 	SubCommits        *SubCommitsHelper
+	Macro             *MacroHelper
 }
 
 func NewStubHelpers() *Helpers {
@@ -62,6 +67,8 @@ func NewStubHelpers() *Helpers {
 		Suggestions:       &SuggestionsHelper{},
 		Files:             &FilesHelper{},
 		WorkingTree:       &WorkingTreeHelper{},
+		BranchCleanup:     &BranchCleanupHelper{},
+		BranchStack:       &BranchStackHelper{},
 		Tags:              &TagsHelper{},
 		MergeAndRebase:    &MergeAndRebaseHelper{},
 		MergeConflicts:    &MergeConflictsHelper{},
@@ -73,6 +80,8 @@ func NewStubHelpers() *Helpers {
 		Upstream:          &UpstreamHelper{},
 		AmendHelper:       &AmendHelper{},
 		FixupHelper:       &FixupHelper{},
+		SplitCommit:       &SplitCommitHelper{},
+		HunkEditor:        &HunkEditorHelper{},
 		Commits:           &CommitsHelper{},
 		Snake:             &SnakeHelper{},
 		Diff:              &DiffHelper{},
@@ -90,5 +99,6 @@ func NewStubHelpers() *Helpers {
 		Search:            &SearchHelper{},
 		Worktree:          &WorktreeHelper{},
 		SubCommits:        &SubCommitsHelper{},
+		Macro:             &MacroHelper{},
 	}
 }
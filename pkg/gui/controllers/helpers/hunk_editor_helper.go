@@ -0,0 +1,173 @@
+package helpers
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/patch"
+	"github.com/jesseduffield/lazygit/pkg/gui/keybindings"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+// Helps with editing the added lines of a hunk in the hunk editor, which is
+// shown on top of the staging view. While typing, the edited hunk is checked
+// in the background to see whether it still applies to the index.
+type HunkEditorHelper struct {
+	c *HelperCommon
+
+	// pending check of the edited hunk; only accessed on the UI thread
+	checkTimer *time.Timer
+	// incremented whenever the content changes, so that the results of
+	// checks of outdated content are ignored
+	contentVersion int
+}
+
+// Checking the hunk runs git, so we wait for a pause in typing before we do
+// it rather than checking after every keystroke
+const hunkEditorCheckDelay = 200 * time.Millisecond
+
+func NewHunkEditorHelper(c *HelperCommon) *HunkEditorHelper {
+	return &HunkEditorHelper{
+		c: c,
+	}
+}
+
+// Opens the hunk editor for the only hunk of the given patch. cursorLineIdx is
+// the index of the line within the hunk body that the cursor is placed on.
+func (self *HunkEditorHelper) OpenHunkEditor(hunkPatch *patch.Patch, staged bool, cursorLineIdx int) error {
+	if hunkPatch.HunkHasNoNewlineMarker(0) {
+		return errors.New(self.c.Tr.CannotEditHunkWithNoNewlineMarker)
+	}
+
+	state := self.c.Contexts().HunkEditor.State
+	state.Patch = hunkPatch
+	state.Staged = staged
+
+	view := self.c.Views().HunkEditor
+	view.Title = lo.Ternary(staged, self.c.Tr.HunkEditorTitleStaged, self.c.Tr.HunkEditorTitleUnstaged)
+	view.Footer = utils.ResolvePlaceholderString(self.c.Tr.HunkEditorFooter,
+		map[string]string{
+			"confirmKeybinding": keybindings.Label(self.c.UserConfig().Keybinding.Universal.ConfirmInEditor),
+			"cancelKeybinding":  keybindings.Label(self.c.UserConfig().Keybinding.Universal.Return),
+		})
+	view.ClearTextArea()
+	view.TextArea.TypeString(strings.TrimSuffix(hunkPatch.FormatHunkBodyPlain(0), "\n"))
+	view.TextArea.SetCursor2D(0, max(cursorLineIdx, 0))
+	view.RenderTextArea()
+
+	self.c.Context().Push(self.c.Contexts().HunkEditor, types.OnFocusOpts{})
+	self.OnContentChanged()
+	return nil
+}
+
+// Validates the edited hunk and shows the result in the subtitle of the view.
+// Checking whether the patch applies is done in the background.
+func (self *HunkEditorHelper) OnContentChanged() {
+	state := self.c.Contexts().HunkEditor.State
+	view := self.c.Views().HunkEditor
+
+	self.stopCheck()
+	self.contentVersion++
+	contentVersion := self.contentVersion
+	patchText, err := self.editedPatch()
+	if err != nil {
+		view.Subtitle = style.FgRed.Sprint(err.Error())
+		return
+	}
+
+	view.Subtitle = self.c.Tr.HunkEditorChecking
+	self.checkTimer = time.AfterFunc(hunkEditorCheckDelay, func() {
+		state.AsyncHandler.Do(func() func() {
+			err := self.c.Git().Patch.ApplyPatch(patchText, git_commands.ApplyPatchOpts{
+				Cached:  true,
+				Recount: true,
+				Check:   true,
+			})
+			return func() {
+				self.c.OnUIThread(func() error {
+					if contentVersion == self.contentVersion {
+						view.Subtitle = self.statusText(patchText, err)
+					}
+					return nil
+				})
+			}
+		})
+	})
+}
+
+func (self *HunkEditorHelper) stopCheck() {
+	if self.checkTimer != nil {
+		self.checkTimer.Stop()
+		self.checkTimer = nil
+	}
+}
+
+func (self *HunkEditorHelper) statusText(patchText string, checkErr error) string {
+	if checkErr != nil {
+		firstLine, _, _ := strings.Cut(strings.TrimSpace(checkErr.Error()), "\n")
+		return style.FgRed.Sprint(firstLine)
+	}
+
+	lines := patch.Parse(patchText).Lines()
+	return style.FgGreen.Sprint(utils.ResolvePlaceholderString(self.c.Tr.HunkEditorPatchApplies,
+		map[string]string{
+			"additions": fmt.Sprint(lo.CountBy(lines, func(line *patch.PatchLine) bool { return line.Kind == patch.ADDITION })),
+			"deletions": fmt.Sprint(lo.CountBy(lines, func(line *patch.PatchLine) bool { return line.Kind == patch.DELETION })),
+		}))
+}
+
+// Applies the edited hunk to the index and closes the editor
+func (self *HunkEditorHelper) Confirm() error {
+	patchText, err := self.editedPatch()
+	if err != nil {
+		return err
+	}
+
+	self.c.LogAction(self.c.Tr.Actions.ApplyPatch)
+	if err := self.c.Git().Patch.ApplyPatch(patchText, git_commands.ApplyPatchOpts{
+		Cached:  true,
+		Recount: true,
+	}); err != nil {
+		return err
+	}
+
+	self.Close()
+	self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.FILES, types.STAGING}})
+	return nil
+}
+
+func (self *HunkEditorHelper) Close() {
+	self.stopCheck()
+	self.c.Contexts().HunkEditor.State.Patch = nil
+	self.c.Context().Pop()
+}
+
+// Returns the patch to apply to the index for the current content of the
+// editor
+func (self *HunkEditorHelper) editedPatch() (string, error) {
+	state := self.c.Contexts().HunkEditor.State
+	editedBody := self.c.Views().HunkEditor.TextArea.GetContent()
+
+	patchText, err := state.Patch.EditedHunkPatch(0, editedBody, state.Staged)
+	if err != nil {
+		var editHunkErr *patch.EditHunkError
+		if errors.As(err, &editHunkErr) {
+			message := lo.Ternary(editHunkErr.InvalidPrefix, self.c.Tr.HunkEditorInvalidPrefix, self.c.Tr.HunkEditorOnlyAddedLinesEditable)
+			return "", errors.New(utils.ResolvePlaceholderString(message,
+				map[string]string{"line": fmt.Sprint(editHunkErr.LineIdx + 1)}))
+		}
+		return "", err
+	}
+
+	if !patch.Parse(patchText).ContainsChanges() {
+		return "", errors.New(self.c.Tr.HunkEditorNoChanges)
+	}
+
+	return patchText, nil
+}
@@ -0,0 +1,248 @@
+package helpers
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/gui/keybindings"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+// Quick summary of how macros work:
+// while recording, every key that is dispatched to a keybinding or typed into
+// an editable view is appended to the macro. When replaying, we feed the keys
+// back into gocui's event loop one at a time, as if the user had pressed them.
+// After each key we also feed MacroStepDoneKey, whose keybinding tells us that
+// the key before it has been handled, and then we wait until all the async
+// work that the key kicked off (e.g. running a git command and refreshing) is
+// done before moving on to the next key.
+
+// A key that no terminal sends, so that we can use it to find out when the
+// event loop has handled all the keys we fed it before
+var MacroStepDoneKey = gocui.Key(tcell.KeyF50)
+
+var macroRegisters = []rune("abcdefghijklmnopqrstuvwxyz")
+
+type MacroHelper struct {
+	c *HelperCommon
+
+	hasRunningWorkers func() bool
+
+	// empty if we're not recording
+	recordingRegister string
+	recordedKeys      []string
+
+	replaying bool
+	stepDone  chan struct{}
+}
+
+func NewMacroHelper(c *HelperCommon, hasRunningWorkers func() bool) *MacroHelper {
+	return &MacroHelper{
+		c:                 c,
+		hasRunningWorkers: hasRunningWorkers,
+		stepDone:          make(chan struct{}, 1),
+	}
+}
+
+func (self *MacroHelper) IsRecording() bool {
+	return self.recordingRegister != ""
+}
+
+func (self *MacroHelper) IsReplaying() bool {
+	return self.replaying
+}
+
+func (self *MacroHelper) RecordingRegister() string {
+	return self.recordingRegister
+}
+
+func (self *MacroHelper) OpenRecordMenu() error {
+	macros := self.c.GetAppState().Macros
+	menuItems := lo.Map(macroRegisters, func(register rune, _ int) *types.MenuItem {
+		return &types.MenuItem{
+			LabelColumns: []string{string(register), style.FgCyan.Sprint(macroSummary(macros[string(register)]))},
+			Key:          register,
+			OnPress: func() error {
+				self.StartRecording(string(register))
+				return nil
+			},
+		}
+	})
+
+	return self.c.Menu(types.CreateMenuOptions{Title: self.c.Tr.RecordMacroIntoRegister, Items: menuItems})
+}
+
+func (self *MacroHelper) StartRecording(register string) {
+	self.recordingRegister = register
+	self.recordedKeys = []string{}
+}
+
+func (self *MacroHelper) StopRecording() {
+	macros := self.c.GetAppState().Macros
+	if macros == nil {
+		macros = map[string][]string{}
+	}
+	if len(self.recordedKeys) == 0 {
+		delete(macros, self.recordingRegister)
+	} else {
+		macros[self.recordingRegister] = self.recordedKeys
+	}
+	self.c.GetAppState().Macros = macros
+	self.c.SaveAppStateAndLogError()
+
+	self.recordingRegister = ""
+	self.recordedKeys = nil
+}
+
+// Called after a key was handled. If the key stopped the recording, we're no
+// longer recording at this point, so it doesn't end up in the macro.
+func (self *MacroHelper) RecordKey(key types.Key) {
+	if !self.IsRecording() {
+		return
+	}
+
+	self.recordedKeys = append(self.recordedKeys, keybindings.LabelFromKey(key))
+}
+
+func (self *MacroHelper) OpenReplayMenu() error {
+	macros := self.c.GetAppState().Macros
+	registers := lo.Filter(macroRegisters, func(register rune, _ int) bool {
+		return len(macros[string(register)]) > 0
+	})
+	if len(registers) == 0 {
+		self.c.ErrorToast(self.c.Tr.NoMacrosRecorded)
+		return nil
+	}
+
+	menuItems := lo.Map(registers, func(register rune, _ int) *types.MenuItem {
+		return &types.MenuItem{
+			LabelColumns: []string{string(register), style.FgCyan.Sprint(macroSummary(macros[string(register)]))},
+			Key:          register,
+			OnPress: func() error {
+				self.c.Prompt(types.PromptOpts{
+					Title:          self.c.Tr.ReplayMacroCount,
+					InitialContent: "1",
+					HandleConfirm: func(response string) error {
+						count, err := strconv.Atoi(strings.TrimSpace(response))
+						if err != nil || count < 1 {
+							return fmt.Errorf(self.c.Tr.InvalidReplayMacroCount, response)
+						}
+						return self.Replay(string(register), count)
+					},
+				})
+				return nil
+			},
+		}
+	})
+
+	return self.c.Menu(types.CreateMenuOptions{Title: self.c.Tr.ReplayMacro, Items: menuItems})
+}
+
+// Replays the macro of the given register count times. The keys are fed to
+// the event loop from a separate goroutine so that we can wait for each of
+// them to be handled.
+func (self *MacroHelper) Replay(register string, count int) error {
+	keys := self.c.GetAppState().Macros[register]
+	if len(keys) == 0 {
+		self.c.ErrorToast(self.c.Tr.NoMacrosRecorded)
+		return nil
+	}
+
+	self.c.LogAction(fmt.Sprintf(self.c.Tr.Actions.ReplayMacro, register))
+
+	// Keeping a task open for the whole replay means that the app doesn't count
+	// as idle until we're done, which is what integration tests wait for.
+	task := self.c.GocuiGui().NewTask()
+	self.replaying = true
+
+	go utils.Safe(func() {
+		defer task.Done()
+
+		for range count {
+			for _, key := range keys {
+				self.replayKey(key)
+			}
+		}
+
+		self.c.OnUIThread(func() error {
+			self.replaying = false
+			return nil
+		})
+	})
+
+	return nil
+}
+
+// Called by the keybinding of MacroStepDoneKey
+func (self *MacroHelper) StepDone() error {
+	select {
+	case self.stepDone <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+func (self *MacroHelper) replayKey(label string) {
+	self.sendKey(keybindings.GetKey(label))
+	self.sendKey(MacroStepDoneKey)
+	<-self.stepDone
+
+	self.waitForAsyncWork()
+}
+
+func (self *MacroHelper) sendKey(key types.Key) {
+	var r rune
+	var tcellKey tcell.Key
+	switch v := key.(type) {
+	case rune:
+		r = v
+		tcellKey = tcell.KeyRune
+	case gocui.Key:
+		tcellKey = tcell.Key(v)
+	}
+
+	event := tcell.NewEventKey(tcellKey, r, tcell.ModNone)
+
+	// When playing back events in integration tests, gocui doesn't read from
+	// the screen, so we need to send our keys the same way the tests do.
+	if replayedKeys := self.c.GocuiGui().ReplayedEvents.Keys; replayedKeys != nil {
+		replayedKeys <- gocui.NewTcellKeyEventWrapper(event, 0)
+		return
+	}
+
+	for gocui.Screen.PostEvent(event) != nil {
+		// the event queue is full
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func (self *MacroHelper) waitForAsyncWork() {
+	for {
+		for self.hasRunningWorkers() {
+			time.Sleep(10 * time.Millisecond)
+		}
+
+		// Let the workers' UI updates run; they might start more work
+		done := make(chan struct{})
+		self.c.OnUIThread(func() error {
+			close(done)
+			return nil
+		})
+		<-done
+
+		if !self.hasRunningWorkers() {
+			return
+		}
+	}
+}
+
+func macroSummary(keys []string) string {
+	return utils.TruncateWithEllipsis(strings.Join(keys, ""), 50)
+}
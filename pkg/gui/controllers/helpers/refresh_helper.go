@@ -1,6 +1,7 @@
 package helpers
 
 import (
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -14,6 +15,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/gui/mergeconflicts"
 	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/remote"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/samber/lo"
 )
@@ -27,6 +29,14 @@ type RefreshHelper struct {
 	mergeConflictsHelper *MergeConflictsHelper
 	worktreeHelper       *WorktreeHelper
 	searchHelper         *SearchHelper
+
+	// the checked out ref and commit as of the last refresh, so that we can
+	// tell remote-control clients when it changes
+	lastHead string
+
+	// how long getting the status took in the most recent files refresh
+	statusDuration      time.Duration
+	statusDurationMutex sync.Mutex
 }
 
 func NewRefreshHelper(
@@ -171,6 +181,10 @@ func (self *RefreshHelper) Refresh(options types.RefreshOptions) {
 			refresh("worktrees", func() { self.refreshWorktrees() })
 		}
 
+		if scopeSet.Includes(types.WORKSPACE) {
+			refresh("workspace", func() { self.refreshWorkspace() })
+		}
+
 		if scopeSet.Includes(types.STAGING) {
 			refresh("staging", func() {
 				fileWg.Wait()
@@ -190,6 +204,13 @@ func (self *RefreshHelper) Refresh(options types.RefreshOptions) {
 
 		wg.Wait()
 
+		// in async mode, the refresh isn't complete yet
+		if options.Mode != types.ASYNC {
+			scopeNames := lo.Compact(getScopeNames(scopeSet.ToSlice()))
+			slices.Sort(scopeNames)
+			self.c.PublishRemoteEvent(remote.RefreshCompletedEvent, scopeNames)
+		}
+
 		if options.Then != nil {
 			options.Then()
 		}
@@ -218,6 +239,7 @@ func getScopeNames(scopes []types.RefreshableView) []string {
 		types.TAGS:            "tags",
 		types.REMOTES:         "remotes",
 		types.WORKTREES:       "worktrees",
+		types.WORKSPACE:       "workspace",
 		types.STATUS:          "status",
 		types.BISECT_INFO:     "bisect",
 		types.STAGING:         "staging",
@@ -330,6 +352,7 @@ func (self *RefreshHelper) refreshCommitsWithLimit() error {
 			All:                  self.c.Contexts().LocalCommits.GetShowWholeGitGraph(),
 			MainBranches:         self.c.Model().MainBranches,
 			HashPool:             self.c.Model().HashPool,
+			Incremental:          true,
 		},
 	)
 	if err != nil {
@@ -564,10 +587,15 @@ func (self *RefreshHelper) refreshStateFiles() error {
 		}
 	}
 
+	statusStart := time.Now()
 	files := self.c.Git().Loaders.FileLoader.
 		GetStatusFiles(git_commands.GetStatusFileOptions{
 			ForceShowUntracked: self.c.Contexts().Files.ForceShowUntracked(),
+			UntrackedFilesMode: self.c.Contexts().Files.UntrackedFilesMode(),
 		})
+	self.statusDurationMutex.Lock()
+	self.statusDuration = time.Since(statusStart)
+	self.statusDurationMutex.Unlock()
 
 	conflictFileCount := 0
 	for _, file := range files {
@@ -600,6 +628,20 @@ func (self *RefreshHelper) refreshStateFiles() error {
 	return nil
 }
 
+// Returns how long getting the status took in the most recent files refresh,
+// if that was at least as long as the git.status.slowStatusThreshold config
+func (self *RefreshHelper) SlowStatusDuration() (time.Duration, bool) {
+	threshold := self.c.UserConfig().Git.Status.SlowStatusThreshold
+	if threshold <= 0 {
+		return 0, false
+	}
+
+	self.statusDurationMutex.Lock()
+	defer self.statusDurationMutex.Unlock()
+
+	return self.statusDuration, self.statusDuration >= time.Duration(threshold)*time.Millisecond
+}
+
 // the reflogs panel is the only panel where we cache data, in that we only
 // load entries that have been created since we last ran the call. This means
 // we need to be more careful with how we use this, and to ensure we're emptying
@@ -693,6 +735,12 @@ func (self *RefreshHelper) refreshWorktrees() {
 	self.refreshView(self.c.Contexts().Worktrees)
 }
 
+func (self *RefreshHelper) refreshWorkspace() {
+	self.c.Model().WorkspaceRepos = self.c.Git().Loaders.Workspace.GetWorkspaceRepos()
+
+	self.refreshView(self.c.Contexts().Workspace)
+}
+
 func (self *RefreshHelper) refreshStashEntries() {
 	self.c.Model().StashEntries = self.c.Git().Loaders.StashLoader.
 		GetStashEntries(self.c.Modes().Filtering.GetPath())
@@ -714,6 +762,18 @@ func (self *RefreshHelper) refreshStatus() {
 	workingTreeState := self.c.Git().Status.WorkingTreeState()
 	linkedWorktreeName := self.worktreeHelper.GetLinkedWorktreeName()
 
+	if head := currentBranch.FullRefName() + " " + currentBranch.CommitHash; head != self.lastHead {
+		self.lastHead = head
+		branchName := ""
+		if !currentBranch.DetachedHead {
+			branchName = currentBranch.Name
+		}
+		self.c.PublishRemoteEvent(remote.HeadChangedEvent, map[string]string{
+			"branch": branchName,
+			"hash":   currentBranch.CommitHash,
+		})
+	}
+
 	repoName := self.c.Git().RepoPaths.RepoName()
 
 	status := presentation.FormatStatus(repoName, currentBranch, types.ItemOperationNone, linkedWorktreeName, workingTreeState, self.c.Tr, self.c.UserConfig())
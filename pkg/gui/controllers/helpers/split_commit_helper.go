@@ -0,0 +1,278 @@
+package helpers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-errors/errors"
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/patch"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+// Helps with splitting a commit into several commits: the hunks of the commit
+// are assigned to numbered parts, and each part becomes a commit of its own,
+// in the order of the part numbers.
+type SplitCommitHelper struct {
+	c              *HelperCommon
+	mergeAndRebase *MergeAndRebaseHelper
+	commitsHelper  *CommitsHelper
+}
+
+func NewSplitCommitHelper(
+	c *HelperCommon,
+	mergeAndRebaseHelper *MergeAndRebaseHelper,
+	commitsHelper *CommitsHelper,
+) *SplitCommitHelper {
+	return &SplitCommitHelper{
+		c:              c,
+		mergeAndRebase: mergeAndRebaseHelper,
+		commitsHelper:  commitsHelper,
+	}
+}
+
+type splitCommitFile struct {
+	path  string
+	patch *patch.Patch
+}
+
+type splitCommitHunk struct {
+	file *splitCommitFile
+	// -1 for files whose diff has no hunks, e.g. an empty file that was added;
+	// these are always included as a whole
+	hunkIdx int
+	// 1-based number of the part that the hunk goes into
+	part int
+}
+
+func (self *SplitCommitHelper) OpenSplitCommitMenu(commitIdx int) error {
+	commit := self.c.Model().Commits[commitIdx]
+
+	return self.c.WithWaitingStatus(self.c.Tr.LoadingHunksStatus, func(gocui.Task) error {
+		hunks, err := self.loadHunks(commit)
+		if err != nil {
+			return err
+		}
+
+		self.c.OnUIThread(func() error {
+			if len(hunks) < 2 {
+				return errors.New(self.c.Tr.CannotSplitCommitWithSingleHunk)
+			}
+
+			return self.showMenu(commitIdx, hunks, 2, 0)
+		})
+		return nil
+	})
+}
+
+func (self *SplitCommitHelper) loadHunks(commit *models.Commit) ([]*splitCommitHunk, error) {
+	from, to := commit.ParentRefName(), commit.Hash()
+	files, err := self.c.Git().Loaders.CommitFileLoader.GetFilesInDiff(from, to, false)
+	if err != nil {
+		return nil, err
+	}
+
+	hunks := []*splitCommitHunk{}
+	for _, file := range files {
+		diff, err := self.c.Git().WorkingTree.ShowFileDiff(from, to, false, file.Path, true)
+		if err != nil {
+			return nil, err
+		}
+
+		splitFile := &splitCommitFile{path: file.Path, patch: patch.Parse(diff)}
+		hunkCount := splitFile.patch.HunkCount()
+		if hunkCount == 0 {
+			if strings.Contains(diff, "\nBinary files ") {
+				return nil, errors.New(self.c.Tr.CannotSplitCommitWithBinaryFiles)
+			}
+			hunks = append(hunks, &splitCommitHunk{file: splitFile, hunkIdx: -1, part: 1})
+			continue
+		}
+
+		for i := range hunkCount {
+			hunks = append(hunks, &splitCommitHunk{file: splitFile, hunkIdx: i, part: 1})
+		}
+	}
+
+	return hunks, nil
+}
+
+func (self *SplitCommitHelper) showMenu(commitIdx int, hunks []*splitCommitHunk, partCount int, selectedIdx int) error {
+	hunksSection := &types.MenuSection{Title: self.c.Tr.SplitCommitHunksSection}
+	actionsSection := &types.MenuSection{Title: self.c.Tr.SplitCommitActionsSection}
+
+	menuItems := lo.Map(hunks, func(hunk *splitCommitHunk, i int) *types.MenuItem {
+		return &types.MenuItem{
+			LabelColumns: []string{
+				style.FgCyan.Sprintf("%d", hunk.part),
+				hunk.file.path,
+				style.FgBlackLighter.Sprint(hunk.header()),
+			},
+			Section: hunksSection,
+			Tooltip: hunk.content(),
+			OnPress: func() error {
+				hunk.part = hunk.part%partCount + 1
+				return self.showMenu(commitIdx, hunks, partCount, i)
+			},
+		}
+	})
+
+	var removePartDisabledReason *types.DisabledReason
+	if partCount <= 2 {
+		removePartDisabledReason = &types.DisabledReason{Text: self.c.Tr.SplitCommitNeedsTwoParts}
+	}
+
+	var splitDisabledReason *types.DisabledReason
+	for part := 1; part <= partCount; part++ {
+		if !lo.SomeBy(hunks, func(hunk *splitCommitHunk) bool { return hunk.part == part }) {
+			splitDisabledReason = &types.DisabledReason{Text: utils.ResolvePlaceholderString(
+				self.c.Tr.SplitCommitPartIsEmpty, map[string]string{"part": fmt.Sprint(part)})}
+			break
+		}
+	}
+
+	menuItems = append(menuItems,
+		&types.MenuItem{
+			Label:   self.c.Tr.SplitCommitAddPart,
+			Key:     'a',
+			Section: actionsSection,
+			OnPress: func() error {
+				return self.showMenu(commitIdx, hunks, partCount+1, len(hunks))
+			},
+		},
+		&types.MenuItem{
+			Label:          self.c.Tr.SplitCommitRemoveLastPart,
+			Key:            'r',
+			Section:        actionsSection,
+			DisabledReason: removePartDisabledReason,
+			OnPress: func() error {
+				for _, hunk := range hunks {
+					hunk.part = min(hunk.part, partCount-1)
+				}
+				return self.showMenu(commitIdx, hunks, partCount-1, len(hunks)+1)
+			},
+		},
+		&types.MenuItem{
+			Label: utils.ResolvePlaceholderString(
+				self.c.Tr.SplitCommitIntoParts, map[string]string{"count": fmt.Sprint(partCount)}),
+			Key:            's',
+			Section:        actionsSection,
+			DisabledReason: splitDisabledReason,
+			OnPress: func() error {
+				return self.promptForMessages(commitIdx, self.partPatches(hunks, partCount), nil)
+			},
+		},
+	)
+
+	if err := self.c.Menu(types.CreateMenuOptions{
+		Title:  self.c.Tr.SplitCommit,
+		Prompt: self.c.Tr.SplitCommitMenuPrompt,
+		Items:  menuItems,
+	}); err != nil {
+		return err
+	}
+
+	self.c.Contexts().Menu.SetSelection(selectedIdx)
+	self.c.Contexts().Menu.HandleFocus(types.OnFocusOpts{})
+	return nil
+}
+
+// Returns one patch per part, each containing the hunks assigned to that part
+func (self *SplitCommitHelper) partPatches(hunks []*splitCommitHunk, partCount int) []string {
+	hunksByFile := lo.GroupBy(hunks, func(hunk *splitCommitHunk) *splitCommitFile { return hunk.file })
+	files := lo.Uniq(lo.Map(hunks, func(hunk *splitCommitHunk, _ int) *splitCommitFile { return hunk.file }))
+
+	result := make([]string, partCount)
+	for partIdx := range partCount {
+		stringBuilder := &strings.Builder{}
+		for _, file := range files {
+			includedLineIndices := []int{}
+			for _, hunk := range hunksByFile[file] {
+				if hunk.part != partIdx+1 {
+					continue
+				}
+				if hunk.hunkIdx == -1 {
+					stringBuilder.WriteString(file.patch.FormatPlain())
+					continue
+				}
+				includedLineIndices = append(includedLineIndices,
+					patch.ExpandRange(file.patch.HunkStartIdx(hunk.hunkIdx), file.patch.HunkEndIdx(hunk.hunkIdx))...)
+			}
+
+			if len(includedLineIndices) > 0 {
+				stringBuilder.WriteString(file.patch.Transform(patch.TransformOpts{
+					IncludedLineIndices: includedLineIndices,
+				}).FormatPlain())
+			}
+		}
+		result[partIdx] = stringBuilder.String()
+	}
+
+	return result
+}
+
+// Asks for the commit message of each part in turn, and splits the commit once
+// all of them have been entered
+func (self *SplitCommitHelper) promptForMessages(commitIdx int, patches []string, parts []git_commands.CommitSplitPart) error {
+	if len(parts) == len(patches) {
+		return self.splitCommit(commitIdx, parts)
+	}
+
+	initialMessage := ""
+	if len(parts) == 0 {
+		var err error
+		initialMessage, err = self.c.Git().Commit.GetCommitMessage(self.c.Model().Commits[commitIdx].Hash())
+		if err != nil {
+			return err
+		}
+	}
+
+	self.commitsHelper.OpenCommitMessagePanel(
+		&OpenCommitMessagePanelOpts{
+			CommitIndex:    commitIdx,
+			InitialMessage: initialMessage,
+			SummaryTitle: utils.ResolvePlaceholderString(self.c.Tr.SplitCommitSummaryTitle, map[string]string{
+				"part":  fmt.Sprint(len(parts) + 1),
+				"count": fmt.Sprint(len(patches)),
+			}),
+			DescriptionTitle: self.c.Tr.CommitDescriptionTitle,
+			PreserveMessage:  false,
+			OnConfirm: func(summary string, description string) error {
+				return self.promptForMessages(commitIdx, patches, append(parts, git_commands.CommitSplitPart{
+					Patch:       patches[len(parts)],
+					Summary:     summary,
+					Description: description,
+				}))
+			},
+		},
+	)
+
+	return nil
+}
+
+func (self *SplitCommitHelper) splitCommit(commitIdx int, parts []git_commands.CommitSplitPart) error {
+	return self.c.WithWaitingStatus(self.c.Tr.RebasingStatus, func(gocui.Task) error {
+		self.c.LogAction(self.c.Tr.Actions.SplitCommit)
+		err := self.c.Git().Patch.SplitCommit(self.c.Model().Commits, commitIdx, parts)
+		return self.mergeAndRebase.CheckMergeOrRebase(err)
+	})
+}
+
+func (self *splitCommitHunk) header() string {
+	if self.hunkIdx == -1 {
+		return ""
+	}
+	return self.file.patch.Lines()[self.file.patch.HunkStartIdx(self.hunkIdx)].Content
+}
+
+func (self *splitCommitHunk) content() string {
+	if self.hunkIdx == -1 {
+		return self.file.patch.FormatPlain()
+	}
+	return self.file.patch.FormatRangePlain(self.file.patch.HunkStartIdx(self.hunkIdx), self.file.patch.HunkEndIdx(self.hunkIdx))
+}
@@ -1,6 +1,9 @@
 package helpers
 
 import (
+	"errors"
+	"strings"
+
 	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
@@ -23,6 +26,36 @@ func NewTagsHelper(c *HelperCommon, commitsHelper *CommitsHelper, gpg *GpgHelper
 }
 
 func (self *TagsHelper) OpenCreateTagPrompt(ref string, onCreate func()) error {
+	return self.openCreateTagPrompt(ref, "", nil, onCreate)
+}
+
+// Like OpenCreateTagPrompt, but the tag is signed with the given key, or with
+// the default signing key if signingKey is empty.
+func (self *TagsHelper) OpenCreateSignedTagPrompt(ref string, signingKey string, onCreate func()) error {
+	return self.openCreateTagPrompt(ref, "", &signingKey, onCreate)
+}
+
+// Opens the create tag prompt for HEAD, pre-filled with the next semantic
+// version derived from the latest tag and the commits since then.
+func (self *TagsHelper) OpenCreateNextVersionTagPrompt(onCreate func()) error {
+	latestTag := self.c.Git().Tag.LatestTag()
+	commitMessages, err := self.c.Git().Tag.CommitMessagesSince(latestTag)
+	if err != nil {
+		return err
+	}
+
+	nextTag, ok := git_commands.NextSemverTag(latestTag, commitMessages)
+	if !ok {
+		return errors.New(utils.ResolvePlaceholderString(
+			self.c.Tr.LatestTagIsNotSemver,
+			map[string]string{"tagName": latestTag},
+		))
+	}
+
+	return self.openCreateTagPrompt("", nextTag, nil, onCreate)
+}
+
+func (self *TagsHelper) openCreateTagPrompt(ref string, initialName string, signingKey *string, onCreate func()) error {
 	onConfirm := func(tagName string, description string) error {
 		prompt := utils.ResolvePlaceholderString(
 			self.c.Tr.ForceTagPrompt,
@@ -38,7 +71,10 @@ func (self *TagsHelper) OpenCreateTagPrompt(ref string, onCreate func()) error {
 			Prompt: prompt,
 			HandleConfirm: func() error {
 				var command *oscommands.CmdObj
-				if description != "" || self.c.Git().Config.GetGpgTagSign() {
+				if signingKey != nil {
+					self.c.LogAction(self.c.Tr.Actions.CreateSignedTag)
+					command = self.c.Git().Tag.CreateSignedObj(tagName, ref, description, *signingKey, force)
+				} else if description != "" || self.c.Git().Config.GetGpgTagSign() {
 					self.c.LogAction(self.c.Tr.Actions.CreateAnnotatedTag)
 					command = self.c.Git().Tag.CreateAnnotatedObj(tagName, ref, description, force)
 				} else {
@@ -46,9 +82,20 @@ func (self *TagsHelper) OpenCreateTagPrompt(ref string, onCreate func()) error {
 					command = self.c.Git().Tag.CreateLightweightObj(tagName, ref, force)
 				}
 
-				return self.gpg.WithGpgHandling(command, git_commands.TagGpgSign, self.c.Tr.CreatingTag, func() error {
+				// when the tag isn't created in a subprocess, this runs on a
+				// worker, but onCreate changes the selection of the tags view
+				onSuccess := func() error {
+					self.c.OnUIThread(func() error {
+						onCreate()
+						return nil
+					})
 					return nil
-				}, []types.RefreshableView{types.COMMITS, types.TAGS})
+				}
+				refreshScope := []types.RefreshableView{types.COMMITS, types.TAGS}
+				if signingKey != nil {
+					return self.gpg.WithSigning(command, self.c.Tr.CreatingTag, onSuccess, refreshScope)
+				}
+				return self.gpg.WithGpgHandling(command, git_commands.TagGpgSign, self.c.Tr.CreatingTag, onSuccess, refreshScope)
 			},
 		})
 	}
@@ -56,7 +103,7 @@ func (self *TagsHelper) OpenCreateTagPrompt(ref string, onCreate func()) error {
 	self.commitsHelper.OpenCommitMessagePanel(
 		&OpenCommitMessagePanelOpts{
 			CommitIndex:      context.NoCommitIndex,
-			InitialMessage:   "",
+			InitialMessage:   initialName,
 			SummaryTitle:     self.c.Tr.TagNameTitle,
 			DescriptionTitle: self.c.Tr.TagMessageTitle,
 			PreserveMessage:  false,
@@ -66,3 +113,31 @@ func (self *TagsHelper) OpenCreateTagPrompt(ref string, onCreate func()) error {
 
 	return nil
 }
+
+// Opens the tag message panel pre-filled with the current message of the tag,
+// and replaces the message on confirm.
+func (self *TagsHelper) OpenEditTagMessagePrompt(tagName string) error {
+	message, err := self.c.Git().Tag.GetMessage(tagName)
+	if err != nil {
+		return err
+	}
+
+	self.commitsHelper.OpenCommitMessagePanel(
+		&OpenCommitMessagePanelOpts{
+			CommitIndex:      context.NoCommitIndex,
+			InitialMessage:   message,
+			SummaryTitle:     self.c.Tr.TagMessageTitle,
+			DescriptionTitle: self.c.Tr.CommitDescriptionTitle,
+			PreserveMessage:  false,
+			OnConfirm: func(summary string, description string) error {
+				self.c.LogAction(self.c.Tr.Actions.EditTagMessage)
+				message := strings.TrimSpace(summary + "\n\n" + description)
+				command := self.c.Git().Tag.EditMessageObj(tagName, message)
+				return self.gpg.WithGpgHandling(command, git_commands.TagGpgSign, self.c.Tr.UpdatingTagMessage, nil,
+					[]types.RefreshableView{types.TAGS})
+			},
+		},
+	)
+
+	return nil
+}
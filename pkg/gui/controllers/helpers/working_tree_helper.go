@@ -122,6 +122,17 @@ func (self *WorkingTreeHelper) handleCommit(summary string, description string,
 		}, nil)
 }
 
+// Commits the staged changes with the given message, signing the commit if
+// configured just like when committing from the commit message panel, and
+// calls onDone with the result once the commit has finished. Must be called on
+// the UI thread.
+func (self *WorkingTreeHelper) CommitWithMessage(message string, onDone func(error)) {
+	summary, description := self.commitsHelper.SplitCommitMessageAndDescription(message)
+	cmdObj := self.c.Git().Commit.CommitCmdObj(summary, description, false)
+	self.c.LogAction(self.c.Tr.Actions.Commit)
+	self.gpgHelper.WithGpgHandlingAndResult(cmdObj, git_commands.CommitGpgSign, self.c.Tr.CommittingStatus, nil, onDone)
+}
+
 func (self *WorkingTreeHelper) switchFromCommitMessagePanelToEditor(filepath string, forceSkipHooks bool) error {
 	// We won't be able to tell whether the commit was successful, because
 	// RunSubprocessAndRefresh doesn't return the error (it opens an error alert
@@ -0,0 +1,55 @@
+package controllers
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+)
+
+type HunkEditorController struct {
+	baseController
+	c *ControllerCommon
+}
+
+var _ types.IController = &HunkEditorController{}
+
+func NewHunkEditorController(
+	c *ControllerCommon,
+) *HunkEditorController {
+	return &HunkEditorController{
+		baseController: baseController{},
+		c:              c,
+	}
+}
+
+func (self *HunkEditorController) GetKeybindings(opts types.KeybindingsOpts) []*types.Binding {
+	bindings := []*types.Binding{
+		{
+			Key:         opts.GetKey(opts.Config.Universal.Return),
+			Handler:     self.close,
+			Description: self.c.Tr.Cancel,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Universal.ConfirmInEditor),
+			Handler:     self.confirm,
+			Description: self.c.Tr.ApplyEditedHunk,
+		},
+		{
+			Key:     opts.GetKey(opts.Config.Universal.ConfirmInEditorAlt),
+			Handler: self.confirm,
+		},
+	}
+
+	return bindings
+}
+
+func (self *HunkEditorController) Context() types.Context {
+	return self.c.Contexts().HunkEditor
+}
+
+func (self *HunkEditorController) close() error {
+	self.c.Helpers().HunkEditor.Close()
+	return nil
+}
+
+func (self *HunkEditorController) confirm() error {
+	return self.c.Helpers().HunkEditor.Confirm()
+}
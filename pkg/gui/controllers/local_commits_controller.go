@@ -63,6 +63,7 @@ func (self *LocalCommitsController) GetKeybindings(opts types.KeybindingsOpts) [
 				self.itemRangeSelected(
 					self.midRebaseCommandEnabled,
 					self.canSquashOrFixup,
+					self.notNextToCommitsOfOtherBranches,
 				),
 			),
 			Description:     self.c.Tr.Squash,
@@ -76,6 +77,7 @@ func (self *LocalCommitsController) GetKeybindings(opts types.KeybindingsOpts) [
 				self.itemRangeSelected(
 					self.midRebaseCommandEnabled,
 					self.canSquashOrFixup,
+					self.notNextToCommitsOfOtherBranches,
 				),
 			),
 			Description:     self.c.Tr.Fixup,
@@ -86,7 +88,7 @@ func (self *LocalCommitsController) GetKeybindings(opts types.KeybindingsOpts) [
 			Key:     opts.GetKey(opts.Config.Commits.RenameCommit),
 			Handler: self.withItem(self.reword),
 			GetDisabledReason: self.require(
-				self.singleItemSelected(self.rewordEnabled),
+				self.singleItemSelected(self.rewordEnabled, self.notCommitOfOtherBranch),
 			),
 			Description:     self.c.Tr.Reword,
 			Tooltip:         self.c.Tr.CommitRewordTooltip,
@@ -97,7 +99,7 @@ func (self *LocalCommitsController) GetKeybindings(opts types.KeybindingsOpts) [
 			Key:     opts.GetKey(opts.Config.Commits.RenameCommitWithEditor),
 			Handler: self.withItem(self.rewordEditor),
 			GetDisabledReason: self.require(
-				self.singleItemSelected(self.rewordEnabled),
+				self.singleItemSelected(self.rewordEnabled, self.notCommitOfOtherBranch),
 			),
 			Description: self.c.Tr.RewordCommitEditor,
 		},
@@ -107,6 +109,7 @@ func (self *LocalCommitsController) GetKeybindings(opts types.KeybindingsOpts) [
 			GetDisabledReason: self.require(
 				self.itemRangeSelected(
 					self.canDropCommits,
+					self.notNextToCommitsOfOtherBranches,
 				),
 			),
 			Description:     self.c.Tr.DropCommit,
@@ -117,13 +120,21 @@ func (self *LocalCommitsController) GetKeybindings(opts types.KeybindingsOpts) [
 			Key:     opts.GetKey(editCommitKey),
 			Handler: opts.Guards.OutsideFilterMode(self.withItemsRange(self.edit)),
 			GetDisabledReason: self.require(
-				self.itemRangeSelected(self.midRebaseCommandEnabled),
+				self.itemRangeSelected(self.midRebaseCommandEnabled, self.notNextToCommitsOfOtherBranches),
 			),
 			Description:      self.c.Tr.EditCommit,
 			ShortDescription: self.c.Tr.Edit,
 			Tooltip:          self.c.Tr.EditCommitTooltip,
 			DisplayOnScreen:  true,
 		},
+		{
+			Key:               opts.GetKey(opts.Config.Commits.SplitCommit),
+			Handler:           opts.Guards.OutsideFilterMode(self.withItem(self.splitCommit)),
+			GetDisabledReason: self.require(self.singleItemSelected(self.canSplitCommit, self.notCommitOfOtherBranch)),
+			Description:       self.c.Tr.SplitCommit,
+			Tooltip:           self.c.Tr.SplitCommitTooltip,
+			OpensMenu:         true,
+		},
 		{
 			// The user-facing description here is 'Start interactive rebase' but internally
 			// we're calling it 'quick-start interactive rebase' to differentiate it from
@@ -148,7 +159,7 @@ func (self *LocalCommitsController) GetKeybindings(opts types.KeybindingsOpts) [
 		{
 			Key:               opts.GetKey(opts.Config.Commits.CreateFixupCommit),
 			Handler:           opts.Guards.OutsideFilterMode(self.withItem(self.createFixupCommit)),
-			GetDisabledReason: self.require(self.singleItemSelected()),
+			GetDisabledReason: self.require(self.singleItemSelected(self.notCommitOfOtherBranch)),
 			Description:       self.c.Tr.CreateFixupCommit,
 			Tooltip: utils.ResolvePlaceholderString(
 				self.c.Tr.CreateFixupCommitTooltip,
@@ -173,6 +184,7 @@ func (self *LocalCommitsController) GetKeybindings(opts types.KeybindingsOpts) [
 			GetDisabledReason: self.require(self.itemRangeSelected(
 				self.midRebaseMoveCommandEnabled,
 				self.canMoveDown,
+				self.notNextToCommitsOfOtherBranches,
 			)),
 			Description: self.c.Tr.MoveDownCommit,
 		},
@@ -182,6 +194,7 @@ func (self *LocalCommitsController) GetKeybindings(opts types.KeybindingsOpts) [
 			GetDisabledReason: self.require(self.itemRangeSelected(
 				self.midRebaseMoveCommandEnabled,
 				self.canMoveUp,
+				self.notNextToCommitsOfOtherBranches,
 			)),
 			Description: self.c.Tr.MoveUpCommit,
 		},
@@ -210,7 +223,7 @@ func (self *LocalCommitsController) GetKeybindings(opts types.KeybindingsOpts) [
 		{
 			Key:               opts.GetKey(opts.Config.Commits.AmendToCommit),
 			Handler:           self.withItem(self.amendTo),
-			GetDisabledReason: self.require(self.singleItemSelected(self.canAmend)),
+			GetDisabledReason: self.require(self.singleItemSelected(self.canAmend, self.notCommitOfOtherBranch)),
 			Description:       self.c.Tr.Amend,
 			Tooltip:           self.c.Tr.AmendCommitTooltip,
 			DisplayOnScreen:   true,
@@ -218,7 +231,7 @@ func (self *LocalCommitsController) GetKeybindings(opts types.KeybindingsOpts) [
 		{
 			Key:               opts.GetKey(opts.Config.Commits.ResetCommitAuthor),
 			Handler:           self.withItemsRange(self.amendAttribute),
-			GetDisabledReason: self.require(self.itemRangeSelected(self.canAmendRange)),
+			GetDisabledReason: self.require(self.itemRangeSelected(self.canAmendRange, self.notNextToCommitsOfOtherBranches)),
 			Description:       self.c.Tr.AmendCommitAttribute,
 			Tooltip:           self.c.Tr.AmendCommitAttributeTooltip,
 			OpensMenu:         true,
@@ -237,6 +250,12 @@ func (self *LocalCommitsController) GetKeybindings(opts types.KeybindingsOpts) [
 			Description:       self.c.Tr.TagCommit,
 			Tooltip:           self.c.Tr.TagCommitTooltip,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Commits.ToggleWholeGitGraph),
+			Handler:     self.toggleWholeGitGraph,
+			Description: self.c.Tr.ToggleWholeGitGraph,
+			Tooltip:     self.c.Tr.ToggleWholeGitGraphTooltip,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Commits.OpenLogMenu),
 			Handler:     self.handleOpenLogMenu,
@@ -518,6 +537,10 @@ func (self *LocalCommitsController) edit(selectedCommits []*models.Commit, start
 	return self.startInteractiveRebaseWithEdit(selectedCommits)
 }
 
+func (self *LocalCommitsController) splitCommit(commit *models.Commit) error {
+	return self.c.Helpers().SplitCommit.OpenSplitCommitMenu(self.context().GetSelectedLineIdx())
+}
+
 func (self *LocalCommitsController) quickStartInteractiveRebase() error {
 	commitToEdit, err := self.findCommitForQuickStartInteractiveRebase()
 	if err != nil {
@@ -1150,26 +1173,28 @@ func (self *LocalCommitsController) openSearch() error {
 	return self.c.Helpers().Search.OpenSearchPrompt(self.context())
 }
 
+func (self *LocalCommitsController) toggleWholeGitGraph() error {
+	self.context().SetShowWholeGitGraph(!self.context().GetShowWholeGitGraph())
+
+	if self.context().GetShowWholeGitGraph() {
+		self.context().SetLimitCommits(false)
+	}
+
+	return self.c.WithWaitingStatus(self.c.Tr.LoadingCommits, func(gocui.Task) error {
+		self.c.Refresh(
+			types.RefreshOptions{Mode: types.SYNC, Scope: []types.RefreshableView{types.COMMITS}},
+		)
+		return nil
+	})
+}
+
 func (self *LocalCommitsController) handleOpenLogMenu() error {
 	return self.c.Menu(types.CreateMenuOptions{
 		Title: self.c.Tr.LogMenuTitle,
 		Items: []*types.MenuItem{
 			{
-				Label: self.c.Tr.ToggleShowGitGraphAll,
-				OnPress: func() error {
-					self.context().SetShowWholeGitGraph(!self.context().GetShowWholeGitGraph())
-
-					if self.context().GetShowWholeGitGraph() {
-						self.context().SetLimitCommits(false)
-					}
-
-					return self.c.WithWaitingStatus(self.c.Tr.LoadingCommits, func(gocui.Task) error {
-						self.c.Refresh(
-							types.RefreshOptions{Mode: types.SYNC, Scope: []types.RefreshableView{types.COMMITS}},
-						)
-						return nil
-					})
-				},
+				Label:   self.c.Tr.ToggleShowGitGraphAll,
+				OnPress: self.toggleWholeGitGraph,
 			},
 			{
 				Label:     self.c.Tr.ShowGitGraph,
@@ -1456,6 +1481,68 @@ func (self *LocalCommitsController) canDropCommits(selectedCommits []*models.Com
 	return nil
 }
 
+func (self *LocalCommitsController) canSplitCommit(commit *models.Commit) *types.DisabledReason {
+	if self.isCherryPickingOrReverting() {
+		return &types.DisabledReason{Text: self.c.Tr.NotAllowedMidCherryPickOrRevert}
+	}
+
+	if self.isRebasing() {
+		return &types.DisabledReason{Text: self.c.Tr.AlreadyRebasing}
+	}
+
+	if commit.IsMerge() {
+		return &types.DisabledReason{Text: self.c.Tr.CannotSplitMergeCommit}
+	}
+
+	if commit.IsFirstCommit() {
+		return &types.DisabledReason{Text: self.c.Tr.CannotSplitFirstCommit}
+	}
+
+	return nil
+}
+
+// When showing the whole git graph, the commits of other branches are
+// interleaved with the ones of the checked-out branch. Rebasing can only work
+// on our own commits, and it also looks at the neighbours of the selection
+// (e.g. to find the base commit, or the commit to swap with when moving), so
+// these must not belong to other branches either.
+func (self *LocalCommitsController) notNextToCommitsOfOtherBranches(selectedCommits []*models.Commit, startIdx int, endIdx int) *types.DisabledReason {
+	commits := self.c.Model().Commits
+	if len(commits) == 0 {
+		return nil
+	}
+
+	// The range extends from the commit above the selection down to the
+	// parent of the commit below it, which is the base commit when moving the
+	// selection down. Any commits displayed in between belong to other
+	// branches.
+	rangeStart := max(startIdx-1, 0)
+	rangeEnd := min(endIdx+1, len(commits)-1)
+	if rangeEnd > endIdx {
+		if parents := commits[rangeEnd].Parents(); len(parents) > 0 {
+			_, parentIdx, found := lo.FindIndexOf(commits[rangeEnd+1:], func(c *models.Commit) bool {
+				return c.Hash() == parents[0]
+			})
+			if found {
+				rangeEnd += parentIdx + 1
+			}
+		}
+	}
+
+	if lo.SomeBy(commits[rangeStart:rangeEnd+1], func(c *models.Commit) bool {
+		return c.Status == models.StatusOtherBranch
+	}) {
+		return &types.DisabledReason{Text: self.c.Tr.NotAllowedForCommitsOfOtherBranches}
+	}
+
+	return nil
+}
+
+func (self *LocalCommitsController) notCommitOfOtherBranch(_ *models.Commit) *types.DisabledReason {
+	idx := self.context().GetSelectedLineIdx()
+	return self.notNextToCommitsOfOtherBranches(nil, idx, idx)
+}
+
 // These actions represent standard things you might want to do with a commit,
 // as opposed to TODO actions like 'merge', 'update-ref', etc.
 var standardActions = []todo.TodoCommand{
@@ -0,0 +1,85 @@
+package controllers
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+)
+
+type MacrosController struct {
+	baseController
+	c *ControllerCommon
+}
+
+var _ types.IController = &MacrosController{}
+
+func NewMacrosController(
+	c *ControllerCommon,
+) *MacrosController {
+	return &MacrosController{
+		baseController: baseController{},
+		c:              c,
+	}
+}
+
+func (self *MacrosController) GetKeybindings(opts types.KeybindingsOpts) []*types.Binding {
+	return []*types.Binding{
+		{
+			Key:               opts.GetKey(opts.Config.Universal.RecordMacro),
+			Handler:           self.toggleRecording,
+			GetDisabledReason: self.disabledWhileReplaying,
+			Description:       self.c.Tr.RecordMacro,
+			DescriptionFunc:   self.recordMacroDescription,
+			Tooltip:           self.c.Tr.RecordMacroTooltip,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Universal.ReplayMacro),
+			Handler:           self.c.Helpers().Macro.OpenReplayMenu,
+			GetDisabledReason: self.replayDisabledReason,
+			Description:       self.c.Tr.ReplayMacro,
+			Tooltip:           self.c.Tr.ReplayMacroTooltip,
+			OpensMenu:         true,
+		},
+		{
+			// not meant to be pressed by the user; see MacroHelper
+			Key:     helpers.MacroStepDoneKey,
+			Handler: self.c.Helpers().Macro.StepDone,
+		},
+	}
+}
+
+func (self *MacrosController) Context() types.Context {
+	return nil
+}
+
+func (self *MacrosController) toggleRecording() error {
+	if self.c.Helpers().Macro.IsRecording() {
+		self.c.Helpers().Macro.StopRecording()
+		return nil
+	}
+
+	return self.c.Helpers().Macro.OpenRecordMenu()
+}
+
+func (self *MacrosController) recordMacroDescription() string {
+	if self.c.Helpers().Macro.IsRecording() {
+		return self.c.Tr.StopRecordingMacro
+	}
+
+	return self.c.Tr.RecordMacro
+}
+
+func (self *MacrosController) disabledWhileReplaying() *types.DisabledReason {
+	if self.c.Helpers().Macro.IsReplaying() {
+		return &types.DisabledReason{Text: self.c.Tr.MacroIsBeingReplayed}
+	}
+
+	return nil
+}
+
+func (self *MacrosController) replayDisabledReason() *types.DisabledReason {
+	if self.c.Helpers().Macro.IsRecording() {
+		return &types.DisabledReason{Text: self.c.Tr.CannotReplayMacroWhileRecording}
+	}
+
+	return self.disabledWhileReplaying()
+}
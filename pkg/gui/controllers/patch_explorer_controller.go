@@ -107,6 +107,12 @@ func (self *PatchExplorerController) GetKeybindings(opts types.KeybindingsOpts)
 			Tooltip:         self.c.Tr.ToggleSelectHunkTooltip,
 			DisplayOnScreen: true,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Main.ToggleSideBySideView),
+			Handler:     self.HandleToggleSideBySideView,
+			Description: self.c.Tr.ToggleSideBySideView,
+			Tooltip:     self.c.Tr.ToggleSideBySideViewTooltip,
+		},
 		{
 			Tag:         "navigation",
 			Key:         opts.GetKey(opts.Config.Universal.PrevPage),
@@ -252,6 +258,14 @@ func (self *PatchExplorerController) HandleToggleSelectHunk() error {
 	return nil
 }
 
+func (self *PatchExplorerController) HandleToggleSideBySideView() error {
+	self.c.State().SetSideBySideStagingView(!self.c.State().GetSideBySideStagingView())
+
+	// Re-focusing updates the wrap setting of the view and re-renders it
+	self.context.HandleFocus(types.OnFocusOpts{})
+	return nil
+}
+
 func (self *PatchExplorerController) HandleScrollLeft() error {
 	self.context.GetViewTrait().ScrollLeft()
 
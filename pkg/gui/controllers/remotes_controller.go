@@ -10,6 +10,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
 type RemotesController struct {
@@ -78,6 +79,29 @@ func (self *RemotesController) GetKeybindings(opts types.KeybindingsOpts) []*typ
 			Tooltip:           self.c.Tr.FetchRemoteTooltip,
 			DisplayOnScreen:   true,
 		},
+		{
+			Key:               opts.GetKey(opts.Config.Branches.PruneRemote),
+			Handler:           self.withItem(self.prune),
+			GetDisabledReason: self.require(self.singleItemSelected()),
+			Description:       self.c.Tr.PruneRemote,
+			Tooltip:           self.c.Tr.PruneRemoteTooltip,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Branches.EditRemotePushUrl),
+			Handler:           self.withItem(self.editPushUrl),
+			GetDisabledReason: self.require(self.singleItemSelected()),
+			Description:       self.c.Tr.EditRemotePushUrl,
+			Tooltip:           self.c.Tr.EditRemotePushUrlTooltip,
+			OpensMenu:         true,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Branches.SetRemoteHead),
+			Handler:           self.withItem(self.setHead),
+			GetDisabledReason: self.require(self.singleItemSelected()),
+			Description:       self.c.Tr.SetRemoteHead,
+			Tooltip:           self.c.Tr.SetRemoteHeadTooltip,
+			OpensMenu:         true,
+		},
 	}
 
 	return bindings
@@ -95,7 +119,7 @@ func (self *RemotesController) GetOnRenderToMain() func() {
 			if remote == nil {
 				task = types.NewRenderStringTask("No remotes")
 			} else {
-				task = types.NewRenderStringTask(fmt.Sprintf("%s\nUrls:\n%s", style.FgGreen.Sprint(remote.Name), strings.Join(remote.Urls, "\n")))
+				task = types.NewRenderStringTask(self.remoteInfo(remote))
 			}
 
 			self.c.RenderToMainViews(types.RefreshMainOpts{
@@ -109,6 +133,19 @@ func (self *RemotesController) GetOnRenderToMain() func() {
 	}
 }
 
+func (self *RemotesController) remoteInfo(remote *models.Remote) string {
+	pushUrls := strings.Join(remote.PushUrls, "\n")
+	if len(remote.PushUrls) == 0 {
+		pushUrls = style.FgBlackLighter.Sprint(self.c.Tr.SameAsFetchUrl)
+	}
+
+	return fmt.Sprintf("%s\n%s:\n%s\n\n%s:\n%s",
+		style.FgGreen.Sprint(remote.Name),
+		self.c.Tr.FetchUrls, strings.Join(remote.Urls, "\n"),
+		self.c.Tr.PushUrls, pushUrls,
+	)
+}
+
 func (self *RemotesController) GetOnClick() func() error {
 	return self.withItemGraceful(self.enter)
 }
@@ -257,3 +294,205 @@ func (self *RemotesController) fetch(remote *models.Remote) error {
 		return nil
 	})
 }
+
+func (self *RemotesController) prune(remote *models.Remote) error {
+	return self.c.WithWaitingStatus(self.c.Tr.CheckingForStaleBranchesStatus, func(gocui.Task) error {
+		staleBranches, err := self.c.Git().Remote.GetStaleRemoteBranches(remote.Name)
+		if err != nil {
+			return err
+		}
+
+		self.c.OnUIThread(func() error {
+			if len(staleBranches) == 0 {
+				self.c.Toast(self.c.Tr.NoStaleRemoteBranches)
+				return nil
+			}
+
+			prompt := utils.ResolvePlaceholderString(
+				self.c.Tr.PruneRemotePrompt,
+				map[string]string{
+					"remoteName": remote.Name,
+					"branches":   strings.Join(staleBranches, "\n"),
+				},
+			)
+			self.c.Confirm(types.ConfirmOpts{
+				Title:  self.c.Tr.PruneRemote,
+				Prompt: prompt,
+				HandleConfirm: func() error {
+					return self.c.WithInlineStatus(remote, types.ItemOperationFetching, context.REMOTES_CONTEXT_KEY, func(task gocui.Task) error {
+						self.c.LogAction(self.c.Tr.Actions.PruneRemote)
+						if err := self.c.Git().Sync.FetchRemoteAndPrune(task, remote.Name); err != nil {
+							return err
+						}
+
+						self.c.Refresh(types.RefreshOptions{
+							Scope: []types.RefreshableView{types.BRANCHES, types.REMOTES},
+							Mode:  types.ASYNC,
+						})
+						return nil
+					})
+				},
+			})
+			return nil
+		})
+
+		return nil
+	})
+}
+
+func (self *RemotesController) editPushUrl(remote *models.Remote) error {
+	refresh := func() {
+		self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.REMOTES}})
+	}
+
+	// Push URLs are edited one at a time, since a remote can have several of
+	// them and we don't want to touch the others
+	menuItems := lo.Map(remote.PushUrls, func(pushUrl string, _ int) *types.MenuItem {
+		return &types.MenuItem{
+			LabelColumns: []string{pushUrl},
+			OnPress: func() error {
+				self.c.Prompt(types.PromptOpts{
+					Title: utils.ResolvePlaceholderString(
+						self.c.Tr.EditRemotePushUrlPrompt,
+						map[string]string{
+							"remoteName": remote.Name,
+						},
+					),
+					InitialContent: pushUrl,
+					HandleConfirm: func(updatedPushUrl string) error {
+						updatedPushUrl = strings.TrimSpace(updatedPushUrl)
+						var err error
+						if updatedPushUrl == "" {
+							self.c.LogAction(self.c.Tr.Actions.RemoveRemotePushUrl)
+							err = self.c.Git().Remote.RemoveRemotePushUrl(remote.Name, pushUrl)
+						} else {
+							self.c.LogAction(self.c.Tr.Actions.UpdateRemotePushUrl)
+							err = self.c.Git().Remote.UpdateRemotePushUrl(remote.Name, pushUrl, updatedPushUrl)
+						}
+						if err != nil {
+							return err
+						}
+
+						refresh()
+						return nil
+					},
+				})
+				return nil
+			},
+		}
+	})
+
+	var noPushUrls *types.DisabledReason
+	if len(remote.PushUrls) == 0 {
+		noPushUrls = &types.DisabledReason{Text: self.c.Tr.NoRemotePushUrls}
+	}
+
+	menuItems = append(menuItems,
+		&types.MenuItem{
+			Label: self.c.Tr.AddRemotePushUrl,
+			Key:   'a',
+			OnPress: func() error {
+				self.c.Prompt(types.PromptOpts{
+					Title: utils.ResolvePlaceholderString(
+						self.c.Tr.AddRemotePushUrlPrompt,
+						map[string]string{
+							"remoteName": remote.Name,
+						},
+					),
+					HandleConfirm: func(pushUrl string) error {
+						self.c.LogAction(self.c.Tr.Actions.AddRemotePushUrl)
+						if err := self.c.Git().Remote.AddRemotePushUrl(remote.Name, strings.TrimSpace(pushUrl)); err != nil {
+							return err
+						}
+
+						refresh()
+						return nil
+					},
+				})
+				return nil
+			},
+		},
+		&types.MenuItem{
+			Label:          self.c.Tr.RemoveRemotePushUrls,
+			Tooltip:        self.c.Tr.RemoveRemotePushUrlsTooltip,
+			Key:            'd',
+			DisabledReason: noPushUrls,
+			OnPress: func() error {
+				self.c.LogAction(self.c.Tr.Actions.RemoveRemotePushUrl)
+				if err := self.c.Git().Remote.RemoveRemotePushUrls(remote.Name); err != nil {
+					return err
+				}
+
+				refresh()
+				return nil
+			},
+		},
+	)
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.EditRemotePushUrl,
+		Items: menuItems,
+	})
+}
+
+func (self *RemotesController) setHead(remote *models.Remote) error {
+	refresh := func() {
+		self.c.Refresh(types.RefreshOptions{
+			Scope: []types.RefreshableView{types.BRANCHES, types.REMOTES},
+			Mode:  types.ASYNC,
+		})
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.SetRemoteHead,
+		Items: []*types.MenuItem{
+			{
+				Label:   self.c.Tr.SetRemoteHeadAuto,
+				Tooltip: self.c.Tr.SetRemoteHeadAutoTooltip,
+				Key:     'a',
+				OnPress: func() error {
+					return self.c.WithInlineStatus(remote, types.ItemOperationFetching, context.REMOTES_CONTEXT_KEY, func(task gocui.Task) error {
+						self.c.LogAction(self.c.Tr.Actions.SetRemoteHead)
+						if err := self.c.Git().Remote.SetRemoteHeadAuto(task, remote.Name); err != nil {
+							return err
+						}
+						refresh()
+						return nil
+					})
+				},
+			},
+			{
+				Label:     self.c.Tr.SetRemoteHeadToBranch,
+				Key:       'b',
+				OpensMenu: true,
+				OnPress: func() error {
+					self.c.Prompt(types.PromptOpts{
+						Title:               self.c.Tr.SetRemoteHeadToBranch,
+						FindSuggestionsFunc: self.c.Helpers().Suggestions.GetRemoteBranchesForRemoteSuggestionsFunc(remote.Name),
+						HandleConfirm: func(branchName string) error {
+							self.c.LogAction(self.c.Tr.Actions.SetRemoteHead)
+							if err := self.c.Git().Remote.SetRemoteHead(remote.Name, branchName); err != nil {
+								return err
+							}
+							refresh()
+							return nil
+						},
+					})
+					return nil
+				},
+			},
+			{
+				Label: self.c.Tr.DeleteRemoteHead,
+				Key:   'd',
+				OnPress: func() error {
+					self.c.LogAction(self.c.Tr.Actions.SetRemoteHead)
+					if err := self.c.Git().Remote.DeleteRemoteHead(remote.Name); err != nil {
+						return err
+					}
+					refresh()
+					return nil
+				},
+			},
+		},
+	})
+}
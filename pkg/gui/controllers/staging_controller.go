@@ -1,14 +1,19 @@
 package controllers
 
 import (
+	"errors"
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/patch"
 	"github.com/jesseduffield/lazygit/pkg/gui/keybindings"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
 type StagingController struct {
@@ -87,6 +92,19 @@ func (self *StagingController) GetKeybindings(opts types.KeybindingsOpts) []*typ
 			Description: self.c.Tr.EditHunk,
 			Tooltip:     self.c.Tr.EditHunkTooltip,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Main.EditHunkInline),
+			Handler:     self.EditHunkInline,
+			Description: self.c.Tr.EditHunkInline,
+			Tooltip:     self.c.Tr.EditHunkInlineTooltip,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Main.StageMatchingLines),
+			Handler:     self.OpenMatchingLinesPrompt,
+			Description: self.c.Tr.StageMatchingLines,
+			Tooltip:     self.c.Tr.StageMatchingLinesTooltip,
+			OpensMenu:   true,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Files.CommitChanges),
 			Handler:     self.c.Helpers().WorkingTree.HandleCommitPress,
@@ -109,6 +127,13 @@ func (self *StagingController) GetKeybindings(opts types.KeybindingsOpts) []*typ
 			Description: self.c.Tr.FindBaseCommitForFixup,
 			Tooltip:     self.c.Tr.FindBaseCommitForFixupTooltip,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Files.AbsorbStagedChanges),
+			Handler:     self.c.Helpers().FixupHelper.HandleAbsorbPress,
+			Description: self.c.Tr.AbsorbStagedChanges,
+			Tooltip:     self.c.Tr.AbsorbStagedChangesTooltip,
+			OpensMenu:   true,
+		},
 	}
 }
 
@@ -122,7 +147,9 @@ func (self *StagingController) GetMouseKeybindings(opts types.KeybindingsOpts) [
 
 func (self *StagingController) GetOnFocus() func(types.OnFocusOpts) {
 	return func(opts types.OnFocusOpts) {
-		wrap := self.c.UserConfig().Gui.WrapLinesInStagingView
+		// Side-by-side rows are truncated to the width of the view, and must
+		// not wrap so that each of them corresponds to one patch line
+		wrap := self.c.UserConfig().Gui.WrapLinesInStagingView && !self.c.State().GetSideBySideStagingView()
 		self.c.Views().Staging.Wrap = wrap
 		self.c.Views().StagingSecondary.Wrap = wrap
 
@@ -244,12 +271,11 @@ func (self *StagingController) applySelection(reverse bool) error {
 		return nil
 	}
 
-	firstLineIdx, lastLineIdx := state.SelectedPatchRange()
 	patchToApply := patch.
 		Parse(state.GetDiff()).
 		Transform(patch.TransformOpts{
 			Reverse:             reverse,
-			IncludedLineIndices: patch.ExpandRange(firstLineIdx, lastLineIdx),
+			IncludedLineIndices: state.SelectedPatchLineIndices(),
 			FileNameOverride:    path,
 		}).
 		FormatPlain()
@@ -280,6 +306,160 @@ func (self *StagingController) applySelection(reverse bool) error {
 	return nil
 }
 
+func (self *StagingController) OpenMatchingLinesPrompt() error {
+	self.c.Prompt(types.PromptOpts{
+		Title: self.c.Tr.MatchingLinesRegexTitle,
+		HandleConfirm: func(pattern string) error {
+			re, err := regexp.Compile(pattern)
+			if err != nil {
+				return err
+			}
+
+			return self.openMatchingLinesMenu(re)
+		},
+	})
+
+	return nil
+}
+
+func (self *StagingController) openMatchingLinesMenu(re *regexp.Regexp) error {
+	currentFile := func() []*models.File {
+		file := self.c.Contexts().Files.GetSelectedFile()
+		if file == nil {
+			return nil
+		}
+		return []*models.File{file}
+	}
+	allFiles := func() []*models.File {
+		return lo.Filter(self.c.Model().Files, func(file *models.File, _ int) bool {
+			return !file.HasMergeConflicts &&
+				lo.Ternary(self.staged, file.HasStagedChanges, file.HasUnstagedChanges)
+		})
+	}
+
+	var menuItems []*types.MenuItem
+	if self.staged {
+		menuItems = []*types.MenuItem{
+			{
+				Label:   self.c.Tr.UnstageMatchingLinesInFile,
+				Key:     'u',
+				OnPress: func() error { return self.applyMatchingLines(re, currentFile(), true) },
+			},
+			{
+				Label:   self.c.Tr.UnstageMatchingLinesInAllFiles,
+				Key:     'U',
+				OnPress: func() error { return self.applyMatchingLines(re, allFiles(), true) },
+			},
+		}
+	} else {
+		confirmDiscard := func(getFiles func() []*models.File) func() error {
+			return func() error {
+				return self.c.ConfirmIf(!self.c.UserConfig().Gui.SkipDiscardChangeWarning,
+					types.ConfirmOpts{
+						Title: self.c.Tr.DiscardChangeTitle,
+						Prompt: utils.ResolvePlaceholderString(self.c.Tr.DiscardMatchingLinesPrompt,
+							map[string]string{"regex": re.String()}),
+						HandleConfirm: func() error { return self.applyMatchingLines(re, getFiles(), true) },
+					})
+			}
+		}
+
+		menuItems = []*types.MenuItem{
+			{
+				Label:   self.c.Tr.StageMatchingLinesInFile,
+				Key:     's',
+				OnPress: func() error { return self.applyMatchingLines(re, currentFile(), false) },
+			},
+			{
+				Label:   self.c.Tr.StageMatchingLinesInAllFiles,
+				Key:     'S',
+				OnPress: func() error { return self.applyMatchingLines(re, allFiles(), false) },
+			},
+			{
+				Label:   self.c.Tr.DiscardMatchingLinesInFile,
+				Key:     'd',
+				OnPress: confirmDiscard(currentFile),
+			},
+			{
+				Label:   self.c.Tr.DiscardMatchingLinesInAllFiles,
+				Key:     'D',
+				OnPress: confirmDiscard(allFiles),
+			},
+		}
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: utils.ResolvePlaceholderString(self.c.Tr.MatchingLinesMenuTitle, map[string]string{"regex": re.String()}),
+		Items: menuItems,
+	})
+}
+
+// Stages (or, if reverse is true, unstages or discards) all changed lines of
+// the given files that match the regex
+func (self *StagingController) applyMatchingLines(re *regexp.Regexp, files []*models.File, reverse bool) error {
+	if self.c.UserConfig().Git.DiffContextSize == 0 {
+		return fmt.Errorf(self.c.Tr.Actions.NotEnoughContextToStage,
+			keybindings.Label(self.c.UserConfig().Keybinding.Universal.IncreaseContextInDiffView))
+	}
+
+	self.c.LogAction(self.c.Tr.Actions.ApplyPatch)
+
+	// build the patches of all files first and apply them together, so that
+	// either all of them are applied or none
+	var patchToApply strings.Builder
+	lineCount, fileCount := 0, 0
+	for _, file := range files {
+		diff := self.c.Git().WorkingTree.WorktreeFileDiff(file, true, self.staged)
+		parsedPatch := patch.Parse(diff)
+		lineIndices := parsedPatch.ChangeLineIndicesMatching(re)
+		if len(lineIndices) == 0 {
+			continue
+		}
+
+		patchToApply.WriteString(parsedPatch.
+			Transform(patch.TransformOpts{
+				Reverse:             reverse,
+				IncludedLineIndices: lineIndices,
+				FileNameOverride:    file.Path,
+			}).
+			FormatPlain())
+
+		lineCount += len(lineIndices)
+		fileCount++
+	}
+
+	if lineCount == 0 {
+		return errors.New(utils.ResolvePlaceholderString(self.c.Tr.NoChangedLinesMatch,
+			map[string]string{"regex": re.String()}))
+	}
+
+	err := self.c.Git().Patch.ApplyPatch(
+		patchToApply.String(),
+		git_commands.ApplyPatchOpts{
+			Reverse: reverse,
+			Cached:  !reverse || self.staged,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.FILES, types.STAGING}})
+
+	message := self.c.Tr.StagedMatchingLines
+	if self.staged {
+		message = self.c.Tr.UnstagedMatchingLines
+	} else if reverse {
+		message = self.c.Tr.DiscardedMatchingLines
+	}
+	self.c.Toast(utils.ResolvePlaceholderString(message, map[string]string{
+		"lineCount": fmt.Sprint(lineCount),
+		"fileCount": fmt.Sprint(fileCount),
+	}))
+
+	return nil
+}
+
 func (self *StagingController) EditHunkAndRefresh() error {
 	if err := self.editHunk(); err != nil {
 		return err
@@ -289,6 +469,29 @@ func (self *StagingController) EditHunkAndRefresh() error {
 	return nil
 }
 
+func (self *StagingController) EditHunkInline() error {
+	self.context.GetMutex().Lock()
+	defer self.context.GetMutex().Unlock()
+
+	state := self.context.GetState()
+	path := self.FilePath()
+	if path == "" {
+		return nil
+	}
+
+	hunkStartIdx, hunkEndIdx := state.CurrentHunkBounds()
+	hunkPatch := patch.
+		Parse(state.GetDiff()).
+		Transform(patch.TransformOpts{
+			IncludedLineIndices: patch.ExpandRange(hunkStartIdx, hunkEndIdx),
+			FileNameOverride:    path,
+		})
+
+	// the first line of the hunk is its header, which isn't shown in the editor
+	cursorLineIdx := state.GetSelectedPatchLineIdx() - hunkStartIdx - 1
+	return self.c.Helpers().HunkEditor.OpenHunkEditor(hunkPatch, self.staged, cursorLineIdx)
+}
+
 func (self *StagingController) editHunk() error {
 	self.context.GetMutex().Lock()
 	defer self.context.GetMutex().Unlock()
@@ -53,19 +53,39 @@ func (self *TagsController) GetKeybindings(opts types.KeybindingsOpts) []*types.
 			Tooltip:         self.c.Tr.NewTagTooltip,
 			DisplayOnScreen: true,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Branches.CreateSignedTag),
+			Handler:     self.createSigned,
+			Description: self.c.Tr.NewSignedTag,
+			Tooltip:     self.c.Tr.NewSignedTagTooltip,
+			OpensMenu:   true,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Branches.CreateNextVersionTag),
+			Handler:     self.createNextVersion,
+			Description: self.c.Tr.NewNextVersionTag,
+			Tooltip:     self.c.Tr.NewNextVersionTagTooltip,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Branches.EditTagMessage),
+			Handler:           self.withItem(self.editMessage),
+			GetDisabledReason: self.require(self.singleItemSelected(self.isAnnotated)),
+			Description:       self.c.Tr.EditTagMessage,
+			Tooltip:           self.c.Tr.EditTagMessageTooltip,
+		},
 		{
 			Key:               opts.GetKey(opts.Config.Universal.Remove),
-			Handler:           self.withItem(self.delete),
+			Handler:           self.withItems(self.delete),
 			Description:       self.c.Tr.Delete,
-			GetDisabledReason: self.require(self.singleItemSelected()),
+			GetDisabledReason: self.require(self.itemRangeSelected()),
 			Tooltip:           self.c.Tr.TagDeleteTooltip,
 			OpensMenu:         true,
 			DisplayOnScreen:   true,
 		},
 		{
 			Key:               opts.GetKey(opts.Config.Branches.PushTag),
-			Handler:           self.withItem(self.push),
-			GetDisabledReason: self.require(self.singleItemSelected()),
+			Handler:           self.withItems(self.push),
+			GetDisabledReason: self.require(self.itemRangeSelected()),
 			Description:       self.c.Tr.PushTag,
 			Tooltip:           self.c.Tr.PushTagTooltip,
 			DisplayOnScreen:   true,
@@ -126,7 +146,10 @@ func (self *TagsController) getTagInfo(tag *models.Tag) string {
 		info := fmt.Sprintf("%s: %s", self.c.Tr.AnnotatedTag, style.AttrBold.Sprint(style.FgYellow.Sprint(tag.Name)))
 		output, err := self.c.Git().Tag.ShowAnnotationInfo(tag.Name)
 		if err == nil {
-			info += "\n\n" + strings.TrimRight(filterOutPgpSignature(output), "\n")
+			info += "\n\n" + strings.TrimRight(filterOutSignature(output), "\n")
+			if hasSignature(output) {
+				info += "\n\n" + self.getSignatureInfo(tag)
+			}
 		}
 		return info
 	}
@@ -134,18 +157,42 @@ func (self *TagsController) getTagInfo(tag *models.Tag) string {
 	return fmt.Sprintf("%s: %s", self.c.Tr.LightweightTag, style.AttrBold.Sprint(style.FgYellow.Sprint(tag.Name)))
 }
 
-func filterOutPgpSignature(output string) string {
+func (self *TagsController) getSignatureInfo(tag *models.Tag) string {
+	output, err := self.c.Git().Tag.VerifySignature(tag.Name)
+	if err != nil {
+		return fmt.Sprintf("%s: %s\n%s", self.c.Tr.TagSignature, style.FgRed.Sprint(self.c.Tr.TagSignatureInvalid), output)
+	}
+	return fmt.Sprintf("%s: %s\n%s", self.c.Tr.TagSignature, style.FgGreen.Sprint(self.c.Tr.TagSignatureValid), output)
+}
+
+// The armor lines that enclose the signature at the end of a signed tag's
+// message, depending on gpg.format (openpgp, ssh or x509)
+var signatureDelimiters = []struct{ begin, end string }{
+	{"-----BEGIN PGP SIGNATURE-----", "-----END PGP SIGNATURE-----"},
+	{"-----BEGIN SSH SIGNATURE-----", "-----END SSH SIGNATURE-----"},
+	{"-----BEGIN SIGNED MESSAGE-----", "-----END SIGNED MESSAGE-----"},
+}
+
+func hasSignature(output string) bool {
+	return lo.SomeBy(signatureDelimiters, func(delimiters struct{ begin, end string }) bool {
+		return strings.Contains(output, delimiters.begin)
+	})
+}
+
+func filterOutSignature(output string) string {
 	lines := strings.Split(output, "\n")
-	inPgpSignature := false
+	inSignature := false
 	filteredLines := lo.Filter(lines, func(line string, _ int) bool {
-		if line == "-----END PGP SIGNATURE-----" {
-			inPgpSignature = false
-			return false
-		}
-		if line == "-----BEGIN PGP SIGNATURE-----" {
-			inPgpSignature = true
+		for _, delimiters := range signatureDelimiters {
+			if line == delimiters.end {
+				inSignature = false
+				return false
+			}
+			if line == delimiters.begin {
+				inSignature = true
+			}
 		}
-		return !inPgpSignature
+		return !inSignature
 	})
 	return strings.Join(filteredLines, "\n")
 }
@@ -159,52 +206,50 @@ func (self *TagsController) checkout(tag *models.Tag) error {
 	return nil
 }
 
-func (self *TagsController) localDelete(tag *models.Tag) error {
+func (self *TagsController) localDelete(tags []*models.Tag) error {
 	return self.c.WithWaitingStatus(self.c.Tr.DeletingStatus, func(gocui.Task) error {
 		self.c.LogAction(self.c.Tr.Actions.DeleteLocalTag)
-		err := self.c.Git().Tag.LocalDelete(tag.Name)
+		err := self.c.Git().Tag.LocalDelete(tagNames(tags))
+		self.context().CollapseRangeSelectionToTop()
 		self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.COMMITS, types.TAGS}})
 		return err
 	})
 }
 
-func (self *TagsController) remoteDelete(tag *models.Tag) error {
-	title := utils.ResolvePlaceholderString(
-		self.c.Tr.SelectRemoteTagUpstream,
-		map[string]string{
-			"tagName": tag.Name,
-		},
-	)
-
+func (self *TagsController) remoteDelete(tags []*models.Tag) error {
 	self.c.Prompt(types.PromptOpts{
-		Title:               title,
+		Title:               self.selectRemoteTitle(self.c.Tr.SelectRemoteTagUpstream, self.c.Tr.SelectRemoteTagsUpstream, tags),
 		InitialContent:      "origin",
 		FindSuggestionsFunc: self.c.Helpers().Suggestions.GetRemoteSuggestionsFunc(),
 		HandleConfirm: func(upstream string) error {
-			confirmTitle := utils.ResolvePlaceholderString(
-				self.c.Tr.DeleteTagTitle,
-				map[string]string{
-					"tagName": tag.Name,
-				},
-			)
-			confirmPrompt := utils.ResolvePlaceholderString(
-				self.c.Tr.DeleteRemoteTagPrompt,
-				map[string]string{
-					"tagName":  tag.Name,
-					"upstream": upstream,
-				},
-			)
+			var confirmPrompt string
+			if len(tags) == 1 {
+				confirmPrompt = utils.ResolvePlaceholderString(
+					self.c.Tr.DeleteRemoteTagPrompt,
+					map[string]string{
+						"tagName":  tags[0].Name,
+						"upstream": upstream,
+					},
+				)
+			} else {
+				confirmPrompt = utils.ResolvePlaceholderString(
+					self.c.Tr.DeleteRemoteTagsPrompt,
+					map[string]string{
+						"upstream": upstream,
+					},
+				)
+			}
 
 			self.c.Confirm(types.ConfirmOpts{
-				Title:  confirmTitle,
+				Title:  self.deleteTitle(tags),
 				Prompt: confirmPrompt,
 				HandleConfirm: func() error {
-					return self.c.WithInlineStatus(tag, types.ItemOperationDeleting, context.TAGS_CONTEXT_KEY, func(task gocui.Task) error {
+					return self.withItemsOperation(tags, types.ItemOperationDeleting, self.c.Tr.DeletingStatus, func(task gocui.Task) error {
 						self.c.LogAction(self.c.Tr.Actions.DeleteRemoteTag)
-						if err := self.c.Git().Remote.DeleteRemoteTag(task, upstream, tag.Name); err != nil {
+						if err := self.c.Git().Remote.DeleteRemoteTag(task, upstream, tagNames(tags)); err != nil {
 							return err
 						}
-						self.c.Toast(self.c.Tr.RemoteTagDeletedMessage)
+						self.c.Toast(lo.Ternary(len(tags) > 1, self.c.Tr.RemoteTagsDeletedMessage, self.c.Tr.RemoteTagDeletedMessage))
 						self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.COMMITS, types.TAGS}})
 						return nil
 					})
@@ -218,47 +263,45 @@ func (self *TagsController) remoteDelete(tag *models.Tag) error {
 	return nil
 }
 
-func (self *TagsController) localAndRemoteDelete(tag *models.Tag) error {
-	title := utils.ResolvePlaceholderString(
-		self.c.Tr.SelectRemoteTagUpstream,
-		map[string]string{
-			"tagName": tag.Name,
-		},
-	)
-
+func (self *TagsController) localAndRemoteDelete(tags []*models.Tag) error {
 	self.c.Prompt(types.PromptOpts{
-		Title:               title,
+		Title:               self.selectRemoteTitle(self.c.Tr.SelectRemoteTagUpstream, self.c.Tr.SelectRemoteTagsUpstream, tags),
 		InitialContent:      "origin",
 		FindSuggestionsFunc: self.c.Helpers().Suggestions.GetRemoteSuggestionsFunc(),
 		HandleConfirm: func(upstream string) error {
-			confirmTitle := utils.ResolvePlaceholderString(
-				self.c.Tr.DeleteTagTitle,
-				map[string]string{
-					"tagName": tag.Name,
-				},
-			)
-			confirmPrompt := utils.ResolvePlaceholderString(
-				self.c.Tr.DeleteLocalAndRemoteTagPrompt,
-				map[string]string{
-					"tagName":  tag.Name,
-					"upstream": upstream,
-				},
-			)
+			var confirmPrompt string
+			if len(tags) == 1 {
+				confirmPrompt = utils.ResolvePlaceholderString(
+					self.c.Tr.DeleteLocalAndRemoteTagPrompt,
+					map[string]string{
+						"tagName":  tags[0].Name,
+						"upstream": upstream,
+					},
+				)
+			} else {
+				confirmPrompt = utils.ResolvePlaceholderString(
+					self.c.Tr.DeleteLocalAndRemoteTagsPrompt,
+					map[string]string{
+						"upstream": upstream,
+					},
+				)
+			}
 
 			self.c.Confirm(types.ConfirmOpts{
-				Title:  confirmTitle,
+				Title:  self.deleteTitle(tags),
 				Prompt: confirmPrompt,
 				HandleConfirm: func() error {
-					return self.c.WithInlineStatus(tag, types.ItemOperationDeleting, context.TAGS_CONTEXT_KEY, func(task gocui.Task) error {
+					return self.withItemsOperation(tags, types.ItemOperationDeleting, self.c.Tr.DeletingStatus, func(task gocui.Task) error {
 						self.c.LogAction(self.c.Tr.Actions.DeleteRemoteTag)
-						if err := self.c.Git().Remote.DeleteRemoteTag(task, upstream, tag.Name); err != nil {
+						if err := self.c.Git().Remote.DeleteRemoteTag(task, upstream, tagNames(tags)); err != nil {
 							return err
 						}
 
 						self.c.LogAction(self.c.Tr.Actions.DeleteLocalTag)
-						if err := self.c.Git().Tag.LocalDelete(tag.Name); err != nil {
+						if err := self.c.Git().Tag.LocalDelete(tagNames(tags)); err != nil {
 							return err
 						}
+						self.context().CollapseRangeSelectionToTop()
 						self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.COMMITS, types.TAGS}})
 						return nil
 					})
@@ -272,62 +315,48 @@ func (self *TagsController) localAndRemoteDelete(tag *models.Tag) error {
 	return nil
 }
 
-func (self *TagsController) delete(tag *models.Tag) error {
-	menuTitle := utils.ResolvePlaceholderString(
-		self.c.Tr.DeleteTagTitle,
-		map[string]string{
-			"tagName": tag.Name,
-		},
-	)
-
+func (self *TagsController) delete(tags []*models.Tag) error {
 	menuItems := []*types.MenuItem{
 		{
-			Label: self.c.Tr.DeleteLocalTag,
+			Label: lo.Ternary(len(tags) > 1, self.c.Tr.DeleteLocalTags, self.c.Tr.DeleteLocalTag),
 			Key:   'c',
 			OnPress: func() error {
-				return self.localDelete(tag)
+				return self.localDelete(tags)
 			},
 		},
 		{
-			Label:     self.c.Tr.DeleteRemoteTag,
+			Label:     lo.Ternary(len(tags) > 1, self.c.Tr.DeleteRemoteTags, self.c.Tr.DeleteRemoteTag),
 			Key:       'r',
 			OpensMenu: true,
 			OnPress: func() error {
-				return self.remoteDelete(tag)
+				return self.remoteDelete(tags)
 			},
 		},
 		{
-			Label:     self.c.Tr.DeleteLocalAndRemoteTag,
+			Label:     lo.Ternary(len(tags) > 1, self.c.Tr.DeleteLocalAndRemoteTags, self.c.Tr.DeleteLocalAndRemoteTag),
 			Key:       'b',
 			OpensMenu: true,
 			OnPress: func() error {
-				return self.localAndRemoteDelete(tag)
+				return self.localAndRemoteDelete(tags)
 			},
 		},
 	}
 
 	return self.c.Menu(types.CreateMenuOptions{
-		Title: menuTitle,
+		Title: self.deleteTitle(tags),
 		Items: menuItems,
 	})
 }
 
-func (self *TagsController) push(tag *models.Tag) error {
-	title := utils.ResolvePlaceholderString(
-		self.c.Tr.PushTagTitle,
-		map[string]string{
-			"tagName": tag.Name,
-		},
-	)
-
+func (self *TagsController) push(tags []*models.Tag) error {
 	self.c.Prompt(types.PromptOpts{
-		Title:               title,
+		Title:               self.selectRemoteTitle(self.c.Tr.PushTagTitle, self.c.Tr.PushTagsTitle, tags),
 		InitialContent:      "origin",
 		FindSuggestionsFunc: self.c.Helpers().Suggestions.GetRemoteSuggestionsFunc(),
 		HandleConfirm: func(response string) error {
-			return self.c.WithInlineStatus(tag, types.ItemOperationPushing, context.TAGS_CONTEXT_KEY, func(task gocui.Task) error {
+			return self.withItemsOperation(tags, types.ItemOperationPushing, self.c.Tr.PushingTagStatus, func(task gocui.Task) error {
 				self.c.LogAction(self.c.Tr.Actions.PushTag)
-				err := self.c.Git().Tag.Push(task, response, tag.Name)
+				err := self.c.Git().Tag.Push(task, response, tagNames(tags))
 
 				// Render again to remove the inline status:
 				self.c.OnUIThread(func() error {
@@ -343,15 +372,102 @@ func (self *TagsController) push(tag *models.Tag) error {
 	return nil
 }
 
+// Shows an inline status next to the tag if there is only one, otherwise a
+// waiting status in the app status bar.
+func (self *TagsController) withItemsOperation(tags []*models.Tag, operation types.ItemOperation, waitingStatus string, f func(gocui.Task) error) error {
+	if len(tags) == 1 {
+		return self.c.WithInlineStatus(tags[0], operation, context.TAGS_CONTEXT_KEY, f)
+	}
+
+	return self.c.WithWaitingStatus(waitingStatus, f)
+}
+
+func (self *TagsController) deleteTitle(tags []*models.Tag) string {
+	if len(tags) > 1 {
+		return self.c.Tr.DeleteTagsTitle
+	}
+
+	return utils.ResolvePlaceholderString(
+		self.c.Tr.DeleteTagTitle,
+		map[string]string{
+			"tagName": tags[0].Name,
+		},
+	)
+}
+
+func (self *TagsController) selectRemoteTitle(singleTemplate string, multiTitle string, tags []*models.Tag) string {
+	if len(tags) > 1 {
+		return multiTitle
+	}
+
+	return utils.ResolvePlaceholderString(
+		singleTemplate,
+		map[string]string{
+			"tagName": tags[0].Name,
+		},
+	)
+}
+
+func tagNames(tags []*models.Tag) []string {
+	return lo.Map(tags, func(tag *models.Tag, _ int) string { return tag.Name })
+}
+
+func (self *TagsController) createSigned() error {
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.NewSignedTag,
+		Items: []*types.MenuItem{
+			{
+				Label: self.c.Tr.SignWithDefaultKey,
+				Key:   'd',
+				OnPress: func() error {
+					return self.c.Helpers().Tags.OpenCreateSignedTagPrompt("", "", self.onTagCreated)
+				},
+			},
+			{
+				Label:     self.c.Tr.SignWithSpecificKey,
+				Key:       'k',
+				OpensMenu: true,
+				OnPress: func() error {
+					self.c.Prompt(types.PromptOpts{
+						Title: self.c.Tr.SigningKeyTitle,
+						HandleConfirm: func(signingKey string) error {
+							return self.c.Helpers().Tags.OpenCreateSignedTagPrompt("", strings.TrimSpace(signingKey), self.onTagCreated)
+						},
+					})
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func (self *TagsController) createNextVersion() error {
+	return self.c.Helpers().Tags.OpenCreateNextVersionTagPrompt(self.onTagCreated)
+}
+
+// Editing the message of a lightweight tag would turn it into an annotated tag
+func (self *TagsController) isAnnotated(tag *models.Tag) *types.DisabledReason {
+	if !tag.IsAnnotated {
+		return &types.DisabledReason{Text: self.c.Tr.CannotEditLightweightTag}
+	}
+	return nil
+}
+
+func (self *TagsController) editMessage(tag *models.Tag) error {
+	return self.c.Helpers().Tags.OpenEditTagMessagePrompt(tag.Name)
+}
+
+func (self *TagsController) onTagCreated() {
+	self.context().SetSelection(0)
+}
+
 func (self *TagsController) createResetMenu(tag *models.Tag) error {
 	return self.c.Helpers().Refs.CreateGitResetMenu(tag.Name, tag.FullRefName())
 }
 
 func (self *TagsController) create() error {
 	// leaving commit hash blank so that we're just creating the tag for the current commit
-	return self.c.Helpers().Tags.OpenCreateTagPrompt("", func() {
-		self.context().SetSelection(0)
-	})
+	return self.c.Helpers().Tags.OpenCreateTagPrompt("", self.onTagCreated)
 }
 
 func (self *TagsController) context() *context.TagsContext {
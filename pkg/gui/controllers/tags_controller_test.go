@@ -0,0 +1,48 @@
+package controllers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterOutSignature(t *testing.T) {
+	scenarios := []struct {
+		testName             string
+		output               string
+		expectedOutput       string
+		expectedHasSignature bool
+	}{
+		{
+			testName:             "unsigned",
+			output:               "tag v1.0\nTagger: Jesse\n\nmessage\n",
+			expectedOutput:       "tag v1.0\nTagger: Jesse\n\nmessage\n",
+			expectedHasSignature: false,
+		},
+		{
+			testName:             "PGP signature",
+			output:               "tag v1.0\n\nmessage\n-----BEGIN PGP SIGNATURE-----\n\nabc\n-----END PGP SIGNATURE-----\n",
+			expectedOutput:       "tag v1.0\n\nmessage\n",
+			expectedHasSignature: true,
+		},
+		{
+			testName:             "SSH signature",
+			output:               "tag v1.0\n\nmessage\n-----BEGIN SSH SIGNATURE-----\nabc\n-----END SSH SIGNATURE-----\n",
+			expectedOutput:       "tag v1.0\n\nmessage\n",
+			expectedHasSignature: true,
+		},
+		{
+			testName:             "X.509 signature",
+			output:               "tag v1.0\n\nmessage\n-----BEGIN SIGNED MESSAGE-----\nabc\n-----END SIGNED MESSAGE-----\n",
+			expectedOutput:       "tag v1.0\n\nmessage\n",
+			expectedHasSignature: true,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			assert.Equal(t, s.expectedOutput, filterOutSignature(s.output))
+			assert.Equal(t, s.expectedHasSignature, hasSignature(s.output))
+		})
+	}
+}
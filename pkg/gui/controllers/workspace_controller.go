@@ -0,0 +1,199 @@
+package controllers
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"text/tabwriter"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+// Shows the status of several repos at once, and lets you switch between them
+// or fetch/pull in all of them.
+type WorkspaceController struct {
+	baseController
+	*ListControllerTrait[*models.WorkspaceRepo]
+	c *ControllerCommon
+}
+
+var _ types.IController = &WorkspaceController{}
+
+func NewWorkspaceController(
+	c *ControllerCommon,
+) *WorkspaceController {
+	return &WorkspaceController{
+		baseController: baseController{},
+		ListControllerTrait: NewListControllerTrait(
+			c,
+			c.Contexts().Workspace,
+			c.Contexts().Workspace.GetSelected,
+			c.Contexts().Workspace.GetSelectedItems,
+		),
+		c: c,
+	}
+}
+
+func (self *WorkspaceController) GetKeybindings(opts types.KeybindingsOpts) []*types.Binding {
+	bindings := []*types.Binding{
+		{
+			Key:               opts.GetKey(opts.Config.Universal.Select),
+			Handler:           self.withItem(self.enter),
+			GetDisabledReason: self.require(self.singleItemSelected()),
+			Description:       self.c.Tr.Switch,
+			Tooltip:           self.c.Tr.SwitchToWorkspaceRepoTooltip,
+			DisplayOnScreen:   true,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Universal.GoInto),
+			Handler:           self.withItem(self.enter),
+			GetDisabledReason: self.require(self.singleItemSelected()),
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Universal.OpenFile),
+			Handler:           self.withItem(self.open),
+			GetDisabledReason: self.require(self.singleItemSelected()),
+			Description:       self.c.Tr.OpenInEditor,
+		},
+		{
+			Key:             opts.GetKey(opts.Config.Workspace.FetchAllRepos),
+			Handler:         self.fetchAll,
+			Description:     self.c.Tr.FetchAllRepos,
+			Tooltip:         self.c.Tr.FetchAllReposTooltip,
+			DisplayOnScreen: true,
+		},
+		{
+			Key:             opts.GetKey(opts.Config.Workspace.PullAllRepos),
+			Handler:         self.pullAll,
+			Description:     self.c.Tr.PullAllRepos,
+			Tooltip:         self.c.Tr.PullAllReposTooltip,
+			DisplayOnScreen: true,
+		},
+	}
+
+	return bindings
+}
+
+func (self *WorkspaceController) GetOnRenderToMain() func() {
+	return func() {
+		var task types.UpdateTask
+		repo := self.context().GetSelected()
+		if repo == nil {
+			task = types.NewRenderStringTask(self.c.Tr.NoWorkspaceRepos)
+		} else {
+			var builder strings.Builder
+			w := tabwriter.NewWriter(&builder, 0, 0, 2, ' ', 0)
+			_, _ = fmt.Fprintf(w, "%s:\t%s\n", self.c.Tr.Name, style.FgGreen.Sprint(repo.Name))
+			_, _ = fmt.Fprintf(w, "%s:\t%s\n", self.c.Tr.Path, style.FgCyan.Sprint(repo.Path))
+			if repo.Err != nil {
+				_, _ = fmt.Fprintf(w, "%s:\t%s\n", self.c.Tr.Error, style.FgRed.Sprint(repo.Err.Error()))
+			} else {
+				_, _ = fmt.Fprintf(w, "%s:\t%s\n", self.c.Tr.Branch, style.FgYellow.Sprint(repo.Branch))
+				if repo.WorkingTreeState.Any() {
+					_, _ = fmt.Fprintf(w, "%s:\t%s\n", self.c.Tr.WorkspaceRepoStatus,
+						style.FgMagenta.Sprint(repo.WorkingTreeState.Title(self.c.Tr)))
+				}
+			}
+			_ = w.Flush()
+
+			task = types.NewRenderStringTask(builder.String())
+		}
+
+		self.c.RenderToMainViews(types.RefreshMainOpts{
+			Pair: self.c.MainViewPairs().Normal,
+			Main: &types.ViewUpdateOpts{
+				Title: self.c.Tr.WorkspaceRepoTitle,
+				Task:  task,
+			},
+		})
+	}
+}
+
+func (self *WorkspaceController) GetOnClick() func() error {
+	return self.withItemGraceful(self.enter)
+}
+
+func (self *WorkspaceController) enter(repo *models.WorkspaceRepo) error {
+	if repo.IsCurrent {
+		return nil
+	}
+
+	// like when switching to a recent repo, we don't want to return to a
+	// parent repo when hitting escape in the new one
+	self.c.State().GetRepoPathStack().Clear()
+	return self.c.Helpers().Repos.DispatchSwitchToRepo(repo.Path, context.WORKSPACE_CONTEXT_KEY)
+}
+
+func (self *WorkspaceController) open(repo *models.WorkspaceRepo) error {
+	return self.c.Helpers().Files.OpenDirInEditor(repo.Path)
+}
+
+func (self *WorkspaceController) fetchAll() error {
+	return self.forAllRepos(self.c.Tr.FetchingStatus, self.c.Tr.Actions.FetchAllRepos,
+		func(*models.WorkspaceRepo) bool { return true },
+		self.c.Git().Sync.FetchInRepo)
+}
+
+func (self *WorkspaceController) pullAll() error {
+	return self.forAllRepos(self.c.Tr.PullingStatus, self.c.Tr.Actions.PullAllRepos,
+		func(repo *models.WorkspaceRepo) bool { return repo.HasUpstream },
+		self.c.Git().Sync.PullInRepo)
+}
+
+// Runs the given command in all available repos for which the filter returns
+// true, in parallel. Errors are collected and reported together once all
+// repos are done, so that a failure in one repo doesn't affect the others.
+func (self *WorkspaceController) forAllRepos(
+	waitingStatus string,
+	action string,
+	filter func(*models.WorkspaceRepo) bool,
+	f func(repoPath string) error,
+) error {
+	repos := lo.Filter(self.c.Model().WorkspaceRepos, func(repo *models.WorkspaceRepo, _ int) bool {
+		return repo.Err == nil && filter(repo)
+	})
+
+	return self.c.WithWaitingStatus(waitingStatus, func(gocui.Task) error {
+		self.c.LogAction(action)
+
+		errs := make([]error, len(repos))
+		wg := sync.WaitGroup{}
+		wg.Add(len(repos))
+		for i, repo := range repos {
+			go utils.Safe(func() {
+				defer wg.Done()
+
+				errs[i] = f(repo.Path)
+			})
+		}
+		wg.Wait()
+
+		// the current repo may be one of the workspace repos, so refresh
+		// everything rather than just the workspace
+		self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC})
+		self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.WORKSPACE}, Mode: types.ASYNC})
+
+		failures := lo.FilterMap(repos, func(repo *models.WorkspaceRepo, i int) (string, bool) {
+			if errs[i] == nil {
+				return "", false
+			}
+			return fmt.Sprintf("%s: %s", repo.Name, strings.TrimSpace(errs[i].Error())), true
+		})
+		if len(failures) > 0 {
+			return errors.New(self.c.Tr.WorkspaceActionFailed + "\n\n" + strings.Join(failures, "\n"))
+		}
+
+		return nil
+	})
+}
+
+func (self *WorkspaceController) context() *context.WorkspaceContext {
+	return self.c.Contexts().Workspace
+}
@@ -54,6 +54,15 @@ func (gui *Gui) handleEditorKeypress(textArea *gocui.TextArea, key gocui.Key, ch
 		return false
 	}
 
+	// Macros don't support modifiers, so alt+backspace etc. aren't recorded
+	if mod == gocui.ModNone {
+		if ch != 0 {
+			gui.helpers.Macro.RecordKey(ch)
+		} else {
+			gui.helpers.Macro.RecordKey(key)
+		}
+	}
+
 	return true
 }
 
@@ -72,6 +81,17 @@ func (gui *Gui) commitDescriptionEditor(v *gocui.View, key gocui.Key, ch rune, m
 	return matched
 }
 
+func (gui *Gui) hunkEditorEditor(v *gocui.View, key gocui.Key, ch rune, mod gocui.Modifier) bool {
+	matched := gui.handleEditorKeypress(v.TextArea, key, ch, mod, true)
+	v.RenderTextArea()
+
+	if matched {
+		gui.helpers.HunkEditor.OnContentChanged()
+	}
+
+	return matched
+}
+
 func (gui *Gui) promptEditor(v *gocui.View, key gocui.Key, ch rune, mod gocui.Modifier) bool {
 	matched := gui.handleEditorKeypress(v.TextArea, key, ch, mod, false)
 
@@ -30,6 +30,10 @@ func (gui *Gui) handleCreateExtrasMenuPanel() error {
 				Label:   gui.c.Tr.FocusCommandLog,
 				OnPress: gui.handleFocusCommandLog,
 			},
+			{
+				Label:   gui.c.Tr.OpenCommandHistory,
+				OnPress: gui.handleOpenCommandHistory,
+			},
 		},
 	})
 }
@@ -42,6 +46,16 @@ func (gui *Gui) handleFocusCommandLog() error {
 	return nil
 }
 
+func (gui *Gui) handleOpenCommandHistory() error {
+	commandLogEntries := gui.State.Contexts.CommandLogEntries
+	commandLogEntries.SetEntries(gui.State.Model.CommandLog.Entries())
+	commandLogEntries.ClearFilter()
+	commandLogEntries.SetSelection(0)
+	gui.c.Context().Push(commandLogEntries, types.OnFocusOpts{})
+	gui.c.PostRefreshUpdate(commandLogEntries)
+	return nil
+}
+
 func (gui *Gui) scrollUpExtra() error {
 	gui.Views.Extras.Autoscroll = false
 
@@ -37,6 +37,10 @@ func (self *CollapsedPaths) ToggleCollapsed(path string) {
 	}
 }
 
+func (self *CollapsedPaths) ToSlice() []string {
+	return self.collapsedPaths.ToSlice()
+}
+
 func (self *CollapsedPaths) ExpandAll() {
 	// Could be cleaner if Set had a Clear() method...
 	self.collapsedPaths.RemoveSlice(self.collapsedPaths.ToSlice())
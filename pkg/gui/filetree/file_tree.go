@@ -42,6 +42,8 @@ type IFileTree interface {
 	FilterFiles(test func(*models.File) bool) []*models.File
 	SetStatusFilter(filter FileTreeDisplayFilter)
 	ForceShowUntracked() bool
+	UntrackedFilesMode() string
+	SetUntrackedFilesMode(mode string)
 	Get(index int) *FileNode
 	GetFile(path string) *models.File
 	GetAllItems() []*FileNode
@@ -57,6 +59,9 @@ type FileTree struct {
 	common         *common.Common
 	filter         FileTreeDisplayFilter
 	collapsedPaths *CollapsedPaths
+	// which untracked files to show, if chosen by the user; one of "no",
+	// "normal" or "all", or empty to use the config
+	untrackedFilesMode string
 }
 
 var _ IFileTree = &FileTree{}
@@ -104,6 +109,14 @@ func (self *FileTree) ForceShowUntracked() bool {
 	return self.filter == DisplayUntracked
 }
 
+func (self *FileTree) UntrackedFilesMode() string {
+	return self.untrackedFilesMode
+}
+
+func (self *FileTree) SetUntrackedFilesMode(mode string) {
+	self.untrackedFilesMode = mode
+}
+
 func (self *FileTree) FilterFiles(test func(*models.File) bool) []*models.File {
 	return lo.Filter(self.getFiles(), func(file *models.File, _ int) bool { return test(file) })
 }
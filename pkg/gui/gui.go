@@ -12,6 +12,8 @@ import (
 	"sort"
 	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazycore/pkg/boxlayout"
@@ -37,12 +39,14 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/gui/presentation/graph"
 	"github.com/jesseduffield/lazygit/pkg/gui/presentation/icons"
 	"github.com/jesseduffield/lazygit/pkg/gui/services/custom_commands"
+	"github.com/jesseduffield/lazygit/pkg/gui/services/plugins"
 	"github.com/jesseduffield/lazygit/pkg/gui/status"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/i18n"
 	"github.com/jesseduffield/lazygit/pkg/integration/components"
 	integrationTypes "github.com/jesseduffield/lazygit/pkg/integration/types"
+	"github.com/jesseduffield/lazygit/pkg/remote"
 	"github.com/jesseduffield/lazygit/pkg/tasks"
 	"github.com/jesseduffield/lazygit/pkg/theme"
 	"github.com/jesseduffield/lazygit/pkg/updates"
@@ -73,6 +77,7 @@ type Gui struct {
 	pagerConfig *config.PagerConfig
 
 	CustomCommandsClient *custom_commands.Client
+	PluginsClient        *plugins.Client
 	ActionHookManager    *actionhooks.Manager
 	actionHookState      actionHookState
 
@@ -112,6 +117,10 @@ type Gui struct {
 	// the extras window contains things like the command log
 	ShowExtrasWindow bool
 
+	// whether the staging and custom patch views show the old and new versions
+	// side by side; can be toggled for the current session
+	SideBySideStagingView bool
+
 	PopupHandler types.IPopupHandler
 
 	IsRefreshingFiles bool
@@ -126,6 +135,10 @@ type Gui struct {
 	itemOperations      map[string]types.ItemOperation
 	itemOperationsMutex deadlock.Mutex
 
+	// the number of workers that are currently busy; macro replay waits for
+	// this to drop to zero before replaying the next key
+	runningWorkers atomic.Int32
+
 	PrevLayout PrevLayout
 
 	// this is the initial dir we are in upon opening lazygit. We hold onto this
@@ -137,6 +150,10 @@ type Gui struct {
 	InitialDir string
 
 	BackgroundRoutineMgr *BackgroundRoutineMgr
+
+	// the server of the remote-control socket; nil unless lazygit was started
+	// with --listen
+	remoteServer *remote.Server
 	// for accessing the gui's state from outside this package
 	stateAccessor *StateAccessor
 
@@ -194,6 +211,14 @@ func (self *StateAccessor) SetShowExtrasWindow(value bool) {
 	self.gui.ShowExtrasWindow = value
 }
 
+func (self *StateAccessor) GetSideBySideStagingView() bool {
+	return self.gui.SideBySideStagingView
+}
+
+func (self *StateAccessor) SetSideBySideStagingView(value bool) {
+	self.gui.SideBySideStagingView = value
+}
+
 func (self *StateAccessor) GetRetainOriginalDir() bool {
 	return self.gui.RetainOriginalDir
 }
@@ -256,6 +281,10 @@ type GuiRepoState struct {
 
 	ScreenMode types.ScreenMode
 
+	// IDs of the items to select once the models have been loaded, by context
+	// key; see Gui.restoreSession
+	RestoredSelections *utils.ThreadSafeMap[types.ContextKey, string]
+
 	CurrentPopupOpts *types.CreatePopupPanelOpts
 }
 
@@ -310,6 +339,8 @@ func (self *GuiRepoState) GetSplitMainPanel() bool {
 }
 
 func (gui *Gui) onNewRepo(startArgs appTypes.StartArgs, contextKey types.ContextKey) error {
+	gui.saveSession()
+
 	var err error
 	gui.git, err = commands.NewGitCommand(
 		gui.Common,
@@ -336,6 +367,11 @@ func (gui *Gui) onNewRepo(startArgs appTypes.StartArgs, contextKey types.Context
 
 	gui.resetHelpersAndControllers()
 
+	if err := gui.PluginsClient.Load(); err != nil {
+		// the gui isn't running yet, so we can't show the error right away
+		gui.c.OnUIThread(func() error { return err })
+	}
+
 	if err := gui.resetKeybindings(); err != nil {
 		return err
 	}
@@ -480,6 +516,7 @@ func (gui *Gui) checkForChangedConfigsThatDontAutoReload(oldConfig *config.UserC
 		"Git.AutoRefresh",
 		"Refresher.RefreshInterval",
 		"Refresher.FetchInterval",
+		"Workspace.RefreshInterval",
 		"Update.Method",
 		"Update.Days",
 	}
@@ -572,6 +609,7 @@ func (gui *Gui) resetState(startArgs appTypes.StartArgs) types.Context {
 			Authors:               map[string]*models.Author{},
 			MainBranches:          git_commands.NewMainBranches(gui.c.Common, gui.os.Cmd),
 			HashPool:              &utils.StringPool{},
+			CommandLog:            gui.newCommandLogStore(worktreePath),
 		},
 		Modes: &types.Modes{
 			Filtering:        filtering.New(startArgs.FilterPath, ""),
@@ -581,14 +619,19 @@ func (gui *Gui) resetState(startArgs appTypes.StartArgs) types.Context {
 		},
 		ScreenMode: initialScreenMode,
 		// TODO: only use contexts from context manager
-		ContextMgr:        NewContextMgr(gui, contextTree),
-		Contexts:          contextTree,
-		WindowViewNameMap: initialWindowViewNameMap(contextTree),
-		SearchState:       types.NewSearchState(),
+		ContextMgr:         NewContextMgr(gui, contextTree),
+		Contexts:           contextTree,
+		WindowViewNameMap:  initialWindowViewNameMap(contextTree),
+		SearchState:        types.NewSearchState(),
+		RestoredSelections: utils.NewThreadSafeMap[types.ContextKey, string](),
 	}
 
 	gui.RepoStateMap[Repo(worktreePath)] = gui.State
 
+	if restoredContext := gui.restoreSession(startArgs); restoredContext != nil {
+		return restoredContext
+	}
+
 	return initialContext(contextTree, startArgs)
 }
 
@@ -687,6 +730,8 @@ func NewGui(
 		// real value after loading the user config:
 		ShowExtrasWindow: true,
 
+		SideBySideStagingView: cmn.UserConfig().Gui.SideBySideStagingView,
+
 		InitialDir:       initialDir,
 		afterLayoutFuncs: make(chan func() error, 1000),
 
@@ -719,6 +764,7 @@ func NewGui(
 	guiIO := oscommands.NewGuiIO(
 		cmn.Log,
 		gui.LogCommand,
+		gui.logCmdObj,
 		gui.getCmdWriter,
 		credentialsHelper.PromptUserForCredential,
 	)
@@ -819,6 +865,13 @@ func (gui *Gui) viewTabMap() map[string][]context.TabView {
 		},
 	}
 
+	if gui.c.UserConfig().Workspace.IsEnabled() {
+		result["files"] = append(result["files"], context.TabView{
+			Tab:      gui.c.Tr.WorkspaceTitle,
+			ViewName: "workspace",
+		})
+	}
+
 	return result
 }
 
@@ -861,6 +914,10 @@ func (gui *Gui) Run(startArgs appTypes.StartArgs) error {
 
 	gui.BackgroundRoutineMgr.startBackgroundRoutines()
 
+	if gui.remoteServer != nil {
+		go utils.Safe(gui.remoteServer.Serve)
+	}
+
 	gui.Helpers().SuspendResume.InstallResumeSignalHandler()
 
 	gui.c.Log.Info("starting main loop")
@@ -882,6 +939,8 @@ func (gui *Gui) RunAndHandleError(startArgs appTypes.StartArgs) error {
 			close(gui.stopChan)
 
 			if errors.Is(err, gocui.ErrQuit) {
+				gui.saveSession()
+
 				if gui.c.State().GetRetainOriginalDir() {
 					if err := gui.helpers.RecordDirectory.RecordDirectory(gui.InitialDir); err != nil {
 						return err
@@ -955,7 +1014,8 @@ func (gui *Gui) runSubprocessWithSuspense(subprocess *oscommands.CmdObj) (bool,
 }
 
 func (gui *Gui) runSubprocess(cmdObj *oscommands.CmdObj) error {
-	gui.LogCommand(cmdObj.ToString(), true)
+	onDone := gui.logSubprocessCmdObj(cmdObj)
+	start := time.Now()
 
 	subprocess := cmdObj.GetCmd()
 	subprocess.Stdout = os.Stdout
@@ -965,6 +1025,8 @@ func (gui *Gui) runSubprocess(cmdObj *oscommands.CmdObj) error {
 	fmt.Fprintf(os.Stdout, "\n%s\n\n", style.FgBlue.Sprint("+ "+strings.Join(subprocess.Args, " ")))
 
 	err := subprocess.Run()
+	// stderr went to the terminal, so we don't have it
+	onDone(oscommands.NewCmdResult(start, err, ""))
 
 	subprocess.Stdout = io.Discard
 	subprocess.Stderr = io.Discard
@@ -987,6 +1049,12 @@ func (gui *Gui) loadNewRepo() error {
 	}
 
 	gui.c.Refresh(types.RefreshOptions{Mode: types.ASYNC})
+	// Loading the workspace runs git in every one of its repos, so it is not
+	// part of the full refresh above; apart from here it is only refreshed in
+	// the background and after actions on the workspace.
+	if gui.c.UserConfig().Workspace.IsEnabled() {
+		gui.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.WORKSPACE}, Mode: types.ASYNC})
+	}
 
 	if err := gui.os.UpdateWindowTitle(); err != nil {
 		return err
@@ -1109,7 +1177,48 @@ func (gui *Gui) onUIThread(f func() error) {
 }
 
 func (gui *Gui) onWorker(f func(gocui.Task) error) {
-	gui.g.OnWorker(f)
+	// incrementing here rather than in the worker so that the work counts as
+	// pending right away
+	gui.runningWorkers.Add(1)
+	gui.g.OnWorker(func(task gocui.Task) error {
+		workerTask := &workerTask{Task: task, runningWorkers: &gui.runningWorkers}
+		defer workerTask.done()
+		return f(workerTask)
+	})
+}
+
+func (gui *Gui) hasRunningWorkers() bool {
+	return gui.runningWorkers.Load() > 0
+}
+
+// A worker that is paused (e.g. while waiting for the user to enter a
+// password) doesn't count as running
+type workerTask struct {
+	gocui.Task
+	runningWorkers *atomic.Int32
+	paused         bool
+}
+
+func (self *workerTask) Pause() {
+	if !self.paused {
+		self.paused = true
+		self.runningWorkers.Add(-1)
+	}
+	self.Task.Pause()
+}
+
+func (self *workerTask) Continue() {
+	self.Task.Continue()
+	if self.paused {
+		self.paused = false
+		self.runningWorkers.Add(1)
+	}
+}
+
+func (self *workerTask) done() {
+	if !self.paused {
+		self.runningWorkers.Add(-1)
+	}
 }
 
 func (gui *Gui) getWindowDimensions(informationStr string, appStatus string) map[string]boxlayout.Dimensions {
@@ -191,6 +191,12 @@ func (self *guiCommon) RunningIntegrationTest() bool {
 	return self.gui.integrationTest != nil
 }
 
+func (self *guiCommon) PublishRemoteEvent(event string, data any) {
+	if self.gui.remoteServer != nil {
+		self.gui.remoteServer.Publish(event, data)
+	}
+}
+
 func (self *guiCommon) InDemo() bool {
 	return self.gui.integrationTest != nil && self.gui.integrationTest.IsDemo()
 }
@@ -46,7 +46,7 @@ func (self *GuiDriver) PressKey(keyStr string) {
 		0,
 	)
 
-	self.waitTillIdle()
+	self.WaitTillIdle()
 }
 
 func (self *GuiDriver) Click(x, y int) {
@@ -56,16 +56,16 @@ func (self *GuiDriver) Click(x, y int) {
 		tcell.NewEventMouse(x, y, tcell.ButtonPrimary, 0),
 		0,
 	)
-	self.waitTillIdle()
+	self.WaitTillIdle()
 	self.gui.g.ReplayedEvents.MouseEvents <- gocui.NewTcellMouseEventWrapper(
 		tcell.NewEventMouse(x, y, tcell.ButtonNone, 0),
 		0,
 	)
-	self.waitTillIdle()
+	self.WaitTillIdle()
 }
 
 // wait until lazygit is idle (i.e. all processing is done) before continuing
-func (self *GuiDriver) waitTillIdle() {
+func (self *GuiDriver) WaitTillIdle() {
 	<-self.isIdleChan
 }
 
@@ -151,12 +151,12 @@ func (self *GuiDriver) View(viewName string) *gocui.View {
 
 func (self *GuiDriver) SetCaption(caption string) {
 	self.gui.setCaption(caption)
-	self.waitTillIdle()
+	self.WaitTillIdle()
 }
 
 func (self *GuiDriver) SetCaptionPrefix(prefix string) {
 	self.gui.setCaptionPrefix(prefix)
-	self.waitTillIdle()
+	self.WaitTillIdle()
 }
 
 func (self *GuiDriver) NextToast() *string {
@@ -2,6 +2,7 @@ package gui
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/jesseduffield/lazygit/pkg/constants"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
@@ -9,17 +10,29 @@ import (
 )
 
 func (gui *Gui) informationStr() string {
+	if gui.helpers.Macro.IsRecording() {
+		return style.FgRed.Sprintf(gui.c.Tr.RecordingMacro, gui.helpers.Macro.RecordingRegister())
+	}
+
 	if activeMode, ok := gui.helpers.Mode.GetActiveMode(); ok {
 		return activeMode.InfoLabel()
 	}
 
+	// make it visible when refreshing the files is slow, so that you know to
+	// look at the git.status configs
+	slowStatus := ""
+	if duration, ok := gui.helpers.Refresh.SlowStatusDuration(); ok {
+		slowStatus = style.FgYellow.Sprint(utils.ResolvePlaceholderString(gui.c.Tr.SlowStatus,
+			map[string]string{"duration": duration.Round(10 * time.Millisecond).String()})) + " "
+	}
+
 	if gui.g.Mouse {
 		donate := style.FgMagenta.Sprint(style.PrintHyperlink(gui.c.Tr.Donate, constants.Links.Donate))
 		askQuestion := style.FgYellow.Sprint(style.PrintHyperlink(gui.c.Tr.AskQuestion, constants.Links.Discussions))
-		return fmt.Sprintf("%s %s %s", donate, askQuestion, gui.Config.GetVersion())
+		return fmt.Sprintf("%s%s %s %s", slowStatus, donate, askQuestion, gui.Config.GetVersion())
 	}
 
-	return gui.Config.GetVersion()
+	return slowStatus + gui.Config.GetVersion()
 }
 
 func (gui *Gui) handleInfoClick() error {
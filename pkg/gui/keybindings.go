@@ -438,6 +438,9 @@ func (gui *Gui) GetInitialKeybindingsWithCustomCommands() ([]*types.Binding, []*
 	}
 	// prepending because we want to give our custom keybindings precedence over default keybindings
 	bindings = append(customBindings, bindings...)
+	// plugin keybindings come first of all, so that a plugin that is meant to
+	// replace a custom command can simply bind the same key
+	bindings = append(gui.PluginsClient.GetPluginKeybindings(), bindings...)
 	return bindings, mouseBindings
 }
 
@@ -549,6 +552,11 @@ func (gui *Gui) callKeybindingHandler(binding *types.Binding) error {
 		}
 	}
 
+	if gui.helpers.Macro.IsRecording() {
+		// deferring so that the key that stops the recording isn't recorded
+		defer gui.helpers.Macro.RecordKey(binding.Key)
+	}
+
 	keyLabel := keybindings.LabelFromKey(binding.Key)
 
 	var execution *actionhooks.Execution
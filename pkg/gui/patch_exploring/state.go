@@ -23,10 +23,16 @@ type State struct {
 	diff          string
 	patch         *patch.Patch
 	selectMode    selectMode
+	// whether the view was wrapping lines, or showing the patch side by side,
+	// when we computed the line indices below
+	wrap       bool
+	sideBySide bool
 
 	// Array of indices of the wrapped lines indexed by a patch line index
 	viewLineIndices []int
-	// Array of indices of the original patch lines indexed by a wrapped view line index
+	// Array of indices of the original patch lines indexed by a wrapped view line
+	// index. In the side-by-side view a line can show two patch lines, in which
+	// case this is the first of them.
 	patchLineIndices []int
 
 	// whether the user has switched to hunk mode manually; if hunk mode is on
@@ -45,8 +51,8 @@ const (
 	HUNK
 )
 
-func NewState(diff string, selectedLineIdx int, view *gocui.View, oldState *State, useHunkModeByDefault bool) *State {
-	if oldState != nil && diff == oldState.diff && selectedLineIdx == -1 {
+func NewState(diff string, selectedLineIdx int, view *gocui.View, oldState *State, useHunkModeByDefault bool, sideBySide bool) *State {
+	if oldState != nil && diff == oldState.diff && selectedLineIdx == -1 && view.Wrap == oldState.wrap && sideBySide == oldState.sideBySide {
 		// if we're here then we can return the old state. If selectedLineIdx was not -1
 		// then that would mean we were trying to click and potentially drag a range, which
 		// is why in that case we continue below
@@ -60,6 +66,9 @@ func NewState(diff string, selectedLineIdx int, view *gocui.View, oldState *Stat
 	}
 
 	viewLineIndices, patchLineIndices := wrapPatchLines(diff, view)
+	if sideBySide {
+		viewLineIndices, patchLineIndices = patch.SideBySideLineIndices()
+	}
 
 	rangeStartLineIdx := 0
 	if oldState != nil {
@@ -101,6 +110,8 @@ func NewState(diff string, selectedLineIdx int, view *gocui.View, oldState *Stat
 		rangeStartLineIdx:   rangeStartLineIdx,
 		rangeIsSticky:       false,
 		diff:                diff,
+		wrap:                view.Wrap,
+		sideBySide:          sideBySide,
 		viewLineIndices:     viewLineIndices,
 		patchLineIndices:    patchLineIndices,
 		userEnabledHunkMode: userEnabledHunkMode,
@@ -108,7 +119,7 @@ func NewState(diff string, selectedLineIdx int, view *gocui.View, oldState *Stat
 }
 
 func (s *State) OnViewWidthChanged(view *gocui.View) {
-	if !view.Wrap {
+	if !view.Wrap || s.sideBySide {
 		return
 	}
 
@@ -324,6 +335,12 @@ func (s *State) selectionRangeForCurrentBlockOfChanges() (int, int) {
 	}
 
 	viewStart, viewEnd := s.viewLineIndices[patchStart], s.viewLineIndices[patchEnd]
+	// In the side-by-side view the first and last patch lines of the block
+	// aren't necessarily shown in its first and last view lines
+	for _, viewLineIdx := range s.viewLineIndices[patchStart : patchEnd+1] {
+		viewStart = min(viewStart, viewLineIdx)
+		viewEnd = max(viewEnd, viewLineIdx)
+	}
 
 	// Increase viewEnd in case the last patch line is wrapped to more than one view line.
 	for viewEnd < len(s.patchLineIndices)-1 && s.patchLineIndices[viewEnd] == s.patchLineIndices[viewEnd+1] {
@@ -351,22 +368,31 @@ func (s *State) SelectedViewRange() (int, int) {
 }
 
 func (s *State) SelectedPatchRange() (int, int) {
-	start, end := s.SelectedViewRange()
-	return s.patchLineIndices[start], s.patchLineIndices[end]
+	indices := s.SelectedPatchLineIndices()
+	return lo.Min(indices), lo.Max(indices)
+}
+
+// Returns the indices of the patch lines shown in the selected view lines. In
+// the side-by-side view these are not necessarily contiguous, because a view
+// line can show a deleted line together with the added line next to it.
+func (s *State) SelectedPatchLineIndices() []int {
+	viewStart, viewEnd := s.SelectedViewRange()
+	if !s.sideBySide {
+		return patch.ExpandRange(s.patchLineIndices[viewStart], s.patchLineIndices[viewEnd])
+	}
+
+	return lo.Filter(lo.Range(len(s.viewLineIndices)), func(patchLineIdx int, _ int) bool {
+		viewLineIdx := s.viewLineIndices[patchLineIdx]
+		return viewLineIdx >= viewStart && viewLineIdx <= viewEnd
+	})
 }
 
 // Returns the line indices of the selected patch range that are changes (i.e. additions or deletions)
 func (s *State) LineIndicesOfAddedOrDeletedLinesInSelectedPatchRange() []int {
-	viewStart, viewEnd := s.SelectedViewRange()
-	patchStart, patchEnd := s.patchLineIndices[viewStart], s.patchLineIndices[viewEnd]
 	lines := s.patch.Lines()
-	indices := []int{}
-	for i := patchStart; i <= patchEnd; i++ {
-		if lines[i].IsChange() {
-			indices = append(indices, i)
-		}
-	}
-	return indices
+	return lo.Filter(s.SelectedPatchLineIndices(), func(patchLineIdx int, _ int) bool {
+		return lines[patchLineIdx].IsChange()
+	})
 }
 
 func (s *State) CurrentLineNumber() int {
@@ -378,16 +404,19 @@ func (s *State) AdjustSelectedLineIdx(change int) {
 	s.SelectLine(s.selectedLineIdx + change)
 }
 
-func (s *State) RenderForLineIndices(includedLineIndices []int) string {
-	includedLineIndicesSet := set.NewFromSlice(includedLineIndices)
-	return s.patch.FormatView(patch.FormatViewOpts{
-		IncLineIndices: includedLineIndicesSet,
-	})
+func (s *State) RenderForLineIndices(includedLineIndices []int, opts patch.FormatViewOpts) string {
+	opts.IncLineIndices = set.NewFromSlice(includedLineIndices)
+	return s.patch.FormatView(opts)
 }
 
 func (s *State) PlainRenderSelected() string {
-	firstLineIdx, lastLineIdx := s.SelectedPatchRange()
-	return s.patch.FormatRangePlain(firstLineIdx, lastLineIdx)
+	lines := s.patch.Lines()
+	return strings.Join(
+		lo.Map(s.SelectedPatchLineIndices(), func(patchLineIdx int, _ int) string {
+			return lines[patchLineIdx].Content + "\n"
+		}),
+		"",
+	)
 }
 
 func (s *State) SelectBottom() {
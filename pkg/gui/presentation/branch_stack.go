@@ -0,0 +1,32 @@
+package presentation
+
+import (
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+)
+
+// Renders a stack of branches as a tree below the branch it is based on, e.g.
+//
+//	master
+//	└─ feature-a
+//	   ├─ feature-b
+//	   └─ feature-c
+func GetBranchStackTreeString(root *models.BranchStackNode, baseBranchName string) string {
+	lines := []string{baseBranchName}
+	lines = appendBranchStackLines(lines, root, "", true)
+	return strings.Join(lines, "\n")
+}
+
+func appendBranchStackLines(lines []string, node *models.BranchStackNode, indent string, isLast bool) []string {
+	connector, childIndent := "├─ ", "│  "
+	if isLast {
+		connector, childIndent = "└─ ", "   "
+	}
+
+	lines = append(lines, indent+connector+node.BranchName)
+	for i, child := range node.Children {
+		lines = appendBranchStackLines(lines, child, indent+childIndent, i == len(node.Children)-1)
+	}
+	return lines
+}
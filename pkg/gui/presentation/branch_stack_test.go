@@ -0,0 +1,24 @@
+package presentation
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetBranchStackTreeString(t *testing.T) {
+	root := &models.BranchStackNode{BranchName: "feature-a"}
+	b := &models.BranchStackNode{BranchName: "feature-b", Parent: root}
+	c := &models.BranchStackNode{BranchName: "feature-c", Parent: b}
+	d := &models.BranchStackNode{BranchName: "feature-d", Parent: root}
+	root.Children = []*models.BranchStackNode{b, d}
+	b.Children = []*models.BranchStackNode{c}
+
+	expected := "master\n" +
+		"└─ feature-a\n" +
+		"   ├─ feature-b\n" +
+		"   │  └─ feature-c\n" +
+		"   └─ feature-d"
+	assert.Equal(t, expected, GetBranchStackTreeString(root, "master"))
+}
@@ -0,0 +1,41 @@
+package presentation
+
+import (
+	"time"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/theme"
+	"github.com/samber/lo"
+)
+
+func GetCommandLogEntryDisplayStrings(entries []*models.CommandLogEntry) [][]string {
+	return lo.Map(entries, func(entry *models.CommandLogEntry, _ int) []string {
+		return getCommandLogEntryDisplayStrings(entry)
+	})
+}
+
+func getCommandLogEntryDisplayStrings(entry *models.CommandLogEntry) []string {
+	commandColor := theme.DefaultTextColor
+	if !entry.IsCommandLine {
+		// same as in the command log view
+		commandColor = style.FgMagenta
+	}
+
+	duration := ""
+	exitCodeColor := style.FgGreen
+	if entry.Finished {
+		duration = entry.Duration.Round(time.Millisecond).String()
+		if entry.Failed() {
+			exitCodeColor = style.FgRed
+		}
+	}
+
+	return []string{
+		style.FgBlue.Sprint(entry.Time.Format(time.DateTime)),
+		style.FgYellow.Sprint(entry.Action),
+		commandColor.Sprint(entry.Command),
+		style.FgCyan.Sprint(duration),
+		exitCodeColor.Sprint(entry.ExitCodeString()),
+	}
+}
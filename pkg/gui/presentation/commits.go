@@ -2,6 +2,7 @@ package presentation
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -29,7 +30,11 @@ type pipeSetCacheKey struct {
 
 var (
 	pipeSetCache = make(map[pipeSetCacheKey][][]graph.Pipe)
-	mutex        deadlock.Mutex
+	// the commits and pipe sets that we computed most recently, so that we
+	// can reuse them when new commits are added on top
+	lastPipeSetCommits []*models.Commit
+	lastPipeSets       [][]graph.Pipe
+	mutex              deadlock.Mutex
 )
 
 type bisectBounds struct {
@@ -260,13 +265,47 @@ func loadPipesets(commits []*models.Commit) [][]graph.Pipe {
 		getStyle := func(commit *models.Commit) *style.TextStyle {
 			return authors.AuthorStyle(commit.AuthorName)
 		}
-		pipeSets = graph.GetPipeSets(commits, getStyle)
+		if reuseFrom, reusable := lastPipeSetsOffset(commits); reusable > 0 {
+			pipeSets = graph.GetPipeSetsReusing(commits, getStyle, reuseFrom, lastPipeSets[:reusable])
+		} else {
+			pipeSets = graph.GetPipeSets(commits, getStyle)
+		}
 		pipeSetCache[cacheKey] = pipeSets
+		lastPipeSetCommits = commits
+		lastPipeSets = pipeSets
 	}
 
 	return pipeSets
 }
 
+// If the commits that we computed pipe sets for most recently reappear further
+// down in the given commits, returns the index at which they start, and how
+// many of them there are (some might have dropped off the end because of the
+// commit limit, or there might be more commits now). Returns zero for the
+// latter if they don't.
+func lastPipeSetsOffset(commits []*models.Commit) (int, int) {
+	if len(lastPipeSetCommits) == 0 {
+		return 0, 0
+	}
+
+	reuseFrom := slices.IndexFunc(commits, func(commit *models.Commit) bool {
+		return commit.HashPtr() == lastPipeSetCommits[0].HashPtr()
+	})
+	if reuseFrom <= 0 {
+		return 0, 0
+	}
+
+	reusable := min(len(commits)-reuseFrom, len(lastPipeSetCommits))
+	for i, commit := range commits[reuseFrom : reuseFrom+reusable] {
+		if commit.HashPtr() != lastPipeSetCommits[i].HashPtr() ||
+			commit.Divergence != lastPipeSetCommits[i].Divergence {
+			return 0, 0
+		}
+	}
+
+	return reuseFrom, reusable
+}
+
 // similar to the git_commands.BisectStatus but more gui-focused
 type BisectStatus int
 
@@ -493,6 +532,9 @@ func getHashColor(
 		hashColor = style.FgBlue
 	case models.StatusReflog:
 		hashColor = style.FgBlue
+	case models.StatusOtherBranch:
+		// dimmed, to tell them apart from the commits of the checked-out branch
+		hashColor = style.FgBlackLighter
 	default:
 	}
 
@@ -158,7 +158,8 @@ func getFileLine(
 
 	isSubmodule := file != nil && file.IsSubmodule(submoduleConfigs)
 	isLinkedWorktree := file != nil && file.IsWorktree
-	isDirectory := file == nil
+	isUntrackedDirectory := file != nil && file.IsUntrackedDirectory
+	isDirectory := file == nil || isUntrackedDirectory
 
 	if showFileIcons {
 		icon := icons.IconForFile(name, isSubmodule, isLinkedWorktree, isDirectory, customIconsConfig)
@@ -168,6 +169,11 @@ func getFileLine(
 
 	output += nameColor.Sprint(utils.EscapeSpecialChars(name))
 
+	// there's no arrow to show that it's a directory, since it can't be expanded
+	if isUntrackedDirectory {
+		output += nameColor.Sprint("/")
+	}
+
 	if isSubmodule {
 		output += theme.DefaultTextColor.Sprint(" (submodule)")
 	}
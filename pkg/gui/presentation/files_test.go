@@ -60,6 +60,21 @@ func TestRenderFileTree(t *testing.T) {
 				"   M test4",
 			},
 		},
+		{
+			name: "untracked directory",
+			files: []*models.File{
+				{Path: "dir/build", ShortStatus: "??", HasUnstagedChanges: true, IsUntrackedDirectory: true},
+				{Path: "dir/file", ShortStatus: "??", HasUnstagedChanges: true},
+			},
+			showRootItem: true,
+			expected: toStringSlice(
+				`
+▼ dir
+  ?? build/
+  ?? file
+`,
+			),
+		},
 		{
 			name: "big example",
 			files: []*models.File{
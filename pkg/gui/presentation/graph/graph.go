@@ -69,6 +69,64 @@ func GetPipeSets(commits []*models.Commit, getStyle func(c *models.Commit) *styl
 	})
 }
 
+// Like GetPipeSets, but for commits whose tail, starting at reuseFrom, was
+// rendered before with the given pipe sets; this happens when new commits are
+// added on top of a branch. We compute the pipe sets of the new commits and of
+// the first commit of the tail (its incoming pipes come from a different
+// commit now), and if the lanes leading out of that commit are the same as
+// before, the following pipe sets can't be any different, so we reuse them.
+// Otherwise, or if the previous pipe sets don't cover all commits, we compute
+// the remaining ones as usual.
+func GetPipeSetsReusing(commits []*models.Commit, getStyle func(c *models.Commit) *style.TextStyle, reuseFrom int, prevPipeSets [][]Pipe) [][]Pipe {
+	if reuseFrom <= 0 || reuseFrom >= len(commits) || len(prevPipeSets) == 0 {
+		return GetPipeSets(commits, getStyle)
+	}
+
+	pipeSets := make([][]Pipe, 0, len(commits))
+	pipes := []Pipe{{fromPos: 0, toPos: 0, fromHash: &StartCommitHash, toHash: commits[0].HashPtr(), kind: STARTS, style: &style.FgDefault}}
+	for _, commit := range commits[:reuseFrom+1] {
+		pipes = getNextPipes(pipes, commit, getStyle)
+		pipeSets = append(pipeSets, pipes)
+	}
+
+	next := reuseFrom + 1
+	if haveSameLanes(pipes, prevPipeSets[0]) {
+		reusable := min(len(prevPipeSets), len(commits)-reuseFrom)
+		pipeSets = append(pipeSets, prevPipeSets[1:reusable]...)
+		pipes = pipeSets[len(pipeSets)-1]
+		next = reuseFrom + reusable
+	}
+
+	for _, commit := range commits[next:] {
+		pipes = getNextPipes(pipes, commit, getStyle)
+		pipeSets = append(pipeSets, pipes)
+	}
+
+	return pipeSets
+}
+
+// Returns true if getNextPipes would produce the same result for the next
+// commit given either of the two pipe sets: it ignores the pipes that
+// terminate in the current commit, except for the positions they occupy. Styles
+// are compared by identity, since they come from a cache.
+func haveSameLanes(a []Pipe, b []Pipe) bool {
+	maxPos := func(pipes []Pipe) int16 {
+		return lo.MaxBy(pipes, func(p1 Pipe, p2 Pipe) bool { return p1.toPos > p2.toPos }).toPos
+	}
+	if maxPos(a) != maxPos(b) {
+		return false
+	}
+
+	isContinuing := func(pipe Pipe, _ int) bool { return pipe.kind != TERMINATES }
+	continuingA := lo.Filter(a, isContinuing)
+	continuingB := lo.Filter(b, isContinuing)
+
+	return slices.EqualFunc(continuingA, continuingB, func(p1 Pipe, p2 Pipe) bool {
+		return p1.fromPos == p2.fromPos && p1.toPos == p2.toPos && p1.kind == p2.kind &&
+			*p1.fromHash == *p2.fromHash && *p1.toHash == *p2.toHash && p1.style == p2.style
+	})
+}
+
 func RenderAux(pipeSets [][]Pipe, commits []*models.Commit, selectedCommitHashPtr *string) []string {
 	maxProcs := runtime.GOMAXPROCS(0)
 
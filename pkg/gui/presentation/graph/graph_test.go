@@ -550,6 +550,88 @@ func TestGetNextPipes(t *testing.T) {
 	}
 }
 
+func TestGetPipeSetsReusing(t *testing.T) {
+	hashPool := &utils.StringPool{}
+	getStyle := func(commit *models.Commit) *style.TextStyle { return &style.FgDefault }
+
+	prependCommits := func(commits []*models.Commit, count int, mergeParent string) []*models.Commit {
+		result := make([]*models.Commit, 0, count+len(commits))
+		for i := range count {
+			parents := []string{commits[0].Hash()}
+			if i < count-1 {
+				parents = []string{fmt.Sprintf("new%d", i+1)}
+			}
+			if i == 0 && mergeParent != "" {
+				parents = append(parents, mergeParent)
+			}
+			result = append(result, models.NewCommit(hashPool, models.NewCommitOpts{
+				Hash:    fmt.Sprintf("new%d", i),
+				Parents: parents,
+			}))
+		}
+		return append(result, commits...)
+	}
+
+	baseCommits := generateCommits(hashPool, 100)
+
+	tests := []struct {
+		name string
+		// the commits that were rendered before
+		prevCommits []*models.Commit
+		commits     []*models.Commit
+		reuseFrom   int
+		expectReuse bool
+	}{
+		{
+			name:        "one commit added",
+			prevCommits: baseCommits,
+			commits:     prependCommits(baseCommits, 1, ""),
+			reuseFrom:   1,
+			expectReuse: true,
+		},
+		{
+			name:        "several commits added",
+			prevCommits: baseCommits,
+			commits:     prependCommits(baseCommits, 5, ""),
+			reuseFrom:   5,
+			expectReuse: true,
+		},
+		{
+			name:        "merge commit added",
+			prevCommits: baseCommits,
+			commits:     prependCommits(baseCommits, 2, baseCommits[10].Hash()),
+			reuseFrom:   2,
+		},
+		{
+			name:        "more commits than were rendered before",
+			prevCommits: baseCommits[:50],
+			commits:     prependCommits(baseCommits, 3, ""),
+			reuseFrom:   3,
+			expectReuse: true,
+		},
+		{
+			name:        "fewer commits than were rendered before",
+			prevCommits: baseCommits,
+			commits:     prependCommits(baseCommits, 3, "")[:60],
+			reuseFrom:   3,
+			expectReuse: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			prevPipeSets := GetPipeSets(test.prevCommits, getStyle)
+			expected := GetPipeSets(test.commits, getStyle)
+
+			pipeSets := GetPipeSetsReusing(test.commits, getStyle, test.reuseFrom, prevPipeSets)
+			assert.Equal(t, expected, pipeSets)
+
+			reused := &pipeSets[test.reuseFrom+1][0] == &prevPipeSets[1][0]
+			assert.Equal(t, test.expectReuse, reused)
+		})
+	}
+}
+
 func BenchmarkRenderCommitGraph(b *testing.B) {
 	oldColorLevel := color.ForceSetColorLevel(terminfo.ColorLevelMillions)
 	defer color.ForceSetColorLevel(oldColorLevel)
@@ -566,6 +648,29 @@ func BenchmarkRenderCommitGraph(b *testing.B) {
 	}
 }
 
+func BenchmarkGetPipeSets(b *testing.B) {
+	hashPool := &utils.StringPool{}
+	commits := generateCommits(hashPool, 1000)
+	getStyle := func(commit *models.Commit) *style.TextStyle { return &style.FgDefault }
+
+	for b.Loop() {
+		GetPipeSets(commits, getStyle)
+	}
+}
+
+func BenchmarkGetPipeSetsReusing(b *testing.B) {
+	hashPool := &utils.StringPool{}
+	prevCommits := generateCommits(hashPool, 1000)
+	getStyle := func(commit *models.Commit) *style.TextStyle { return &style.FgDefault }
+	prevPipeSets := GetPipeSets(prevCommits, getStyle)
+	newCommit := models.NewCommit(hashPool, models.NewCommitOpts{Hash: "new", Parents: []string{prevCommits[0].Hash()}})
+	commits := append([]*models.Commit{newCommit}, prevCommits...)
+
+	for b.Loop() {
+		GetPipeSetsReusing(commits, getStyle, 1, prevPipeSets)
+	}
+}
+
 func generateCommits(hashPool *utils.StringPool, count int) []*models.Commit {
 	rnd := rand.New(rand.NewSource(1234))
 	pool := []*models.Commit{models.NewCommit(hashPool, models.NewCommitOpts{Hash: "a", AuthorName: "A"})}
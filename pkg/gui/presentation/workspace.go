@@ -0,0 +1,71 @@
+package presentation
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/i18n"
+	"github.com/jesseduffield/lazygit/pkg/theme"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+func GetWorkspaceRepoDisplayStrings(tr *i18n.TranslationSet, repos []*models.WorkspaceRepo) [][]string {
+	return lo.Map(repos, func(repo *models.WorkspaceRepo, _ int) []string {
+		return getWorkspaceRepoDisplayStrings(tr, repo)
+	})
+}
+
+func getWorkspaceRepoDisplayStrings(tr *i18n.TranslationSet, repo *models.WorkspaceRepo) []string {
+	current := ""
+	if repo.IsCurrent {
+		current = "  *"
+	}
+
+	if repo.Err != nil {
+		return []string{
+			style.FgGreen.Sprint(current),
+			style.FgRed.Sprint(repo.Name),
+			style.FgRed.Sprint(tr.WorkspaceRepoUnavailable),
+		}
+	}
+
+	branchColor := style.FgCyan
+	if repo.DetachedHead {
+		branchColor = style.FgYellow
+	}
+
+	status := []string{}
+	if repo.HasUpstream {
+		status = append(status, workspaceRepoSyncStatus(repo))
+	}
+	if repo.DirtyCount > 0 {
+		status = append(status, style.FgRed.Sprint(utils.ResolvePlaceholderString(tr.WorkspaceRepoDirtyCount,
+			map[string]string{"count": fmt.Sprint(repo.DirtyCount)})))
+	}
+	if repo.WorkingTreeState.Any() {
+		status = append(status, style.FgMagenta.Sprint(repo.WorkingTreeState.LowerCaseTitle(tr)))
+	}
+
+	return []string{
+		style.FgGreen.Sprint(current),
+		theme.DefaultTextColor.Sprint(repo.Name),
+		branchColor.Sprint(repo.Branch),
+		strings.Join(status, " "),
+	}
+}
+
+func workspaceRepoSyncStatus(repo *models.WorkspaceRepo) string {
+	switch {
+	case repo.Ahead == 0 && repo.Behind == 0:
+		return style.FgGreen.Sprint("✓")
+	case repo.Ahead > 0 && repo.Behind > 0:
+		return style.FgYellow.Sprintf("↓%d↑%d", repo.Behind, repo.Ahead)
+	case repo.Behind > 0:
+		return style.FgYellow.Sprintf("↓%d", repo.Behind)
+	default:
+		return style.FgYellow.Sprintf("↑%d", repo.Ahead)
+	}
+}
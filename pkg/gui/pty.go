@@ -50,7 +50,7 @@ func (gui *Gui) newPtyTask(view *gocui.View, cmd *exec.Cmd, prefix string) error
 
 	if pager == "" && externalDiffCommand == "" {
 		// if we're not using a custom pager we don't need to use a pty
-		return gui.newCmdTask(view, cmd, prefix)
+		return gui.newDiffCmdTask(view, cmd, prefix)
 	}
 
 	// Run the pty after layout so that it gets the correct size
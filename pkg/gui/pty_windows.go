@@ -13,5 +13,10 @@ func (gui *Gui) onResize() error {
 
 func (gui *Gui) newPtyTask(view *gocui.View, cmd *exec.Cmd, prefix string) error {
 	cmd.Env = append(cmd.Env, fmt.Sprintf("LAZYGIT_COLUMNS=%d", view.InnerWidth()))
+
+	pagerConfig := gui.stateAccessor.GetPagerConfig()
+	if pagerConfig.GetPagerCommand(view.InnerWidth()) == "" && pagerConfig.GetExternalDiffCommand() == "" {
+		return gui.newDiffCmdTask(view, cmd, prefix)
+	}
 	return gui.newCmdTask(view, cmd, prefix)
 }
@@ -0,0 +1,203 @@
+package gui
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/patch"
+	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/filetree"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/remote"
+	"github.com/samber/lo"
+)
+
+type remoteFocusParams struct {
+	// the key of a side context, e.g. 'files' or 'localBranches'
+	Context string `json:"context"`
+}
+
+type remoteFileParams struct {
+	// relative to the worktree, or absolute
+	Path string `json:"path"`
+	// line number in the working tree version of the file; only used by
+	// openDiff
+	Line int `json:"line"`
+}
+
+type remoteCommitParams struct {
+	// if empty, the commit message panel is opened instead of committing
+	Message string `json:"message"`
+}
+
+// Registers the commands of the remote-control socket with the given server,
+// which is served once the gui is running
+func (gui *Gui) AttachRemoteServer(server *remote.Server) {
+	gui.remoteServer = server
+
+	server.Handle("focus", remoteHandler(gui, gui.remoteFocus))
+	server.Handle("selectFile", remoteHandler(gui, gui.remoteSelectFile))
+	server.Handle("openDiff", remoteHandler(gui, gui.remoteOpenDiff))
+	server.Handle("commit", remoteHandler(gui, gui.remoteCommit))
+	server.Handle("refresh", remoteHandler(gui, gui.remoteRefresh))
+}
+
+// Wraps a command in a remote.Handler that unmarshals its params. Commands
+// are called from the goroutine of the client's connection; they must use
+// remoteOnUIThread for anything that touches the gui.
+func remoteHandler[T any](gui *Gui, command func(params T) (any, error)) remote.Handler {
+	return func(rawParams json.RawMessage) (any, error) {
+		var params T
+		if len(rawParams) > 0 {
+			if err := json.Unmarshal(rawParams, &params); err != nil {
+				return nil, remote.NewInvalidParamsError(err)
+			}
+		}
+
+		return command(params)
+	}
+}
+
+var errShuttingDown = errors.New("lazygit is shutting down")
+
+// Runs the given function on the UI thread and waits for it to complete. If
+// the gui stops before it gets to run the function, we stop waiting.
+func (gui *Gui) remoteOnUIThread(f func() error) error {
+	done := make(chan error, 1)
+	gui.c.OnUIThread(func() error {
+		done <- f()
+		return nil
+	})
+
+	select {
+	case err := <-done:
+		return err
+	case <-gui.stopChan:
+		return errShuttingDown
+	}
+}
+
+func (gui *Gui) remoteFocus(params remoteFocusParams) (any, error) {
+	return nil, gui.remoteOnUIThread(func() error {
+		if !lo.Contains(context.AllContextKeys, types.ContextKey(params.Context)) {
+			return remote.NewInvalidParamsError(fmt.Errorf("unknown context '%s'", params.Context))
+		}
+
+		ctx := gui.c.ContextForKey(types.ContextKey(params.Context))
+		if ctx.GetKind() != types.SIDE_CONTEXT {
+			return remote.NewInvalidParamsError(fmt.Errorf("context '%s' can't be focused", params.Context))
+		}
+
+		gui.c.Context().Push(ctx, types.OnFocusOpts{})
+		return nil
+	})
+}
+
+func (gui *Gui) remoteSelectFile(params remoteFileParams) (any, error) {
+	return nil, gui.remoteOnUIThread(func() error {
+		_, err := gui.remoteSelectFileInFilesPanel(params.Path)
+		return err
+	})
+}
+
+// Selects the file in the files panel and enters the staging view with the
+// given line selected
+func (gui *Gui) remoteOpenDiff(params remoteFileParams) (any, error) {
+	return nil, gui.remoteOnUIThread(func() error {
+		file, err := gui.remoteSelectFileInFilesPanel(params.Path)
+		if err != nil {
+			return err
+		}
+
+		if file.HasInlineMergeConflicts {
+			return fmt.Errorf("'%s' has merge conflicts", file.Path)
+		}
+
+		// show the unstaged changes if there are any, the staged ones otherwise
+		cached := !file.HasUnstagedChanges
+		diff := gui.git.WorkingTree.WorktreeFileDiff(file, true, cached)
+		lineIdx := patch.Parse(diff).LineIdxOfLineNumber(params.Line)
+
+		ctx := lo.Ternary[types.Context](cached, gui.c.Contexts().StagingSecondary, gui.c.Contexts().Staging)
+		gui.c.Context().Push(ctx, types.OnFocusOpts{
+			ClickedWindowName:  ctx.GetWindowName(),
+			ClickedViewLineIdx: lineIdx,
+		})
+		return nil
+	})
+}
+
+func (gui *Gui) remoteSelectFileInFilesPanel(path string) (*models.File, error) {
+	if filepath.IsAbs(path) {
+		relPath, err := filepath.Rel(gui.git.RepoPaths.WorktreePath(), path)
+		if err != nil {
+			return nil, remote.NewInvalidParamsError(err)
+		}
+		path = relPath
+	}
+	path = filepath.ToSlash(path)
+
+	filesContext := gui.c.Contexts().Files
+	treePath := filetree.InternalTreePathForFilePath(path, gui.c.UserConfig().Gui.ShowRootItemInFileTree)
+	filesContext.ExpandToPath(treePath)
+	filesContext.SetTree()
+	idx, ok := filesContext.GetIndexForPath(treePath)
+	if !ok {
+		return nil, fmt.Errorf("'%s' has no changes", path)
+	}
+
+	filesContext.SetSelection(idx)
+	gui.c.PostRefreshUpdate(filesContext)
+	gui.c.Context().Push(filesContext, types.OnFocusOpts{})
+
+	return filesContext.GetSelected().File, nil
+}
+
+// Commits the staged changes with the given message, or opens the commit
+// message panel if there is no message
+func (gui *Gui) remoteCommit(params remoteCommitParams) (any, error) {
+	if params.Message == "" {
+		return nil, gui.remoteOnUIThread(func() error {
+			return gui.helpers.WorkingTree.HandleCommitPress()
+		})
+	}
+
+	done := make(chan error, 1)
+	if err := gui.remoteOnUIThread(func() error {
+		if !gui.helpers.WorkingTree.AnyStagedFiles() {
+			return errors.New(gui.c.Tr.NoFilesStagedTitle)
+		}
+
+		gui.helpers.WorkingTree.CommitWithMessage(params.Message, func(err error) { done <- err })
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	select {
+	case err := <-done:
+		return nil, err
+	case <-gui.stopChan:
+		return nil, errShuttingDown
+	}
+}
+
+func (gui *Gui) remoteRefresh(struct{}) (any, error) {
+	done := make(chan struct{})
+	gui.c.OnWorker(func(gocui.Task) error {
+		gui.c.Refresh(types.RefreshOptions{Mode: types.SYNC})
+		close(done)
+		return nil
+	})
+
+	select {
+	case <-done:
+		return nil, nil
+	case <-gui.stopChan:
+		return nil, errShuttingDown
+	}
+}
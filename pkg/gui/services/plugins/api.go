@@ -0,0 +1,427 @@
+package plugins
+
+import (
+	"fmt"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
+	"go.starlark.net/starlark"
+	"go.starlark.net/starlarkstruct"
+)
+
+// The functions available to plugins through the predeclared 'lazygit' module.
+// Plugin handlers run in the background so that they can't block the UI; the
+// functions that change the UI (e.g. by opening popups) or read the selection
+// do so on the UI thread, and the model is read under the mutexes that guard
+// its refreshes. See docs/Plugins.md for their documentation.
+type api struct {
+	c       *helpers.HelperCommon
+	helpers *helpers.Helpers
+}
+
+func (self *api) module() *starlarkstruct.Module {
+	builtins := []*starlark.Builtin{
+		starlark.NewBuiltin("bind", bind),
+		starlark.NewBuiltin("add_menu_item", addMenuItem),
+
+		starlark.NewBuiltin("commits", self.commits),
+		starlark.NewBuiltin("branches", self.branches),
+		starlark.NewBuiltin("files", self.files),
+		starlark.NewBuiltin("selected_commit", self.selectedCommit),
+		starlark.NewBuiltin("selected_branch", self.selectedBranch),
+		starlark.NewBuiltin("selected_file", self.selectedFile),
+
+		starlark.NewBuiltin("git", self.git),
+		starlark.NewBuiltin("checkout", self.checkout),
+		starlark.NewBuiltin("new_branch", self.newBranch),
+		starlark.NewBuiltin("stage", self.stage),
+		starlark.NewBuiltin("unstage", self.unstage),
+		starlark.NewBuiltin("commit", self.commit),
+		starlark.NewBuiltin("refresh", self.refresh),
+
+		starlark.NewBuiltin("prompt", self.prompt),
+		starlark.NewBuiltin("confirm", self.confirm),
+		starlark.NewBuiltin("menu", self.menu),
+		starlark.NewBuiltin("toast", self.toast),
+		starlark.NewBuiltin("alert", self.alert),
+		starlark.NewBuiltin("render", self.render),
+	}
+
+	members := starlark.StringDict{}
+	for _, builtin := range builtins {
+		members[builtin.Name()] = builtin
+	}
+
+	return &starlarkstruct.Module{Name: "lazygit", Members: members}
+}
+
+func (self *api) commits(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs); err != nil {
+		return nil, err
+	}
+	self.c.Mutexes().LocalCommitsMutex.Lock()
+	defer self.c.Mutexes().LocalCommitsMutex.Unlock()
+
+	return toList(self.c.Model().Commits, commitToValue), nil
+}
+
+func (self *api) branches(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs); err != nil {
+		return nil, err
+	}
+	self.c.Mutexes().RefreshingBranchesMutex.Lock()
+	defer self.c.Mutexes().RefreshingBranchesMutex.Unlock()
+
+	return toList(self.c.Model().Branches, branchToValue), nil
+}
+
+func (self *api) files(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs); err != nil {
+		return nil, err
+	}
+	return toList(self.modelFiles(), fileToValue), nil
+}
+
+func (self *api) selectedCommit(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs); err != nil {
+		return nil, err
+	}
+	return self.readOnUIThread(thread, b.Name(), func() starlark.Value {
+		return toValueOrNone(self.c.Contexts().LocalCommits.GetSelected(), commitToValue)
+	})
+}
+
+func (self *api) selectedBranch(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs); err != nil {
+		return nil, err
+	}
+	return self.readOnUIThread(thread, b.Name(), func() starlark.Value {
+		return toValueOrNone(self.c.Contexts().Branches.GetSelected(), branchToValue)
+	})
+}
+
+// Returns None if a directory is selected
+func (self *api) selectedFile(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs); err != nil {
+		return nil, err
+	}
+	return self.readOnUIThread(thread, b.Name(), func() starlark.Value {
+		return toValueOrNone(self.c.Contexts().Files.GetSelectedFile(), fileToValue)
+	})
+}
+
+// Runs git with the given arguments and returns its output
+func (self *api) git(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	if len(kwargs) > 0 || len(args) == 0 {
+		return nil, fmt.Errorf("%s: expected one or more string arguments", b.Name())
+	}
+	gitArgs := make([]string, 0, len(args))
+	for _, arg := range args {
+		str, ok := starlark.AsString(arg)
+		if !ok {
+			return nil, fmt.Errorf("%s: expected string arguments, got %s", b.Name(), arg.Type())
+		}
+		gitArgs = append(gitArgs, str)
+	}
+
+	cmdArgs := git_commands.NewGitCmd(gitArgs[0]).Arg(gitArgs[1:]...).ToArgv()
+	output, err := self.c.OS().Cmd.New(cmdArgs).RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+	return starlark.String(output), nil
+}
+
+func (self *api) checkout(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var ref string
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs, "ref", &ref); err != nil {
+		return nil, err
+	}
+	if err := self.c.Git().Branch.Checkout(ref, git_commands.CheckoutOptions{}); err != nil {
+		return nil, err
+	}
+	self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC})
+	return starlark.None, nil
+}
+
+func (self *api) newBranch(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	name, base := "", "HEAD"
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs, "name", &name, "base?", &base); err != nil {
+		return nil, err
+	}
+	if err := self.c.Git().Branch.New(name, base); err != nil {
+		return nil, err
+	}
+	self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC})
+	return starlark.None, nil
+}
+
+func (self *api) stage(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var path string
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs, "path", &path); err != nil {
+		return nil, err
+	}
+	if err := self.c.Git().WorkingTree.StageFile(path); err != nil {
+		return nil, err
+	}
+	self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.FILES}})
+	return starlark.None, nil
+}
+
+func (self *api) unstage(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var path string
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs, "path", &path); err != nil {
+		return nil, err
+	}
+	// files that aren't in the model are treated as tracked, which works for
+	// any path that has staged changes
+	tracked := true
+	if file, ok := lo.Find(self.modelFiles(), func(file *models.File) bool { return file.Path == path }); ok {
+		tracked = file.Tracked
+	}
+	if err := self.c.Git().WorkingTree.UnStageFile([]string{path}, tracked); err != nil {
+		return nil, err
+	}
+	self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.FILES}})
+	return starlark.None, nil
+}
+
+// Commits the staged changes; the first line of the message is the summary
+func (self *api) commit(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var message string
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs, "message", &message); err != nil {
+		return nil, err
+	}
+	if err := notLoading(thread, b.Name()); err != nil {
+		return nil, err
+	}
+
+	done := make(chan error, 1)
+	self.c.OnUIThread(func() error {
+		self.helpers.WorkingTree.CommitWithMessage(message, func(err error) { done <- err })
+		return nil
+	})
+	if err := <-done; err != nil {
+		return nil, err
+	}
+	return starlark.None, nil
+}
+
+func (self *api) refresh(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs); err != nil {
+		return nil, err
+	}
+	self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC})
+	return starlark.None, nil
+}
+
+// Shows a prompt and calls on_confirm with the entered text
+func (self *api) prompt(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var title, initial string
+	var onConfirm starlark.Callable
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs, "title", &title, "on_confirm", &onConfirm, "initial?", &initial); err != nil {
+		return nil, err
+	}
+	self.c.OnUIThread(func() error {
+		self.c.Prompt(types.PromptOpts{
+			Title:          title,
+			InitialContent: initial,
+			HandleConfirm: func(input string) error {
+				self.callInBackground(onConfirm, starlark.String(input))
+				return nil
+			},
+		})
+		return nil
+	})
+	return starlark.None, nil
+}
+
+func (self *api) confirm(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var title, prompt string
+	var onConfirm starlark.Callable
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs, "title", &title, "prompt", &prompt, "on_confirm", &onConfirm); err != nil {
+		return nil, err
+	}
+	self.c.OnUIThread(func() error {
+		self.c.Confirm(types.ConfirmOpts{
+			Title:  title,
+			Prompt: prompt,
+			HandleConfirm: func() error {
+				self.callInBackground(onConfirm)
+				return nil
+			},
+		})
+		return nil
+	})
+	return starlark.None, nil
+}
+
+// Shows a menu whose items are given as (label, handler) pairs
+func (self *api) menu(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var title string
+	var items *starlark.List
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs, "title", &title, "items", &items); err != nil {
+		return nil, err
+	}
+
+	menuItems := make([]*types.MenuItem, 0, items.Len())
+	for i := range items.Len() {
+		pair, ok := items.Index(i).(starlark.Indexable)
+		if !ok || pair.Len() != 2 {
+			return nil, fmt.Errorf("%s: item %d must be a (label, handler) pair", b.Name(), i)
+		}
+		label, ok := starlark.AsString(pair.Index(0))
+		if !ok {
+			return nil, fmt.Errorf("%s: label of item %d must be a string", b.Name(), i)
+		}
+		handler, ok := pair.Index(1).(starlark.Callable)
+		if !ok {
+			return nil, fmt.Errorf("%s: handler of item %d must be callable", b.Name(), i)
+		}
+		menuItems = append(menuItems, &types.MenuItem{
+			Label: label,
+			OnPress: func() error {
+				self.callInBackground(handler)
+				return nil
+			},
+		})
+	}
+
+	self.c.OnUIThread(func() error {
+		return self.c.Menu(types.CreateMenuOptions{Title: title, Items: menuItems})
+	})
+	return starlark.None, nil
+}
+
+func (self *api) toast(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var message string
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs, "message", &message); err != nil {
+		return nil, err
+	}
+	self.c.OnUIThread(func() error {
+		self.c.Toast(message)
+		return nil
+	})
+	return starlark.None, nil
+}
+
+func (self *api) alert(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var title, message string
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs, "title", &title, "message", &message); err != nil {
+		return nil, err
+	}
+	self.c.OnUIThread(func() error {
+		self.c.Alert(title, message)
+		return nil
+	})
+	return starlark.None, nil
+}
+
+// Shows the given text in the main view, until the selection changes
+func (self *api) render(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var text, title string
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs, "text", &text, "title?", &title); err != nil {
+		return nil, err
+	}
+	self.c.OnUIThread(func() error {
+		self.c.RenderToMainViews(types.RefreshMainOpts{
+			Pair: self.c.MainViewPairs().Normal,
+			Main: &types.ViewUpdateOpts{
+				Title: title,
+				Task:  types.NewRenderStringTask(text),
+			},
+		})
+		return nil
+	})
+	return starlark.None, nil
+}
+
+// Reads the files of the model, which are guarded by the mutex of the file
+// tree
+func (self *api) modelFiles() []*models.File {
+	fileTreeViewModel := self.c.Contexts().Files.FileTreeViewModel
+	fileTreeViewModel.RWMutex.RLock()
+	defer fileTreeViewModel.RWMutex.RUnlock()
+
+	return self.c.Model().Files
+}
+
+// Calls f on the UI thread, which is where the selections of the contexts
+// change, and waits for its result
+func (self *api) readOnUIThread(thread *starlark.Thread, fnName string, f func() starlark.Value) (starlark.Value, error) {
+	if err := notLoading(thread, fnName); err != nil {
+		return nil, err
+	}
+
+	result := make(chan starlark.Value, 1)
+	self.c.OnUIThread(func() error {
+		result <- f()
+		return nil
+	})
+	return <-result, nil
+}
+
+// Runs a plugin function (e.g. a handler) on a worker thread. Errors are
+// shown to the user when the function is done.
+func (self *api) callInBackground(fn starlark.Callable, args ...starlark.Value) {
+	self.c.OnWorker(func(gocui.Task) error {
+		return call(self.c.Log, fn, args...)
+	})
+}
+
+func toList[T any](items []T, toValue func(T) starlark.Value) *starlark.List {
+	return starlark.NewList(lo.Map(items, func(item T, _ int) starlark.Value { return toValue(item) }))
+}
+
+func toValueOrNone[T any](item *T, toValue func(*T) starlark.Value) starlark.Value {
+	if item == nil {
+		return starlark.None
+	}
+	return toValue(item)
+}
+
+func toStringList(strs []string) *starlark.List {
+	return starlark.NewList(lo.Map(strs, func(str string, _ int) starlark.Value { return starlark.String(str) }))
+}
+
+func commitToValue(commit *models.Commit) starlark.Value {
+	return starlarkstruct.FromStringDict(starlark.String("commit"), starlark.StringDict{
+		"hash":         starlark.String(commit.Hash()),
+		"subject":      starlark.String(commit.Name),
+		"author_name":  starlark.String(commit.AuthorName),
+		"author_email": starlark.String(commit.AuthorEmail),
+		"timestamp":    starlark.MakeInt64(commit.UnixTimestamp),
+		"tags":         toStringList(commit.Tags),
+		"parents":      toStringList(commit.Parents()),
+	})
+}
+
+func branchToValue(branch *models.Branch) starlark.Value {
+	upstream := ""
+	if branch.IsTrackingRemote() {
+		upstream = branch.ShortUpstreamRefName()
+	}
+	return starlarkstruct.FromStringDict(starlark.String("branch"), starlark.StringDict{
+		"name":     starlark.String(branch.Name),
+		"head":     starlark.Bool(branch.Head),
+		"upstream": starlark.String(upstream),
+		"hash":     starlark.String(branch.CommitHash),
+		"subject":  starlark.String(branch.Subject),
+	})
+}
+
+func fileToValue(file *models.File) starlark.Value {
+	return starlarkstruct.FromStringDict(starlark.String("file"), starlark.StringDict{
+		"path":                 starlark.String(file.Path),
+		"previous_path":        starlark.String(file.PreviousPath),
+		"short_status":         starlark.String(file.ShortStatus),
+		"tracked":              starlark.Bool(file.Tracked),
+		"has_staged_changes":   starlark.Bool(file.HasStagedChanges),
+		"has_unstaged_changes": starlark.Bool(file.HasUnstagedChanges),
+		"has_merge_conflicts":  starlark.Bool(file.HasMergeConflicts),
+	})
+}
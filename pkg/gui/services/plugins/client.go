@@ -0,0 +1,170 @@
+package plugins
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
+	"github.com/jesseduffield/lazygit/pkg/gui/keybindings"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+	"go.starlark.net/starlark"
+)
+
+// Client is the entry point to this package. It loads the user's Starlark
+// plugins and returns the keybindings they registered.
+// See https://github.com/jesseduffield/lazygit/blob/master/docs/Plugins.md for more info.
+type Client struct {
+	c       *helpers.HelperCommon
+	api     *api
+	plugins []*plugin
+}
+
+func NewClient(
+	c *helpers.HelperCommon,
+	helpers *helpers.Helpers,
+) *Client {
+	return &Client{
+		c:   c,
+		api: &api{c: c, helpers: helpers},
+	}
+}
+
+// Loads the plugins of the global config dir and of the current repo,
+// replacing any previously loaded ones. Plugins that fail to load are skipped;
+// their errors are returned together.
+func (self *Client) Load() error {
+	self.plugins = nil
+	if !self.c.UserConfig().Plugins.Enabled {
+		return nil
+	}
+
+	module := self.api.module()
+	errs := []error{}
+	for _, path := range self.pluginFiles() {
+		src, err := os.ReadFile(path)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		p, err := loadPlugin(path, src, module, self.c.Log)
+		if err == nil {
+			err = self.validateContexts(p)
+		}
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		self.plugins = append(self.plugins, p)
+	}
+
+	return errors.Join(errs...)
+}
+
+func (self *Client) pluginDirs() []string {
+	dirs := []string{
+		filepath.Join(config.ConfigDir(), "plugins"),
+		filepath.Join(self.c.Git().RepoPaths.RepoGitDirPath(), "lazygit", "plugins"),
+	}
+	if self.c.UserConfig().Plugins.LoadFromWorktree {
+		dirs = append(dirs, filepath.Join(self.c.Git().RepoPaths.WorktreePath(), ".lazygit", "plugins"))
+	}
+	return dirs
+}
+
+func (self *Client) pluginFiles() []string {
+	files := []string{}
+	for _, dir := range self.pluginDirs() {
+		matches, _ := filepath.Glob(filepath.Join(dir, "*.star"))
+		sort.Strings(matches)
+		files = append(files, matches...)
+	}
+	return files
+}
+
+func (self *Client) validateContexts(p *plugin) error {
+	for _, binding := range p.bindings {
+		if _, ok := self.viewNameForContext(binding.context); !ok {
+			return errors.New(utils.ResolvePlaceholderString(self.c.Tr.PluginBindingUnknownContext, map[string]string{
+				"path":    p.path,
+				"key":     binding.key,
+				"context": binding.context,
+			}))
+		}
+	}
+	return nil
+}
+
+func (self *Client) viewNameForContext(contextKey string) (string, bool) {
+	if contextKey == "global" {
+		return "", true
+	}
+
+	context, ok := lo.Find(self.c.Contexts().Flatten(), func(context types.Context) bool {
+		return context.GetKey() == types.ContextKey(contextKey)
+	})
+	if !ok {
+		return "", false
+	}
+	return context.GetViewName(), true
+}
+
+func (self *Client) GetPluginKeybindings() []*types.Binding {
+	bindings := []*types.Binding{}
+	for _, p := range self.plugins {
+		for _, binding := range p.bindings {
+			viewName, _ := self.viewNameForContext(binding.context)
+			bindings = append(bindings, &types.Binding{
+				ViewName:    viewName,
+				Key:         keybindings.GetKey(binding.key),
+				Modifier:    gocui.ModNone,
+				Handler:     self.handler(binding.description, binding.handler),
+				Description: binding.description,
+			})
+		}
+	}
+
+	if lo.SomeBy(self.plugins, func(p *plugin) bool { return len(p.menuItems) > 0 }) {
+		bindings = append(bindings, &types.Binding{
+			ViewName:    "",
+			Key:         keybindings.GetKey(self.c.UserConfig().Keybinding.Universal.OpenPluginsMenu),
+			Modifier:    gocui.ModNone,
+			Handler:     self.showPluginsMenu,
+			Description: self.c.Tr.OpenPluginsMenu,
+			Tooltip:     self.c.Tr.OpenPluginsMenuTooltip,
+			OpensMenu:   true,
+		})
+	}
+
+	return bindings
+}
+
+func (self *Client) showPluginsMenu() error {
+	menuItems := []*types.MenuItem{}
+	for _, p := range self.plugins {
+		for _, item := range p.menuItems {
+			menuItems = append(menuItems, &types.MenuItem{
+				Label:   item.label,
+				Key:     keybindings.GetKey(item.key),
+				OnPress: self.handler(item.label, item.handler),
+			})
+		}
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{Title: self.c.Tr.OpenPluginsMenu, Items: menuItems})
+}
+
+func (self *Client) handler(action string, fn starlark.Callable) func() error {
+	return func() error {
+		self.c.LogAction(action)
+		self.api.callInBackground(fn)
+		return nil
+	}
+}
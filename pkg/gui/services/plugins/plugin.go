@@ -0,0 +1,179 @@
+package plugins
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/sirupsen/logrus"
+	"go.starlark.net/starlark"
+	"go.starlark.net/syntax"
+)
+
+// A loaded plugin file, along with the keybindings and menu items it registered
+type plugin struct {
+	path      string
+	bindings  []*pluginBinding
+	menuItems []*pluginMenuItem
+}
+
+type pluginBinding struct {
+	key         string
+	context     string
+	description string
+	handler     starlark.Callable
+}
+
+type pluginMenuItem struct {
+	label   string
+	key     string
+	handler starlark.Callable
+}
+
+// The thread-local key under which the plugin being loaded is stored, so that
+// the registration functions know whom to register with
+const loadingPluginKey = "plugin"
+
+// Limits for running a plugin's code, so that a plugin with an endless loop
+// can't keep lazygit busy forever. The step limit catches loops in Starlark
+// code; the timeout also catches scripts that spend their time in builtins
+// such as git(), although these are only interrupted once they return.
+const (
+	maxExecutionSteps = 10_000_000
+	executionTimeout  = 10 * time.Second
+)
+
+var fileOptions = &syntax.FileOptions{
+	Set:             true,
+	While:           true,
+	TopLevelControl: true,
+	GlobalReassign:  true,
+}
+
+// Executes the plugin's source, which registers its keybindings and menu items
+// through the 'lazygit' module.
+func loadPlugin(path string, src []byte, module starlark.Value, log *logrus.Entry) (*plugin, error) {
+	p := &plugin{path: path}
+
+	thread, stop := newThread(path, log)
+	defer stop()
+	thread.SetLocal(loadingPluginKey, p)
+
+	if _, err := starlark.ExecFileOptions(fileOptions, thread, path, src, starlark.StringDict{"lazygit": module}); err != nil {
+		return nil, formatError(err)
+	}
+
+	return p, nil
+}
+
+// Returns a thread that is cancelled once it exceeds the execution limits.
+// The returned function must be called when the thread is done.
+//
+// Starlark's print() would otherwise write to stderr, messing up the screen, so
+// we send its output to the log instead.
+func newThread(name string, log *logrus.Entry) (*starlark.Thread, func()) {
+	thread := &starlark.Thread{
+		Name: name,
+		Print: func(thread *starlark.Thread, msg string) {
+			log.Infof("[%s] %s", thread.Name, msg)
+		},
+	}
+	thread.SetMaxExecutionSteps(maxExecutionSteps)
+
+	timer := time.AfterFunc(executionTimeout, func() {
+		thread.Cancel(fmt.Sprintf("timed out after %s", executionTimeout))
+	})
+	return thread, func() { timer.Stop() }
+}
+
+// Calls a function of a plugin, e.g. the handler of a keybinding
+func call(log *logrus.Entry, fn starlark.Callable, args ...starlark.Value) error {
+	thread, stop := newThread(fn.Name(), log)
+	defer stop()
+
+	_, err := starlark.Call(thread, fn, args, nil)
+	return formatError(err)
+}
+
+// Includes the position where a script failed, so that plugin authors can find
+// the problem
+func formatError(err error) error {
+	var evalErr *starlark.EvalError
+	if errors.As(err, &evalErr) {
+		return errors.New(evalErr.Backtrace())
+	}
+	return err
+}
+
+func loadingPlugin(thread *starlark.Thread, fnName string) (*plugin, error) {
+	p, ok := thread.Local(loadingPluginKey).(*plugin)
+	if !ok {
+		return nil, fmt.Errorf("%s: can only be called while the plugin is loading", fnName)
+	}
+	return p, nil
+}
+
+// Plugins are loaded on the UI thread, so while loading they can't call the
+// functions that wait for the UI thread
+func notLoading(thread *starlark.Thread, fnName string) error {
+	if _, ok := thread.Local(loadingPluginKey).(*plugin); ok {
+		return fmt.Errorf("%s: can't be called while the plugin is loading", fnName)
+	}
+	return nil
+}
+
+func bind(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	p, err := loadingPlugin(thread, b.Name())
+	if err != nil {
+		return nil, err
+	}
+
+	binding := &pluginBinding{}
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs,
+		"key", &binding.key,
+		"context", &binding.context,
+		"description", &binding.description,
+		"handler", &binding.handler,
+	); err != nil {
+		return nil, err
+	}
+	if !isValidKey(binding.key) {
+		return nil, fmt.Errorf("%s: unknown key '%s'", b.Name(), binding.key)
+	}
+
+	p.bindings = append(p.bindings, binding)
+	return starlark.None, nil
+}
+
+func addMenuItem(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	p, err := loadingPlugin(thread, b.Name())
+	if err != nil {
+		return nil, err
+	}
+
+	item := &pluginMenuItem{}
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs,
+		"label", &item.label,
+		"handler", &item.handler,
+		"key?", &item.key,
+	); err != nil {
+		return nil, err
+	}
+	if item.key != "" && !isValidKey(item.key) {
+		return nil, fmt.Errorf("%s: unknown key '%s'", b.Name(), item.key)
+	}
+
+	p.menuItems = append(p.menuItems, item)
+	return starlark.None, nil
+}
+
+func isValidKey(key string) bool {
+	if utf8.RuneCountInString(key) == 1 {
+		return true
+	}
+	_, ok := config.KeyByLabel[strings.ToLower(key)]
+	return ok
+}
@@ -0,0 +1,106 @@
+package plugins
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadPlugin(t *testing.T) {
+	scenarios := []struct {
+		name              string
+		src               string
+		expectedBindings  []string
+		expectedMenuItems []string
+		expectedError     string
+	}{
+		{
+			name:              "no registrations",
+			src:               "x = 1\n",
+			expectedBindings:  nil,
+			expectedMenuItems: nil,
+		},
+		{
+			name: "bindings and menu items",
+			src: `
+def handler():
+    pass
+
+lazygit.bind("X", "files", "Do something", handler)
+lazygit.bind(key = "<c-y>", context = "global", description = "Do something else", handler = handler)
+lazygit.add_menu_item("First", handler)
+lazygit.add_menu_item("Second", handler, key = "s")
+`,
+			expectedBindings:  []string{"X files Do something", "<c-y> global Do something else"},
+			expectedMenuItems: []string{"First", "Second s"},
+		},
+		{
+			name:          "unknown key",
+			src:           `lazygit.bind("<c-unknown>", "files", "Do something", lambda: None)`,
+			expectedError: "bind: unknown key '<c-unknown>'",
+		},
+		{
+			name:          "missing argument",
+			src:           `lazygit.add_menu_item("Label")`,
+			expectedError: "add_menu_item: missing argument for handler",
+		},
+		{
+			name:          "reading the selection while loading",
+			src:           "lazygit.selected_commit()\n",
+			expectedError: "selected_commit: can't be called while the plugin is loading",
+		},
+		{
+			name:          "syntax error",
+			src:           "def handler(:\n",
+			expectedError: "test.star:1:14: got ':', want ')'",
+		},
+		{
+			name:          "runtime error",
+			src:           "fail('oops')\n",
+			expectedError: "fail: oops",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			p, err := loadPlugin("test.star", []byte(s.src), (&api{}).module(), utils.NewDummyLog())
+			if s.expectedError != "" {
+				assert.ErrorContains(t, err, s.expectedError)
+				return
+			}
+
+			assert.NoError(t, err)
+			var bindings []string
+			for _, binding := range p.bindings {
+				bindings = append(bindings, binding.key+" "+binding.context+" "+binding.description)
+			}
+			assert.Equal(t, s.expectedBindings, bindings)
+			var menuItems []string
+			for _, item := range p.menuItems {
+				menuItems = append(menuItems, strings.TrimSpace(item.label+" "+item.key))
+			}
+			assert.Equal(t, s.expectedMenuItems, menuItems)
+		})
+	}
+}
+
+func TestRegisteringOutsideOfLoading(t *testing.T) {
+	p, err := loadPlugin("test.star", []byte("def handler():\n    lazygit.add_menu_item('Late', handler)\n\nlazygit.add_menu_item('Early', handler)\n"), (&api{}).module(), utils.NewDummyLog())
+	assert.NoError(t, err)
+
+	err = call(utils.NewDummyLog(), p.menuItems[0].handler)
+	assert.ErrorContains(t, err, "add_menu_item: can only be called while the plugin is loading")
+	assert.Len(t, p.menuItems, 1)
+}
+
+func TestEndlessLoopsAreCancelled(t *testing.T) {
+	_, err := loadPlugin("test.star", []byte("while True:\n    pass\n"), (&api{}).module(), utils.NewDummyLog())
+	assert.ErrorContains(t, err, "too many steps")
+
+	p, err := loadPlugin("test.star", []byte("def handler():\n    while True:\n        pass\n\nlazygit.add_menu_item('Loop', handler)\n"), (&api{}).module(), utils.NewDummyLog())
+	assert.NoError(t, err)
+	err = call(utils.NewDummyLog(), p.menuItems[0].handler)
+	assert.ErrorContains(t, err, "too many steps")
+}
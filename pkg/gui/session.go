@@ -0,0 +1,204 @@
+package gui
+
+import (
+	appTypes "github.com/jesseduffield/lazygit/pkg/app/types"
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/filetree"
+	"github.com/jesseduffield/lazygit/pkg/gui/modes/diffing"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+// The number of search and filter strings per panel that we remember across
+// sessions
+const maxSessionSearchHistory = 50
+
+// The side panels that we restore focus and selection of. Others, like the
+// commit files, only make sense when reached from one of these, so we don't
+// want to start with them.
+func restorableContexts(contextTree *context.ContextTree) []types.Context {
+	return []types.Context{
+		contextTree.Status,
+		contextTree.Files,
+		contextTree.Worktrees,
+		contextTree.Submodules,
+		contextTree.Branches,
+		contextTree.Remotes,
+		contextTree.Tags,
+		contextTree.LocalCommits,
+		contextTree.ReflogCommits,
+		contextTree.Stash,
+	}
+}
+
+// Remembers the state of the gui in the current repo, so that we can restore it
+// when the repo is opened again after restarting lazygit
+func (gui *Gui) saveSession() {
+	if gui.State == nil || gui.git == nil {
+		return
+	}
+
+	contextTree := gui.State.Contexts
+	session := &config.RepoSession{
+		Repo:           gui.git.RepoPaths.WorktreePath(),
+		SelectedItems:  map[string]string{},
+		ScreenMode:     screenModeArg(gui.State.ScreenMode),
+		FilterPath:     gui.State.Modes.Filtering.GetPath(),
+		FilterAuthor:   gui.State.Modes.Filtering.GetAuthor(),
+		DiffRef:        gui.State.Modes.Diffing.Ref,
+		DiffReverse:    gui.State.Modes.Diffing.Reverse,
+		CollapsedPaths: contextTree.Files.CollapsedPaths().ToSlice(),
+		SearchHistory:  map[string][]string{},
+	}
+
+	currentSide := gui.State.ContextMgr.CurrentSide()
+	for _, ctx := range restorableContexts(contextTree) {
+		if ctx.GetKey() == currentSide.GetKey() {
+			session.CurrentContext = string(ctx.GetKey())
+		}
+
+		if listContext, ok := ctx.(types.IListContext); ok {
+			if id := listContext.GetSelectedItemId(); id != "" {
+				session.SelectedItems[string(ctx.GetKey())] = id
+			}
+		}
+	}
+
+	for _, ctx := range contextTree.Flatten() {
+		if searchHistoryContext, ok := ctx.(types.ISearchHistoryContext); ok {
+			if history := searchHistoryContext.GetSearchHistory().Items(); len(history) > 0 {
+				session.SearchHistory[string(ctx.GetKey())] = utils.Limit(lo.Uniq(history), maxSessionSearchHistory)
+			}
+		}
+	}
+
+	gui.c.GetAppState().SetRepoSession(session)
+	if err := gui.c.SaveAppState(); err != nil {
+		gui.c.Log.Errorf("Failed to save session: %v", err)
+	}
+}
+
+// Restores the state saved by saveSession into the newly created repo state.
+// Whatever was given on the command line takes precedence. Returns the context
+// to focus, or nil if the session doesn't have one.
+//
+// The selections can only be restored once the models have been loaded, so we
+// only remember them here; see applyRestoredSelection.
+func (gui *Gui) restoreSession(startArgs appTypes.StartArgs) types.Context {
+	session := gui.c.GetAppState().GetRepoSession(gui.git.RepoPaths.WorktreePath())
+	if session == nil {
+		return nil
+	}
+
+	contextTree := gui.State.Contexts
+
+	if startArgs.ScreenMode == "" {
+		gui.State.ScreenMode = parseScreenModeArg(session.ScreenMode)
+	}
+
+	if startArgs.FilterPath == "" {
+		gui.State.Modes.Filtering.SetPath(session.FilterPath)
+		gui.State.Modes.Filtering.SetAuthor(session.FilterAuthor)
+	}
+
+	gui.State.Modes.Diffing = diffing.Diffing{Ref: session.DiffRef, Reverse: session.DiffReverse}
+
+	for _, path := range session.CollapsedPaths {
+		contextTree.Files.CollapsedPaths().Collapse(path)
+	}
+
+	for _, ctx := range contextTree.Flatten() {
+		if searchHistoryContext, ok := ctx.(types.ISearchHistoryContext); ok {
+			history := session.SearchHistory[string(ctx.GetKey())]
+			// pushing the oldest first, so that the most recent ends up on top
+			for i := len(history) - 1; i >= 0; i-- {
+				searchHistoryContext.GetSearchHistory().Push(history[i])
+			}
+		}
+	}
+
+	for key, id := range session.SelectedItems {
+		gui.State.RestoredSelections.Set(types.ContextKey(key), id)
+	}
+
+	if startArgs.FilterPath != "" || startArgs.GitArg != appTypes.GitArgNone {
+		return nil
+	}
+
+	ctx, ok := lo.Find(restorableContexts(contextTree), func(ctx types.Context) bool {
+		return string(ctx.GetKey()) == session.CurrentContext
+	})
+	if !ok {
+		return nil
+	}
+	return ctx
+}
+
+// Selects the item that was selected when the session was saved, once the
+// context's model has been loaded. If the item no longer exists, the selection
+// is left alone.
+func (gui *Gui) applyRestoredSelection(ctx types.Context) {
+	id, ok := gui.State.RestoredSelections.Get(ctx.GetKey())
+	if !ok {
+		return
+	}
+
+	listContext, ok := ctx.(types.IListContext)
+	if !ok {
+		return
+	}
+
+	list := listContext.GetList()
+	if list.Len() == 0 {
+		// not loaded yet
+		return
+	}
+
+	if index, ok := indexOfItem(listContext, id); ok {
+		list.SetSelection(index)
+		gui.State.RestoredSelections.Delete(ctx.GetKey())
+		return
+	}
+
+	// The commit might be further down than the initially loaded ones, so
+	// load all commits and try again
+	localCommits := gui.State.Contexts.LocalCommits
+	if ctx.GetKey() == localCommits.GetKey() && localCommits.GetLimitCommits() {
+		localCommits.SetLimitCommits(false)
+		gui.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.COMMITS}})
+		return
+	}
+
+	gui.State.RestoredSelections.Delete(ctx.GetKey())
+}
+
+func indexOfItem(listContext types.IListContext, id string) (int, bool) {
+	// the file tree doesn't implement GetItem
+	if workingTreeContext, ok := listContext.(*context.WorkingTreeContext); ok {
+		_, index, found := lo.FindIndexOf(workingTreeContext.GetAllItems(), func(node *filetree.FileNode) bool {
+			return node.ID() == id
+		})
+		return index, found
+	}
+
+	list := listContext.GetList()
+	for i := range list.Len() {
+		if item, ok := list.GetItem(i).(context.HasID); ok && item.ID() == id {
+			return i, true
+		}
+	}
+	return -1, false
+}
+
+func screenModeArg(screenMode types.ScreenMode) string {
+	switch screenMode {
+	case types.SCREEN_HALF:
+		return "half"
+	case types.SCREEN_FULL:
+		return "full"
+	default:
+		return "normal"
+	}
+}
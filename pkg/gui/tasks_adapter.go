@@ -6,10 +6,24 @@ import (
 	"strings"
 
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/syntax"
 	"github.com/jesseduffield/lazygit/pkg/tasks"
+	"github.com/jesseduffield/lazygit/pkg/theme"
 )
 
 func (gui *Gui) newCmdTask(view *gocui.View, cmd *exec.Cmd, prefix string) error {
+	return gui.newCmdTaskWithOutputFilter(view, cmd, prefix, nil)
+}
+
+// Like newCmdTask, but adds syntax highlighting to the diff that the command
+// outputs, if enabled
+func (gui *Gui) newDiffCmdTask(view *gocui.View, cmd *exec.Cmd, prefix string) error {
+	return gui.newCmdTaskWithOutputFilter(view, cmd, prefix, func(r io.Reader) io.Reader {
+		return syntax.NewDiffReader(r, theme.SyntaxHighlighter)
+	})
+}
+
+func (gui *Gui) newCmdTaskWithOutputFilter(view *gocui.View, cmd *exec.Cmd, prefix string, filterOutput func(io.Reader) io.Reader) error {
 	cmdStr := strings.Join(cmd.Args, " ")
 	gui.c.Log.WithField(
 		"command",
@@ -32,6 +46,9 @@ func (gui *Gui) newCmdTask(view *gocui.View, cmd *exec.Cmd, prefix string) error
 			gui.c.Log.Error(err)
 		}
 
+		if filterOutput != nil && r != nil {
+			return cmd, filterOutput(r)
+		}
 		return cmd, r
 	}
 
@@ -8,6 +8,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/common"
 	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/jesseduffield/lazygit/pkg/gui/commandlog"
 	"github.com/jesseduffield/lazygit/pkg/tasks"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/sasha-s/go-deadlock"
@@ -115,6 +116,11 @@ type IGuiCommon interface {
 
 	// Returns true if we're in a demo recording/playback
 	InDemo() bool
+
+	// Notifies the clients of the remote-control socket that subscribed to the
+	// given event (see pkg/remote). Does nothing if lazygit wasn't started
+	// with --listen.
+	PublishRemoteEvent(event string, data any)
 }
 
 type IModeMgr interface {
@@ -158,6 +164,8 @@ type CreateMenuOptions struct {
 	ColumnAlignment           []utils.Alignment
 	AllowFilteringKeybindings bool
 	KeepConfirmKeybindings    bool // if true, the keybindings that match the confirm binding will not be removed from menu items
+	KeepSectionsWhenFiltering bool // if true, filtered items stay grouped by section rather than being sorted by best match only
+	FilterTooltips            bool // if true, filtering matches the tooltips of menu items too
 }
 
 type CreatePopupPanelOpts struct {
@@ -293,6 +301,8 @@ type Model struct {
 	SubCommits   []*models.Commit
 	Remotes      []*models.Remote
 	Worktrees    []*models.Worktree
+	// the repos of the workspace panel; only loaded if a workspace is configured
+	WorkspaceRepos []*models.WorkspaceRepo
 
 	// FilteredReflogCommits are the ones that appear in the reflog panel.
 	// When in filtering mode we only include the ones that match the given path
@@ -320,6 +330,9 @@ type Model struct {
 	Authors map[string]*models.Author
 
 	HashPool *utils.StringPool
+
+	// the structured command log of the repo, which is persisted across runs
+	CommandLog *commandlog.Store
 }
 
 type Mutexes struct {
@@ -365,6 +378,8 @@ type IStateAccessor interface {
 	GetIsRefreshingFiles() bool
 	GetShowExtrasWindow() bool
 	SetShowExtrasWindow(bool)
+	GetSideBySideStagingView() bool
+	SetSideBySideStagingView(bool)
 	GetRetainOriginalDir() bool
 	SetRetainOriginalDir(bool)
 	GetItemOperation(item HasUrn) ItemOperation
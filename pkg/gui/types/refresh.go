@@ -14,6 +14,7 @@ const (
 	TAGS
 	REMOTES
 	WORKTREES
+	WORKSPACE
 	STATUS
 	SUBMODULES
 	STAGING
@@ -9,6 +9,7 @@ type Views struct {
 	Branches       *gocui.View
 	Remotes        *gocui.View
 	Worktrees      *gocui.View
+	Workspace      *gocui.View
 	Tags           *gocui.View
 	RemoteBranches *gocui.View
 	ReflogCommits  *gocui.View
@@ -29,6 +30,8 @@ type Views struct {
 	Menu              *gocui.View
 	CommitMessage     *gocui.View
 	CommitDescription *gocui.View
+	HunkEditor        *gocui.View
+	CommandLogEntries *gocui.View
 	CommitFiles       *gocui.View
 	SubCommits        *gocui.View
 	Information       *gocui.View
@@ -133,6 +133,8 @@ func (gui *Gui) postRefreshUpdate(c types.Context) {
 		gui.Log.Infof("postRefreshUpdate for %s took %s", c.GetKey(), time.Since(t))
 	}()
 
+	gui.applyRestoredSelection(c)
+
 	c.HandleRender()
 
 	if gui.currentViewName() == c.GetViewName() {
@@ -30,6 +30,7 @@ func (gui *Gui) orderedViewNameMappings() []viewNameMapping {
 		{viewPtr: &gui.Views.Snake, name: "snake"},
 		{viewPtr: &gui.Views.Submodules, name: "submodules"},
 		{viewPtr: &gui.Views.Worktrees, name: "worktrees"},
+		{viewPtr: &gui.Views.Workspace, name: "workspace"},
 		{viewPtr: &gui.Views.Files, name: "files"},
 		{viewPtr: &gui.Views.Tags, name: "tags"},
 		{viewPtr: &gui.Views.Remotes, name: "remotes"},
@@ -65,6 +66,8 @@ func (gui *Gui) orderedViewNameMappings() []viewNameMapping {
 		// popups.
 		{viewPtr: &gui.Views.CommitMessage, name: "commitMessage"},
 		{viewPtr: &gui.Views.CommitDescription, name: "commitDescription"},
+		{viewPtr: &gui.Views.HunkEditor, name: "hunkEditor"},
+		{viewPtr: &gui.Views.CommandLogEntries, name: "commandLogEntries"},
 		{viewPtr: &gui.Views.Menu, name: "menu"},
 		{viewPtr: &gui.Views.Suggestions, name: "suggestions"},
 		{viewPtr: &gui.Views.Confirmation, name: "confirmation"},
@@ -127,6 +130,12 @@ func (gui *Gui) createAllViews() error {
 	gui.Views.CommitDescription.Editable = true
 	gui.Views.CommitDescription.Editor = gocui.EditorFunc(gui.commitDescriptionEditor)
 
+	gui.Views.HunkEditor.Visible = false
+	gui.Views.HunkEditor.Editable = true
+	gui.Views.HunkEditor.Editor = gocui.EditorFunc(gui.hunkEditorEditor)
+
+	gui.Views.CommandLogEntries.Visible = false
+
 	gui.Views.Confirmation.Visible = false
 	gui.Views.Confirmation.Wrap = true
 	gui.Views.Confirmation.AutoRenderHyperLinks = true
@@ -186,6 +195,7 @@ func (gui *Gui) configureViewProperties() {
 	gui.Views.Branches.Title = gui.c.Tr.BranchesTitle
 	gui.Views.Remotes.Title = gui.c.Tr.RemotesTitle
 	gui.Views.Worktrees.Title = gui.c.Tr.WorktreesTitle
+	gui.Views.Workspace.Title = gui.c.Tr.WorkspaceTitle
 	gui.Views.Tags.Title = gui.c.Tr.TagsTitle
 	gui.Views.Files.Title = gui.c.Tr.FilesTitle
 	gui.Views.PatchBuilding.Title = gui.c.Tr.Patch
@@ -197,6 +207,10 @@ func (gui *Gui) configureViewProperties() {
 	gui.Views.StagingSecondary.Title = gui.c.Tr.StagedChanges
 	gui.Views.CommitMessage.Title = gui.c.Tr.CommitSummary
 	gui.Views.CommitDescription.Title = gui.c.Tr.CommitDescriptionTitle
+	gui.Views.HunkEditor.Title = gui.c.Tr.HunkEditorTitle
+	gui.Views.HunkEditor.TabWidth = gui.c.UserConfig().Gui.TabWidth
+
+	gui.Views.CommandLogEntries.Title = gui.c.Tr.CommandLogEntriesTitle
 	gui.Views.Extras.Title = gui.c.Tr.CommandLog
 	gui.Views.Snake.Title = gui.c.Tr.SnakeTitle
 
@@ -226,6 +240,7 @@ func (gui *Gui) configureViewProperties() {
 
 		gui.Views.Files.TitlePrefix = jumpLabels[1]
 		gui.Views.Worktrees.TitlePrefix = jumpLabels[1]
+		gui.Views.Workspace.TitlePrefix = jumpLabels[1]
 		gui.Views.Submodules.TitlePrefix = jumpLabels[1]
 
 		gui.Views.Branches.TitlePrefix = jumpLabels[2]
@@ -243,6 +258,7 @@ func (gui *Gui) configureViewProperties() {
 
 		gui.Views.Files.TitlePrefix = ""
 		gui.Views.Worktrees.TitlePrefix = ""
+		gui.Views.Workspace.TitlePrefix = ""
 		gui.Views.Submodules.TitlePrefix = ""
 
 		gui.Views.Branches.TitlePrefix = ""
@@ -47,6 +47,20 @@ type TranslationSet struct {
 	CommitChangesWithEditor               string
 	FindBaseCommitForFixup                string
 	FindBaseCommitForFixupTooltip         string
+	AbsorbStagedChanges                   string
+	AbsorbStagedChangesTooltip            string
+	AbsorbCreateFixupCommits              string
+	AbsorbCreateAndSquashFixupCommits     string
+	AbsorbingStatus                       string
+	NoStagedChangesToAbsorb               string
+	AbsorbNoHunksAttributed               string
+	AbsorbHunksLeftStaged                 string
+	AbsorbFixupsNotSquashed               string
+	AbsorbedStagedChanges                 string
+	AbsorbReasonNewFile                   string
+	AbsorbReasonSeveralCommits            string
+	AbsorbReasonNotInCurrentBranch        string
+	AbsorbReasonNoTextualChanges          string
 	NoBaseCommitsFound                    string
 	MultipleBaseCommitsFoundStaged        string
 	MultipleBaseCommitsFoundUnstaged      string
@@ -95,6 +109,15 @@ type TranslationSet struct {
 	FilterLabelTrackedFiles               string
 	FilterLabelUntrackedFiles             string
 	FilterLabelConflictingFiles           string
+	UntrackedFilesOptions                 string
+	UntrackedFilesOptionsTooltip          string
+	UntrackedFilesMenuTitle               string
+	UntrackedFilesNo                      string
+	UntrackedFilesNormal                  string
+	UntrackedFilesAll                     string
+	UntrackedDirectory                    string
+	CannotEnterUntrackedDirectory         string
+	SlowStatus                            string
 	MergeConflictsTitle                   string
 	MergeConflictDescription_DD           string
 	MergeConflictDescription_AU           string
@@ -131,6 +154,36 @@ type TranslationSet struct {
 	ForceDeleteBranchTitle                string
 	ForceDeleteBranchMessage              string
 	ForceDeleteBranchesMessage            string
+	CleanUpBranches                       string
+	CleanUpBranchesTooltip                string
+	FindingBranchesToCleanUp              string
+	NoBranchesToCleanUp                   string
+	BranchCleanupMergedInto               string
+	BranchCleanupSquashMergedInto         string
+	BranchCleanupUpstreamGone             string
+	BranchCleanupStale                    string
+	BranchCleanupBranchesSection          string
+	BranchCleanupActionsSection           string
+	SelectAllBranches                     string
+	SelectNoBranches                      string
+	DeleteSelectedBranchesLocally         string
+	DeleteSelectedLocalAndRemoteBranches  string
+	NoBranchesSelected                    string
+	CleanUpBranchesPrompt                 string
+	CleanUpLocalAndRemoteBranchesPrompt   string
+	ViewBranchStackOptions                string
+	ViewBranchStackOptionsTooltip         string
+	BranchStack                           string
+	BranchIsNotPartOfAStack               string
+	RestackBranches                       string
+	RestackBranchesTooltip                string
+	CannotRestackForkedStack              string
+	PushStack                             string
+	PushStackTooltip                      string
+	NoRemotesToPushStackTo                string
+	OpenStackPullRequests                 string
+	OpenStackPullRequestsTooltip          string
+	StackNotPushed                        string
 	RebaseBranch                          string
 	RebaseBranchTooltip                   string
 	CantRebaseOntoSelf                    string
@@ -292,10 +345,40 @@ type TranslationSet struct {
 	SelectHunk                            string
 	SelectLineByLine                      string
 	ToggleSelectHunkTooltip               string
+	ToggleSideBySideView                  string
+	ToggleSideBySideViewTooltip           string
 	HunkStagingHint                       string
 	ToggleSelectionForPatch               string
 	EditHunk                              string
 	EditHunkTooltip                       string
+	EditHunkInline                        string
+	EditHunkInlineTooltip                 string
+	ApplyEditedHunk                       string
+	HunkEditorTitle                       string
+	HunkEditorTitleUnstaged               string
+	HunkEditorTitleStaged                 string
+	HunkEditorFooter                      string
+	HunkEditorChecking                    string
+	HunkEditorPatchApplies                string
+	HunkEditorInvalidPrefix               string
+	HunkEditorOnlyAddedLinesEditable      string
+	HunkEditorNoChanges                   string
+	CannotEditHunkWithNoNewlineMarker     string
+	StageMatchingLines                    string
+	StageMatchingLinesTooltip             string
+	MatchingLinesRegexTitle               string
+	MatchingLinesMenuTitle                string
+	StageMatchingLinesInFile              string
+	StageMatchingLinesInAllFiles          string
+	UnstageMatchingLinesInFile            string
+	UnstageMatchingLinesInAllFiles        string
+	DiscardMatchingLinesInFile            string
+	DiscardMatchingLinesInAllFiles        string
+	DiscardMatchingLinesPrompt            string
+	NoChangedLinesMatch                   string
+	StagedMatchingLines                   string
+	UnstagedMatchingLines                 string
+	DiscardedMatchingLines                string
 	ToggleStagingView                     string
 	ToggleStagingViewTooltip              string
 	ReturnToFilesPanel                    string
@@ -362,6 +445,7 @@ type TranslationSet struct {
 	RewordNotSupported                    string
 	ChangingThisActionIsNotAllowed        string
 	NotAllowedMidCherryPickOrRevert       string
+	NotAllowedForCommitsOfOtherBranches   string
 	PickIsOnlyAllowedDuringRebase         string
 	DroppingMergeRequiresSingleSelection  string
 	CherryPickCopy                        string
@@ -572,7 +656,54 @@ type TranslationSet struct {
 	CreatingTag                           string
 	ForceTag                              string
 	ForceTagPrompt                        string
+	DeleteTagsTitle                       string
+	DeleteLocalTags                       string
+	DeleteRemoteTags                      string
+	DeleteLocalAndRemoteTags              string
+	RemoteTagsDeletedMessage              string
+	SelectRemoteTagsUpstream              string
+	DeleteRemoteTagsPrompt                string
+	DeleteLocalAndRemoteTagsPrompt        string
+	PushTagsTitle                         string
+	PushingTagStatus                      string
+	NewSignedTag                          string
+	NewSignedTagTooltip                   string
+	SignWithDefaultKey                    string
+	SignWithSpecificKey                   string
+	SigningKeyTitle                       string
+	NewNextVersionTag                     string
+	NewNextVersionTagTooltip              string
+	LatestTagIsNotSemver                  string
+	EditTagMessage                        string
+	EditTagMessageTooltip                 string
+	CannotEditLightweightTag              string
+	UpdatingTagMessage                    string
+	TagSignature                          string
+	TagSignatureValid                     string
+	TagSignatureInvalid                   string
 	FetchRemoteTooltip                    string
+	PruneRemote                           string
+	PruneRemoteTooltip                    string
+	PruneRemotePrompt                     string
+	NoStaleRemoteBranches                 string
+	CheckingForStaleBranchesStatus        string
+	EditRemotePushUrl                     string
+	EditRemotePushUrlTooltip              string
+	EditRemotePushUrlPrompt               string
+	AddRemotePushUrl                      string
+	AddRemotePushUrlPrompt                string
+	RemoveRemotePushUrls                  string
+	RemoveRemotePushUrlsTooltip           string
+	NoRemotePushUrls                      string
+	FetchUrls                             string
+	PushUrls                              string
+	SameAsFetchUrl                        string
+	SetRemoteHead                         string
+	SetRemoteHeadTooltip                  string
+	SetRemoteHeadAuto                     string
+	SetRemoteHeadAutoTooltip              string
+	SetRemoteHeadToBranch                 string
+	DeleteRemoteHead                      string
 	CheckoutCommitTooltip                 string
 	NoBranchesFoundAtCommitTooltip        string
 	GitFlowOptions                        string
@@ -623,6 +754,26 @@ type TranslationSet struct {
 	ResetInParentheses                    string
 	OpenFilteringMenu                     string
 	OpenFilteringMenuTooltip              string
+	OpenGlobalFinder                      string
+	OpenGlobalFinderTooltip               string
+	GlobalFinderTitle                     string
+	OpenCommandPalette                    string
+	OpenCommandPaletteTooltip             string
+	OpenPluginsMenu                       string
+	OpenPluginsMenuTooltip                string
+	PluginBindingUnknownContext           string
+	RecordMacro                           string
+	StopRecordingMacro                    string
+	RecordMacroTooltip                    string
+	RecordMacroIntoRegister               string
+	RecordingMacro                        string
+	ReplayMacro                           string
+	ReplayMacroTooltip                    string
+	ReplayMacroCount                      string
+	InvalidReplayMacroCount               string
+	NoMacrosRecorded                      string
+	MacroIsBeingReplayed                  string
+	CannotReplayMacroWhileRecording       string
 	FilterBy                              string
 	ExitFilterMode                        string
 	FilterPathOption                      string
@@ -737,6 +888,21 @@ type TranslationSet struct {
 	CommandLog                               string
 	ToggleShowCommandLog                     string
 	FocusCommandLog                          string
+	OpenCommandHistory                       string
+	CommandLogEntriesTitle                   string
+	CommandLogEntryDetailsTitle              string
+	CommandLogEntryDetails                   string
+	CommandLogEntryStderr                    string
+	RerunCommand                             string
+	RerunCommandTooltip                      string
+	RerunCommandPrompt                       string
+	CannotRerunCommandLogEntry               string
+	ExportCommandLog                         string
+	ExportCommandLogTooltip                  string
+	ExportCommandLogPrompt                   string
+	CommandLogExported                       string
+	OverwriteExportedFileTitle               string
+	OverwriteExportedFilePrompt              string
 	CommandLogHeader                         string
 	RandomTip                                string
 	ToggleWhitespaceInDiffView               string
@@ -772,6 +938,24 @@ type TranslationSet struct {
 	OpenLogMenuTooltip                       string
 	LogMenuTitle                             string
 	ToggleShowGitGraphAll                    string
+	ToggleWholeGitGraph                      string
+	ToggleWholeGitGraphTooltip               string
+	SplitCommit                              string
+	SplitCommitTooltip                       string
+	SplitCommitMenuPrompt                    string
+	SplitCommitHunksSection                  string
+	SplitCommitActionsSection                string
+	SplitCommitAddPart                       string
+	SplitCommitRemoveLastPart                string
+	SplitCommitIntoParts                     string
+	SplitCommitNeedsTwoParts                 string
+	SplitCommitPartIsEmpty                   string
+	SplitCommitSummaryTitle                  string
+	CannotSplitMergeCommit                   string
+	CannotSplitFirstCommit                   string
+	CannotSplitCommitWithSingleHunk          string
+	CannotSplitCommitWithBinaryFiles         string
+	LoadingHunksStatus                       string
 	ShowGitGraph                             string
 	ShowGitGraphTooltip                      string
 	SortOrder                                string
@@ -817,9 +1001,38 @@ type TranslationSet struct {
 	MovePatchIntoNewCommitTooltip            string
 	MovePatchIntoNewCommitBefore             string
 	MovePatchIntoNewCommitBeforeTooltip      string
+	ApplyPatchOntoOtherBranch                string
+	ApplyPatchOntoOtherBranchTooltip         string
+	ApplyPatchOntoOtherBranchPrompt          string
+	MovePatchToOtherBranch                   string
+	MovePatchToOtherBranchTooltip            string
+	MovePatchToOtherBranchPrompt             string
+	CommitSummaryOnBranchTitle               string
+	BranchNotFound                           string
+	CannotCommitPatchOnCheckedOutBranch      string
+	PatchCommittedOnBranch                   string
 	MovePatchToSelectedCommit                string
 	MovePatchToSelectedCommitTooltip         string
 	CopyPatchToClipboard                     string
+	SaveCustomPatch                          string
+	SaveCustomPatchTooltip                   string
+	SaveCustomPatchPrompt                    string
+	ExportCustomPatch                        string
+	ExportCustomPatchTooltip                 string
+	ExportCustomPatchPrompt                  string
+	LoadCustomPatch                          string
+	LoadCustomPatchTooltip                   string
+	ImportPatchFile                          string
+	ImportPatchFileTooltip                   string
+	ImportPatchFilePrompt                    string
+	InvalidPatchName                         string
+	OverwriteSavedPatchTitle                 string
+	OverwriteSavedPatchPrompt                string
+	CustomPatchSaved                         string
+	CustomPatchExported                      string
+	CustomPatchLoaded                        string
+	PatchChangesNotLoadedTitle               string
+	PatchChangesNotLoaded                    string
 	MustStageFilesAffectedByPatchTitle       string
 	MustStageFilesAffectedByPatchWarning     string
 	NoMatchesFor                             string
@@ -839,6 +1052,18 @@ type TranslationSet struct {
 	DetachWorktreeTooltip                    string
 	Switching                                string
 	RemoveWorktree                           string
+	WorkspaceTitle                           string
+	WorkspaceRepoTitle                       string
+	NoWorkspaceRepos                         string
+	WorkspaceRepoUnavailable                 string
+	WorkspaceRepoDirtyCount                  string
+	SwitchToWorkspaceRepoTooltip             string
+	FetchAllRepos                            string
+	FetchAllReposTooltip                     string
+	PullAllRepos                             string
+	PullAllReposTooltip                      string
+	WorkspaceActionFailed                    string
+	WorkspaceRepoStatus                      string
 	RemoveWorktreeTitle                      string
 	DetachWorktree                           string
 	DetachingWorktree                        string
@@ -948,6 +1173,8 @@ type Actions struct {
 	CheckoutBranchOrCommit           string
 	ForceCheckoutBranch              string
 	DeleteLocalBranch                string
+	RestackBranches                  string
+	PushStack                        string
 	Merge                            string
 	SquashMerge                      string
 	RebaseBranch                     string
@@ -962,6 +1189,8 @@ type Actions struct {
 	RewordCommit                     string
 	DropCommit                       string
 	EditCommit                       string
+	AbsorbStagedChanges              string
+	SplitCommit                      string
 	AmendCommit                      string
 	ResetCommitAuthor                string
 	SetCommitAuthor                  string
@@ -981,7 +1210,13 @@ type Actions struct {
 	CopyCommitAttributeToClipboard   string
 	CopyCommitTagsToClipboard        string
 	CopyPatchToClipboard             string
+	SaveCustomPatch                  string
+	ExportCustomPatch                string
+	LoadCustomPatch                  string
+	ImportCustomPatch                string
 	CustomCommand                    string
+	ReplayMacro                      string
+	RerunCommand                     string
 	DiscardAllChangesInFile          string
 	DiscardAllUnstagedChangesInFile  string
 	StageFile                        string
@@ -1001,6 +1236,8 @@ type Actions struct {
 	Commit                           string
 	Push                             string
 	Pull                             string
+	FetchAllRepos                    string
+	PullAllRepos                     string
 	OpenFile                         string
 	StashAllChanges                  string
 	StashAllChangesKeepIndex         string
@@ -1015,11 +1252,18 @@ type Actions struct {
 	MovePatchToSelectedCommit        string
 	MovePatchIntoIndex               string
 	MovePatchIntoNewCommit           string
+	ApplyPatchOntoOtherBranch        string
+	MovePatchToOtherBranch           string
 	DeleteRemoteBranch               string
 	SetBranchUpstream                string
 	AddRemote                        string
 	RemoveRemote                     string
 	UpdateRemote                     string
+	UpdateRemotePushUrl              string
+	AddRemotePushUrl                 string
+	RemoveRemotePushUrl              string
+	PruneRemote                      string
+	SetRemoteHead                    string
 	ApplyPatch                       string
 	Stash                            string
 	PopStash                         string
@@ -1038,6 +1282,8 @@ type Actions struct {
 	UpdateSubmodule                  string
 	CreateLightweightTag             string
 	CreateAnnotatedTag               string
+	CreateSignedTag                  string
+	EditTagMessage                   string
 	DeleteLocalTag                   string
 	DeleteRemoteTag                  string
 	PushTag                          string
@@ -1131,6 +1377,20 @@ func EnglishTranslationSet() *TranslationSet {
 		CommitChangesWithEditor:              "Commit changes using git editor",
 		FindBaseCommitForFixup:               "Find base commit for fixup",
 		FindBaseCommitForFixupTooltip:        "Find the commit that your current changes are building upon, for the sake of amending/fixing up the commit. This spares you from having to look through your branch's commits one-by-one to see which commit should be amended/fixed up. See docs: <https://github.com/jesseduffield/lazygit/tree/master/docs/Fixup_Commits.md>",
+		AbsorbStagedChanges:                  "Absorb staged changes into commits",
+		AbsorbStagedChangesTooltip:           "Find the commit that each staged hunk belongs to (by blaming the lines it changes) and create a fixup commit for each of these commits. Optionally squash the fixup commits right away. Hunks that can't be attributed to a single commit of the current branch are left staged.",
+		AbsorbCreateFixupCommits:             "Create fixup commits",
+		AbsorbCreateAndSquashFixupCommits:    "Create fixup commits and squash them",
+		AbsorbingStatus:                      "Absorbing",
+		NoStagedChangesToAbsorb:              "There are no staged changes to absorb",
+		AbsorbNoHunksAttributed:              "None of the staged hunks could be attributed to a single commit of the current branch:",
+		AbsorbHunksLeftStaged:                "The following hunks could not be attributed to a single commit of the current branch and were left staged.",
+		AbsorbFixupsNotSquashed:              "The fixup commits were not squashed, so that these hunks stay staged.",
+		AbsorbedStagedChanges:                "Created fixup commits for {{count}} commit(s)",
+		AbsorbReasonNewFile:                  "new file",
+		AbsorbReasonSeveralCommits:           "belongs to several commits",
+		AbsorbReasonNotInCurrentBranch:       "commit is not part of the current branch",
+		AbsorbReasonNoTextualChanges:         "no textual changes",
 		NoBaseCommitsFound:                   "No base commits found",
 		MultipleBaseCommitsFoundStaged:       "Multiple base commits found. (Try staging fewer changes at once)",
 		MultipleBaseCommitsFoundUnstaged:     "Multiple base commits found. (Try staging some of the changes)",
@@ -1195,6 +1455,15 @@ func EnglishTranslationSet() *TranslationSet {
 		FilterLabelTrackedFiles:              "(only tracked)",
 		FilterLabelUntrackedFiles:            "(only untracked)",
 		FilterLabelConflictingFiles:          "(only conflicting)",
+		UntrackedFilesOptions:                "View untracked files options",
+		UntrackedFilesOptionsTooltip:         "Choose which untracked files to show. Showing untracked directories without the files in them is a lot faster if they contain many files.",
+		UntrackedFilesMenuTitle:              "Show untracked files",
+		UntrackedFilesNo:                     "None",
+		UntrackedFilesNormal:                 "Directories, but not the files in them",
+		UntrackedFilesAll:                    "All",
+		UntrackedDirectory:                   "The files in this untracked directory are not listed individually, because of the untracked files options ({{key}}) or the git.status.maxUntrackedFiles config. These are its untracked files:",
+		CannotEnterUntrackedDirectory:        "The files in this untracked directory are not listed individually. You can stage the whole directory, or show all untracked files ({{key}}).",
+		SlowStatus:                           "git status: {{duration}}",
 		NoChangedFiles:                       "No changed files",
 		SoftReset:                            "Soft reset",
 		AlreadyCheckedOutBranch:              "You have already checked out this branch",
@@ -1214,6 +1483,36 @@ func EnglishTranslationSet() *TranslationSet {
 		ForceDeleteBranchTitle:               "Force delete branch",
 		ForceDeleteBranchMessage:             "'{{.selectedBranchName}}' is not fully merged. Are you sure you want to delete it?",
 		ForceDeleteBranchesMessage:           "Some of the selected branches are not fully merged. Are you sure you want to delete them?",
+		CleanUpBranches:                      "Clean up branches",
+		CleanUpBranchesTooltip:               "Find local branches that have been merged (or squash-merged) into a main branch, whose upstream is gone, or that haven't been committed to for a while, and delete them in one go. Main branches and branches that are checked out in a worktree are never suggested.",
+		FindingBranchesToCleanUp:             "Finding branches to clean up...",
+		NoBranchesToCleanUp:                  "No branches to clean up",
+		BranchCleanupMergedInto:              "merged into {{.branchName}}",
+		BranchCleanupSquashMergedInto:        "squash-merged into {{.branchName}}",
+		BranchCleanupUpstreamGone:            "upstream gone",
+		BranchCleanupStale:                   "no commits for {{.days}} days",
+		BranchCleanupBranchesSection:         "Branches",
+		BranchCleanupActionsSection:          "Actions",
+		SelectAllBranches:                    "Select all",
+		SelectNoBranches:                     "Deselect all",
+		DeleteSelectedBranchesLocally:        "Delete selected local branches",
+		DeleteSelectedLocalAndRemoteBranches: "Delete selected local and remote branches",
+		NoBranchesSelected:                   "No branches selected",
+		CleanUpBranchesPrompt:                "Are you sure you want to delete {{.count}} local branch(es)? This can't be undone.",
+		CleanUpLocalAndRemoteBranchesPrompt:  "Are you sure you want to delete {{.count}} local branch(es), along with their remote branches (where they still exist)? This can't be undone.",
+		ViewBranchStackOptions:               "View stack options",
+		ViewBranchStackOptionsTooltip:        "Show the stack of branches that the selected branch is part of (i.e. branches that are built on top of each other), and restack, push, or open pull requests for all of them at once.",
+		BranchStack:                          "Branch stack",
+		BranchIsNotPartOfAStack:              "The selected branch is not part of a stack of branches",
+		RestackBranches:                      "Restack onto {{.baseBranch}}",
+		RestackBranchesTooltip:               "Rebase the topmost branch of the stack onto the base branch with --update-refs, so that all branches of the stack are moved along with it.",
+		CannotRestackForkedStack:             "Cannot restack a stack in which several branches are built on the same branch",
+		PushStack:                            "Push all branches (force with lease)",
+		PushStackTooltip:                     "Push all branches of the stack using --force-with-lease. Branches without an upstream are pushed to the origin remote and their upstream is set.",
+		NoRemotesToPushStackTo:               "There is no remote to push the branches of the stack to",
+		OpenStackPullRequests:                "Open pull requests for all branches",
+		OpenStackPullRequestsTooltip:         "Open a pull request for each branch of the stack in the browser, targeting the branch below it (or the base branch for the bottom branch).",
+		StackNotPushed:                       "Not all branches of the stack have an upstream; push them first",
 		RebaseBranch:                         "Rebase",
 		RebaseBranchTooltip:                  "Rebase the checked-out branch onto the selected branch.",
 		CantRebaseOntoSelf:                   "You cannot rebase a branch onto itself",
@@ -1379,10 +1678,40 @@ func EnglishTranslationSet() *TranslationSet {
 		SelectHunk:                           "Select hunks",
 		SelectLineByLine:                     "Select line-by-line",
 		ToggleSelectHunkTooltip:              "Toggle line-by-line vs. hunk selection mode.",
+		ToggleSideBySideView:                 "Toggle side-by-side view",
+		ToggleSideBySideViewTooltip:          "Show the old version of the file on the left and the new version on the right, instead of a unified diff. Staging works the same in both layouts.",
 		HunkStagingHint:                      englishHunkStagingHint,
 		ToggleSelectionForPatch:              `Toggle lines in patch`,
 		EditHunk:                             `Edit hunk`,
 		EditHunkTooltip:                      "Edit selected hunk in external editor.",
+		EditHunkInline:                       `Edit hunk inline`,
+		EditHunkInlineTooltip:                "Edit the added lines of the selected hunk right here in the main view. While typing, lazygit checks whether the edited hunk still applies; confirming applies it to the index.",
+		ApplyEditedHunk:                      "Apply edited hunk",
+		HunkEditorTitle:                      "Edit hunk",
+		HunkEditorTitleUnstaged:              "Edit hunk (unstaged)",
+		HunkEditorTitleStaged:                "Edit hunk (staged)",
+		HunkEditorFooter:                     "Press {{.confirmKeybinding}} to apply, {{.cancelKeybinding}} to cancel",
+		HunkEditorChecking:                   "Checking...",
+		HunkEditorPatchApplies:               "Applies cleanly (+{{.additions}} -{{.deletions}})",
+		HunkEditorInvalidPrefix:              "Line {{.line}}: lines must start with ' ', '+' or '-'",
+		HunkEditorOnlyAddedLinesEditable:     "Line {{.line}}: only added lines can be edited",
+		HunkEditorNoChanges:                  "The edited hunk doesn't contain any changes",
+		CannotEditHunkWithNoNewlineMarker:    "Hunks at the end of a file that has no trailing newline can't be edited inline; use the external editor instead",
+		StageMatchingLines:                   "Stage/discard lines matching regex",
+		StageMatchingLinesTooltip:            "Stage, unstage or discard all changed lines that match a regular expression, either in the current file or in all files. Useful e.g. for staging all import changes but not the logic changes, or for dropping all debug print statements before committing.",
+		MatchingLinesRegexTitle:              "Regular expression for changed lines",
+		MatchingLinesMenuTitle:               "Lines matching '{{regex}}'",
+		StageMatchingLinesInFile:             "Stage matching lines in this file",
+		StageMatchingLinesInAllFiles:         "Stage matching lines in all files",
+		UnstageMatchingLinesInFile:           "Unstage matching lines in this file",
+		UnstageMatchingLinesInAllFiles:       "Unstage matching lines in all files",
+		DiscardMatchingLinesInFile:           "Discard matching lines in this file",
+		DiscardMatchingLinesInAllFiles:       "Discard matching lines in all files",
+		DiscardMatchingLinesPrompt:           "Are you sure you want to discard all unstaged lines matching '{{regex}}'? This can't be undone.",
+		NoChangedLinesMatch:                  "No changed lines match '{{regex}}'",
+		StagedMatchingLines:                  "Staged {{lineCount}} line(s) in {{fileCount}} file(s)",
+		UnstagedMatchingLines:                "Unstaged {{lineCount}} line(s) in {{fileCount}} file(s)",
+		DiscardedMatchingLines:               "Discarded {{lineCount}} line(s) in {{fileCount}} file(s)",
 		ToggleStagingView:                    "Switch view",
 		ToggleStagingViewTooltip:             "Switch to other view (staged/unstaged changes).",
 		ReturnToFilesPanel:                   `Return to files panel`,
@@ -1455,6 +1784,7 @@ func EnglishTranslationSet() *TranslationSet {
 		RewordNotSupported:                   "Rewording commits while interactively rebasing is not currently supported",
 		ChangingThisActionIsNotAllowed:       "Changing this kind of rebase todo entry is not allowed",
 		NotAllowedMidCherryPickOrRevert:      "This action is not allowed while cherry-picking or reverting",
+		NotAllowedForCommitsOfOtherBranches:  "This action is not allowed for commits of other branches, or while such commits are shown right next to the selection",
 		PickIsOnlyAllowedDuringRebase:        "This action is only allowed while rebasing",
 		DroppingMergeRequiresSingleSelection: "Dropping a merge commit requires a single selected item",
 		CherryPickCopy:                       "Copy (cherry-pick)",
@@ -1667,7 +1997,54 @@ func EnglishTranslationSet() *TranslationSet {
 		CreatingTag:                    "Creating tag",
 		ForceTag:                       "Force Tag",
 		ForceTagPrompt:                 "The tag '{{.tagName}}' exists already. Press {{.cancelKey}} to cancel, or {{.confirmKey}} to overwrite.",
+		DeleteTagsTitle:                "Delete selected tags?",
+		DeleteLocalTags:                "Delete local tags",
+		DeleteRemoteTags:               "Delete remote tags",
+		DeleteLocalAndRemoteTags:       "Delete local and remote tags",
+		RemoteTagsDeletedMessage:       "Remote tags deleted",
+		SelectRemoteTagsUpstream:       "Remote from which to remove the selected tags:",
+		DeleteRemoteTagsPrompt:         "Are you sure you want to delete the selected tags from '{{.upstream}}'?",
+		DeleteLocalAndRemoteTagsPrompt: "Are you sure you want to delete the selected tags from both your machine and from '{{.upstream}}'?",
+		PushTagsTitle:                  "Remote to push the selected tags to:",
+		PushingTagStatus:               "Pushing tags",
+		NewSignedTag:                   "New signed tag",
+		NewSignedTagTooltip:            "Create a new signed tag from the current commit. You'll be asked whether to sign with your default key or with a specific key, and then prompted to enter a tag name and description.",
+		SignWithDefaultKey:             "Sign with default key",
+		SignWithSpecificKey:            "Sign with specific key...",
+		SigningKeyTitle:                "Signing key ID:",
+		NewNextVersionTag:              "New next version tag",
+		NewNextVersionTagTooltip:       "Create a new tag from the current commit, pre-filled with the next semantic version. The version is derived from the latest tag and the commits made since then: a breaking change bumps the major version, a 'feat' commit bumps the minor version, and anything else bumps the patch version.",
+		LatestTagIsNotSemver:           "The latest tag '{{.tagName}}' is not a semantic version",
+		EditTagMessage:                 "Edit tag message",
+		EditTagMessageTooltip:          "Edit the message of the selected annotated tag. The tag keeps pointing at the same commit.",
+		CannotEditLightweightTag:       "Lightweight tags have no message. Delete the tag and create an annotated one instead.",
+		UpdatingTagMessage:             "Updating tag message",
+		TagSignature:                   "Signature",
+		TagSignatureValid:              "valid",
+		TagSignatureInvalid:            "could not be verified",
 		FetchRemoteTooltip:             "Fetch updates from the remote repository. This retrieves new commits and branches without merging them into your local branches.",
+		PruneRemote:                    "Prune stale remote branches",
+		PruneRemoteTooltip:             "List the remote-tracking branches that no longer exist on the selected remote, then fetch from the remote and remove them (git fetch --prune).",
+		PruneRemotePrompt:              "The following remote-tracking branches no longer exist on '{{.remoteName}}' and will be removed:\n\n{{.branches}}\n\nContinue?",
+		NoStaleRemoteBranches:          "No stale remote-tracking branches",
+		CheckingForStaleBranchesStatus: "Checking for stale branches",
+		EditRemotePushUrl:              "Edit push URLs",
+		EditRemotePushUrlTooltip:       "Set separate URLs for pushing to the selected remote, while still fetching from its regular URL. If a remote has several push URLs, pushing goes to all of them.",
+		EditRemotePushUrlPrompt:        `Enter push url for {{.remoteName}} (empty to remove it):`,
+		AddRemotePushUrl:               "Add push URL",
+		AddRemotePushUrlPrompt:         `Enter new push url for {{.remoteName}}:`,
+		RemoveRemotePushUrls:           "Push to fetch URL again",
+		RemoveRemotePushUrlsTooltip:    "Remove all push URLs of the remote, so that pushing goes to its fetch URL again.",
+		NoRemotePushUrls:               "The remote has no push URLs.",
+		FetchUrls:                      "Fetch URLs",
+		PushUrls:                       "Push URLs",
+		SameAsFetchUrl:                 "(same as fetch URL)",
+		SetRemoteHead:                  "Set remote HEAD",
+		SetRemoteHeadTooltip:           "Set the default branch of the selected remote (refs/remotes/<remote>/HEAD).",
+		SetRemoteHeadAuto:              "Query remote for its default branch",
+		SetRemoteHeadAutoTooltip:       "Ask the remote which branch its HEAD points to (git remote set-head --auto).",
+		SetRemoteHeadToBranch:          "Set to branch",
+		DeleteRemoteHead:               "Delete remote HEAD",
 		CheckoutCommitTooltip:          "Checkout the selected commit as a detached HEAD.",
 		NoBranchesFoundAtCommitTooltip: "No branches found at selected commit.",
 		GitFlowOptions:                 "Show git-flow options",
@@ -1715,6 +2092,26 @@ func EnglishTranslationSet() *TranslationSet {
 		ResetInParentheses:               "(Reset)",
 		OpenFilteringMenu:                "View filter options",
 		OpenFilteringMenuTooltip:         "View options for filtering the commit log, so that only commits matching the filter are shown.",
+		OpenGlobalFinder:                 "Find anything",
+		OpenGlobalFinderTooltip:          "Search branches, remote branches, tags, commits, stash entries, files and worktrees at once. Picking a result focuses the panel that shows it and selects it there.",
+		GlobalFinderTitle:                "Find",
+		OpenCommandPalette:               "Command palette",
+		OpenCommandPaletteTooltip:        "Search all actions of all panels, including custom commands, by their description and execute one of them. The panel that the action belongs to is focused first.",
+		OpenPluginsMenu:                  "Plugins",
+		OpenPluginsMenuTooltip:           "Show the menu items added by plugins.",
+		PluginBindingUnknownContext:      "Plugin {{.path}} binds key {{.key}} in unknown context {{.context}}",
+		RecordMacro:                      "Record macro",
+		StopRecordingMacro:               "Stop recording macro",
+		RecordMacroTooltip:               "Record the keys you press into a register, so that you can replay them later. Press again to stop recording.",
+		RecordMacroIntoRegister:          "Record macro into register",
+		RecordingMacro:                   "Recording macro @%s",
+		ReplayMacro:                      "Replay macro",
+		ReplayMacroTooltip:               "Replay the keys recorded into a register. Before each key, lazygit waits until the previous one has finished running.",
+		ReplayMacroCount:                 "Number of times to replay",
+		InvalidReplayMacroCount:          "Invalid number of times: %s",
+		NoMacrosRecorded:                 "No macros recorded yet",
+		MacroIsBeingReplayed:             "A macro is being replayed",
+		CannotReplayMacroWhileRecording:  "Cannot replay a macro while recording one",
 		FilterBy:                         "Filter by",
 		ExitFilterMode:                   "Stop filtering",
 		FilterPathOption:                 "Enter path to filter by",
@@ -1829,6 +2226,21 @@ func EnglishTranslationSet() *TranslationSet {
 		ErrWorktreeMovedOrRemoved:                "Cannot find worktree. It might have been moved or removed ¯\\_(ツ)_/¯",
 		ToggleShowCommandLog:                     "Toggle show/hide command log",
 		FocusCommandLog:                          "Focus command log",
+		OpenCommandHistory:                       "Open command history",
+		CommandLogEntriesTitle:                   "Command history",
+		CommandLogEntryDetailsTitle:              "Command details",
+		CommandLogEntryDetails:                   "Time: {{.time}}\nAction: {{.action}}\nCommand: {{.command}}\nDuration: {{.duration}}\nExit code: {{.exitCode}}",
+		CommandLogEntryStderr:                    "Error output:",
+		RerunCommand:                             "Re-run command",
+		RerunCommandTooltip:                      "Run the selected command again, with the same arguments. Messages about things that lazygit did without running a command cannot be re-run.",
+		RerunCommandPrompt:                       "Are you sure you want to run this command again?\n\n{{.command}}",
+		CannotRerunCommandLogEntry:               "Only commands that lazygit ran itself can be re-run",
+		ExportCommandLog:                         "Export command history",
+		ExportCommandLogTooltip:                  "Write the command history (only the entries matching the current filter, if any) to a file, oldest first. The commands can be run as a shell script; everything else is commented out.",
+		ExportCommandLogPrompt:                   "Export command history to file:",
+		CommandLogExported:                       "Exported {{.count}} entries to {{.path}}",
+		OverwriteExportedFileTitle:               "Overwrite file",
+		OverwriteExportedFilePrompt:              "'{{.path}}' already exists. Overwrite it?",
 		CommandLogHeader:                         "You can hide/focus this panel by pressing '%s'\n",
 		RandomTip:                                "Random tip",
 		ToggleWhitespaceInDiffView:               "Toggle whitespace",
@@ -1863,6 +2275,24 @@ func EnglishTranslationSet() *TranslationSet {
 		OpenLogMenuTooltip:                       "View options for commit log e.g. changing sort order, hiding the git graph, showing the whole git graph.",
 		LogMenuTitle:                             "Commit Log Options",
 		ToggleShowGitGraphAll:                    "Toggle show whole git graph (pass the `--all` flag to `git log`)",
+		ToggleWholeGitGraph:                      "Toggle whole git graph",
+		ToggleWholeGitGraphTooltip:               "Show the commits of all branches in the commits panel (passing the `--all` flag to `git log`). Commits that are not on the checked-out branch can be checked out, cherry-picked and diffed, but not rebased.",
+		SplitCommit:                              "Split commit",
+		SplitCommitTooltip:                       "Split the selected commit into several commits. Each hunk of the commit is assigned to one of the new commits, and you are asked for a commit message for each of them.",
+		SplitCommitMenuPrompt:                    "Press enter on a hunk to move it to the next commit. The new commits are created in the order of their numbers.",
+		SplitCommitHunksSection:                  "Hunks",
+		SplitCommitActionsSection:                "Actions",
+		SplitCommitAddPart:                       "Add another commit",
+		SplitCommitRemoveLastPart:                "Remove last commit",
+		SplitCommitIntoParts:                     "Split into {{count}} commits",
+		SplitCommitNeedsTwoParts:                 "A commit must be split into at least two commits",
+		SplitCommitPartIsEmpty:                   "No hunks are assigned to commit {{part}}",
+		SplitCommitSummaryTitle:                  "Commit summary ({{part}} of {{count}})",
+		CannotSplitMergeCommit:                   "Merge commits can't be split",
+		CannotSplitFirstCommit:                   "The first commit of the repository can't be split",
+		CannotSplitCommitWithSingleHunk:          "This commit has only one hunk, so it can't be split",
+		CannotSplitCommitWithBinaryFiles:         "Commits that change binary files can't be split",
+		LoadingHunksStatus:                       "Loading hunks",
 		ShowGitGraph:                             "Show git graph",
 		ShowGitGraphTooltip:                      "Show or hide the git graph in the commit log.\n\nThe default can be changed in the config file with the key 'git.log.showGraph'.",
 		SortOrder:                                "Sort order",
@@ -1907,9 +2337,38 @@ func EnglishTranslationSet() *TranslationSet {
 		MovePatchIntoNewCommitTooltip:            "Move the patch out of its commit and into a new commit sitting on top of the original commit. This is achieved by starting an interactive rebase at the original commit, applying the patch in reverse, then applying the patch to the index and committing it as a new commit, before continuing the rebase to completion. If later commits depend on the patch, you may need to resolve conflicts.",
 		MovePatchIntoNewCommitBefore:             "Move patch into new commit before the original commit",
 		MovePatchIntoNewCommitBeforeTooltip:      "Move the patch out of its commit and into a new commit before the original commit. This works best when the custom patch contains only entire hunks or even entire files; if it contains partial hunks, you are likely to get conflicts.",
+		ApplyPatchOntoOtherBranch:                "Apply patch onto other branch",
+		ApplyPatchOntoOtherBranchTooltip:         "Apply the patch onto another branch and commit it there, leaving the original commit unchanged. The branch must not be checked out; the patch is applied in a temporary worktree.",
+		ApplyPatchOntoOtherBranchPrompt:          "Apply patch onto branch",
+		MovePatchToOtherBranch:                   "Move patch to other branch",
+		MovePatchToOtherBranchTooltip:            "Commit the patch onto another branch and remove it from its original commit. The branch must not be checked out; the patch is applied in a temporary worktree.",
+		MovePatchToOtherBranchPrompt:             "Move patch to branch",
+		CommitSummaryOnBranchTitle:               "Commit summary (on {{.branch}})",
+		BranchNotFound:                           "Branch '{{.branch}}' not found",
+		CannotCommitPatchOnCheckedOutBranch:      "Cannot commit the patch onto branch '{{.branch}}' because it is checked out in worktree '{{.worktree}}'. Apply the patch there instead.",
+		PatchCommittedOnBranch:                   "Patch committed onto branch '{{.branch}}'",
 		MovePatchToSelectedCommit:                "Move patch to selected commit (%s)",
 		MovePatchToSelectedCommitTooltip:         "Move the patch out of its original commit and into the selected commit. This is achieved by starting an interactive rebase at the original commit, applying the patch in reverse, then continuing the rebase up to the selected commit, before applying the patch forward and amending the selected commit. The rebase is then continued to completion. If commits between the source and destination commit depend on the patch, you may need to resolve conflicts.",
 		CopyPatchToClipboard:                     "Copy patch to clipboard",
+		SaveCustomPatch:                          "Save patch",
+		SaveCustomPatchTooltip:                   "Save the patch under a name in the git directory so that it can be loaded again later, e.g. after resetting it.",
+		SaveCustomPatchPrompt:                    "Patch name",
+		ExportCustomPatch:                        "Export patch to file",
+		ExportCustomPatchTooltip:                 "Write the patch to a file that can be applied with `git apply` or imported again later.",
+		ExportCustomPatchPrompt:                  "Export patch to",
+		LoadCustomPatch:                          "Load custom patch",
+		LoadCustomPatchTooltip:                   "Add the changes of a saved patch or of a .patch file to the custom patch of the selected commit. The patch building view then shows which lines of which files are included.",
+		ImportPatchFile:                          "Import .patch file",
+		ImportPatchFileTooltip:                   "Add the changes of a patch file (e.g. one created with `git diff` or `git format-patch`) to the custom patch.",
+		ImportPatchFilePrompt:                    "Import patch from",
+		InvalidPatchName:                         "Patch name must not be empty or contain slashes",
+		OverwriteSavedPatchTitle:                 "Overwrite saved patch",
+		OverwriteSavedPatchPrompt:                "A patch named '{{.name}}' already exists. Overwrite it?",
+		CustomPatchSaved:                         "Patch saved",
+		CustomPatchExported:                      "Patch exported",
+		CustomPatchLoaded:                        "Patch loaded",
+		PatchChangesNotLoadedTitle:               "Some changes not loaded",
+		PatchChangesNotLoaded:                    "Some changes of the following files are not part of the selected commit and were not added to the custom patch:\n\n{{.files}}",
 		MustStageFilesAffectedByPatchTitle:       "Must stage files",
 		MustStageFilesAffectedByPatchWarning:     "Applying a patch to the index requires staging the unstaged files that are affected by the patch. Note that you might get conflicts when applying the patch. Continue?",
 		NoMatchesFor:                             "No matches for '%s' %s",
@@ -1931,6 +2390,18 @@ func EnglishTranslationSet() *TranslationSet {
 		DetachWorktreeTooltip:                    "This will run `git checkout --detach` on the worktree so that it stops hogging the branch, but the worktree's working tree will be left alone.",
 		Switching:                                "Switching",
 		RemoveWorktree:                           "Remove worktree",
+		WorkspaceTitle:                           "Workspace",
+		WorkspaceRepoTitle:                       "Repository",
+		NoWorkspaceRepos:                         "No repositories found. Configure them with 'workspace.repos' or 'workspace.scanDirs'.",
+		WorkspaceRepoUnavailable:                 "unavailable",
+		WorkspaceRepoDirtyCount:                  "{{.count}} changed",
+		SwitchToWorkspaceRepoTooltip:             "Switch to the selected repository.",
+		FetchAllRepos:                            "Fetch all repositories",
+		FetchAllReposTooltip:                     "Fetch in all repositories of the workspace at once.",
+		PullAllRepos:                             "Pull all repositories",
+		PullAllReposTooltip:                      "Fast-forward the checked out branch of every repository of the workspace that has an upstream. Repositories whose branch can't be fast-forwarded are left alone.",
+		WorkspaceActionFailed:                    "Failed in the following repositories:",
+		WorkspaceRepoStatus:                      "Status",
 		RemoveWorktreeTitle:                      "Remove worktree",
 		RemoveWorktreePrompt:                     "Are you sure you want to remove worktree '{{.worktreeName}}'?",
 		ForceRemoveWorktreePrompt:                "'{{.worktreeName}}' contains modified or untracked files, or submodules (or all of these). Are you sure you want to remove it?",
@@ -2001,6 +2472,8 @@ func EnglishTranslationSet() *TranslationSet {
 			ForceCheckoutBranch:              "Force checkout branch",
 			CheckoutBranchOrCommit:           "Checkout branch or commit",
 			DeleteLocalBranch:                "Delete local branch",
+			RestackBranches:                  "Restack branches",
+			PushStack:                        "Push branch stack",
 			Merge:                            "Merge",
 			SquashMerge:                      "Squash merge",
 			RebaseBranch:                     "Rebase branch",
@@ -2013,6 +2486,8 @@ func EnglishTranslationSet() *TranslationSet {
 			RewordCommit:                     "Reword commit",
 			DropCommit:                       "Drop commit",
 			EditCommit:                       "Edit commit",
+			AbsorbStagedChanges:              "Absorb staged changes",
+			SplitCommit:                      "Split commit",
 			AmendCommit:                      "Amend commit",
 			ResetCommitAuthor:                "Reset commit author",
 			SetCommitAuthor:                  "Set commit author",
@@ -2022,6 +2497,8 @@ func EnglishTranslationSet() *TranslationSet {
 			SquashAllAboveFixupCommits:       "Squash all above fixup commits",
 			CreateLightweightTag:             "Create lightweight tag",
 			CreateAnnotatedTag:               "Create annotated tag",
+			CreateSignedTag:                  "Create signed tag",
+			EditTagMessage:                   "Edit tag message",
 			CopyCommitMessageToClipboard:     "Copy commit message to clipboard",
 			CopyCommitMessageBodyToClipboard: "Copy commit message body to clipboard",
 			CopyCommitSubjectToClipboard:     "Copy commit subject to clipboard",
@@ -2032,9 +2509,15 @@ func EnglishTranslationSet() *TranslationSet {
 			CopyCommitAuthorToClipboard:      "Copy commit author to clipboard",
 			CopyCommitAttributeToClipboard:   "Copy to clipboard",
 			CopyPatchToClipboard:             "Copy patch to clipboard",
+			SaveCustomPatch:                  "Save custom patch",
+			ExportCustomPatch:                "Export custom patch",
+			LoadCustomPatch:                  "Load custom patch",
+			ImportCustomPatch:                "Import custom patch",
 			MoveCommitUp:                     "Move commit up",
 			MoveCommitDown:                   "Move commit down",
 			CustomCommand:                    "Custom command",
+			ReplayMacro:                      "Replay macro @%s",
+			RerunCommand:                     "Re-run command",
 			DiscardAllChangesInFile:          "Discard all changes in selected file(s)",
 			DiscardAllUnstagedChangesInFile:  "Discard all unstaged changes selected file(s)",
 			StageFile:                        "Stage file",
@@ -2054,6 +2537,8 @@ func EnglishTranslationSet() *TranslationSet {
 			Commit:                           "Commit",
 			Push:                             "Push",
 			Pull:                             "Pull",
+			FetchAllRepos:                    "Fetch all repositories",
+			PullAllRepos:                     "Pull all repositories",
 			OpenFile:                         "Open file",
 			StashAllChanges:                  "Stash all changes",
 			StashAllChangesKeepIndex:         "Stash all changes and keep index",
@@ -2068,11 +2553,18 @@ func EnglishTranslationSet() *TranslationSet {
 			MovePatchToSelectedCommit:        "Move patch to selected commit",
 			MovePatchIntoIndex:               "Move patch into index",
 			MovePatchIntoNewCommit:           "Move patch into new commit",
+			ApplyPatchOntoOtherBranch:        "Apply patch onto other branch",
+			MovePatchToOtherBranch:           "Move patch to other branch",
 			DeleteRemoteBranch:               "Delete remote branch",
 			SetBranchUpstream:                "Set branch upstream",
 			AddRemote:                        "Add remote",
 			RemoveRemote:                     "Remove remote",
 			UpdateRemote:                     "Update remote",
+			UpdateRemotePushUrl:              "Update remote push URL",
+			AddRemotePushUrl:                 "Add remote push URL",
+			RemoveRemotePushUrl:              "Remove remote push URL",
+			PruneRemote:                      "Prune remote",
+			SetRemoteHead:                    "Set remote HEAD",
 			ApplyPatch:                       "Apply patch",
 			Stash:                            "Stash",
 			PopStash:                         "Pop stash",
@@ -71,6 +71,12 @@ func (self *TestDriver) Wait(milliseconds int) {
 	time.Sleep(time.Duration(milliseconds) * time.Millisecond)
 }
 
+// for when lazygit was made to do something from outside of the gui, e.g.
+// through the remote-control socket; waits until it's done processing it
+func (self *TestDriver) WaitTillIdle() {
+	self.gui.WaitTillIdle()
+}
+
 func (self *TestDriver) SetCaption(caption string) {
 	self.gui.SetCaption(caption)
 }
@@ -58,6 +58,10 @@ func (self *Views) Status() *ViewDriver {
 	return self.regularView("status")
 }
 
+func (self *Views) Workspace() *ViewDriver {
+	return self.regularView("workspace")
+}
+
 func (self *Views) Submodules() *ViewDriver {
 	return self.regularView("submodules")
 }
@@ -140,6 +144,14 @@ func (self *Views) CommitDescription() *ViewDriver {
 	return self.regularView("commitDescription")
 }
 
+func (self *Views) HunkEditor() *ViewDriver {
+	return self.regularView("hunkEditor")
+}
+
+func (self *Views) CommandLogEntries() *ViewDriver {
+	return self.regularView("commandLogEntries")
+}
+
 func (self *Views) Suggestions() *ViewDriver {
 	return self.regularView("suggestions")
 }
@@ -0,0 +1,91 @@
+package branch
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CleanUp = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Delete merged, squash-merged, gone and stale branches using the branch cleanup menu",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Git.LocalBranchSortOrder = "alphabetical"
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.
+			CloneIntoRemote("origin").
+			EmptyCommit("initial").
+			NewBranch("merged").
+			NewBranchFrom("squashed", "master").
+			CreateFileAndAdd("feature.txt", "one\n").
+			Commit("feature part 1").
+			UpdateFileAndAdd("feature.txt", "one\ntwo\n").
+			Commit("feature part 2").
+			NewBranchFrom("gone", "master").
+			CreateFileAndAdd("gone.txt", "gone\n").
+			Commit("gone work").
+			PushBranchAndSetUpstream("origin", "gone").
+			RunCommand([]string{"git", "push", "origin", "--delete", "gone"}).
+			NewBranchFrom("stale", "master").
+			CreateFileAndAdd("stale.txt", "stale\n").
+			RunCommandWithEnv([]string{"git", "commit", "-m", "old work"},
+				[]string{"GIT_AUTHOR_DATE=2020-01-01T00:00:00", "GIT_COMMITTER_DATE=2020-01-01T00:00:00"}).
+			NewBranchFrom("active", "master").
+			CreateFileAndAdd("active.txt", "active\n").
+			Commit("active work").
+			Checkout("master").
+			CreateFileAndAdd("feature.txt", "one\ntwo\n").
+			Commit("feature (squashed)")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().
+			Focus().
+			Lines(
+				Contains("master").IsSelected(),
+				Contains("active"),
+				Contains("gone"),
+				Contains("merged"),
+				Contains("squashed"),
+				Contains("stale"),
+			).
+			Press(keys.Branches.CleanUpBranches).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Clean up branches")).
+					TopLines(
+						Contains("--- Branches ---"),
+						Contains("[✓]").Contains("gone").Contains("upstream gone"),
+						Contains("[✓]").Contains("merged").Contains("merged into master"),
+						Contains("[✓]").Contains("squashed").Contains("squash-merged into master"),
+						Contains("[ ]").Contains("stale").Contains("no commits for 90 days"),
+					).
+					// Keep the merged branch
+					Select(Contains(" merged ")).
+					Confirm()
+
+				t.ExpectPopup().Menu().
+					Title(Equals("Clean up branches")).
+					TopLines(
+						Contains("--- Branches ---"),
+						Contains("[✓]").Contains("gone"),
+						Contains("[ ]").Contains("merged").IsSelected(),
+						Contains("[✓]").Contains("squashed"),
+						Contains("[ ]").Contains("stale"),
+					).
+					Select(Contains("Delete selected local branches")).
+					Confirm()
+
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Clean up branches")).
+					Content(Equals("Are you sure you want to delete 2 local branch(es)? This can't be undone.")).
+					Confirm()
+			}).
+			Lines(
+				Contains("master").IsSelected(),
+				Contains("active"),
+				Contains("merged"),
+				Contains("stale"),
+			)
+	},
+})
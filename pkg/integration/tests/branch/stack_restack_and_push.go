@@ -0,0 +1,90 @@
+package branch
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var StackRestackAndPush = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show a stack of branches, restack it onto the updated main branch, and push all of its branches",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Git.LocalBranchSortOrder = "alphabetical"
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.
+			CloneIntoRemote("origin").
+			EmptyCommit("initial").
+			NewBranch("feature-a").
+			EmptyCommit("a1").
+			NewBranch("feature-b").
+			EmptyCommit("b1").
+			NewBranch("feature-c").
+			EmptyCommit("c1").
+			Checkout("master").
+			EmptyCommit("master 2")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().
+			Focus().
+			Lines(
+				Contains("master").IsSelected(),
+				Contains("feature-a"),
+				Contains("feature-b"),
+				Contains("feature-c"),
+			).
+			Press(keys.Branches.ViewStackOptions).
+			Tap(func() {
+				t.ExpectPopup().Alert().
+					Title(Equals("Error")).
+					Content(Equals("The selected branch is not part of a stack of branches")).
+					Confirm()
+			}).
+			NavigateToLine(Contains("feature-b")).
+			Press(keys.Branches.ViewStackOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Branch stack")).
+					TopLines(
+						Equals("master"),
+						Equals("└─ feature-a"),
+						Equals("   └─ feature-b"),
+						Equals("      └─ feature-c"),
+					).
+					Select(Contains("Restack onto master")).
+					Confirm()
+			}).
+			Lines(
+				Contains("feature-c"),
+				Contains("feature-a"),
+				Contains("feature-b").IsSelected(),
+				Contains("master"),
+			)
+
+		t.Views().Commits().
+			Lines(
+				Contains("CI ◯ c1"),
+				Contains("CI ◯ * b1"),
+				Contains("CI ◯ * a1"),
+				Contains("CI ◯ master 2"),
+				Contains("CI ◯ initial"),
+			)
+
+		t.Views().Branches().
+			Focus().
+			Press(keys.Branches.ViewStackOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Branch stack")).
+					Select(Contains("Push all branches")).
+					Confirm()
+			}).
+			Lines(
+				Contains("feature-c ✓"),
+				Contains("feature-a ✓"),
+				Contains("feature-b ✓").IsSelected(),
+				Contains("master"),
+			)
+	},
+})
@@ -0,0 +1,60 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var AbsorbStagedChanges = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Absorb staged changes into the commits they belong to, leaving hunks that can't be attributed staged",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.NewBranch("mybranch").
+			CreateFileAndAdd("file1", "1\n2\n3\n4\n5\n").
+			Commit("1st commit").
+			CreateFileAndAdd("file2", "file2 content\n").
+			Commit("2nd commit").
+			CreateFileAndAdd("file3", "file3 content\n").
+			Commit("3rd commit").
+			UpdateFileAndAdd("file1", "1\n2\nthree\n4\n5\n").
+			UpdateFileAndAdd("file3", "file3 changed content\n").
+			CreateFileAndAdd("file4", "file4 content\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			Focus().
+			Press(keys.Files.AbsorbStagedChanges)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Absorb staged changes into commits")).
+			Select(Contains("Create fixup commits").DoesNotContain("squash")).
+			Confirm()
+
+		t.ExpectPopup().Alert().
+			Title(Equals("Absorb staged changes into commits")).
+			Content(
+				Contains("were left staged").
+					Contains("file4:1 (new file)").
+					DoesNotContain("file1").
+					DoesNotContain("file3"),
+			).
+			Confirm()
+
+		t.Views().Commits().
+			Lines(
+				Contains("fixup! 3rd commit"),
+				Contains("fixup! 1st commit"),
+				Contains("3rd commit"),
+				Contains("2nd commit"),
+				Contains("1st commit"),
+			)
+
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Equals("A  file4"),
+			)
+	},
+})
@@ -0,0 +1,60 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var AbsorbStagedChangesAndSquash = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Absorb staged hunks of one file into two different commits and squash the fixup commits",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.NewBranch("mybranch").
+			CreateFileAndAdd("file1", "1\n2\n3\n4\n5\n6\n7\n8\n").
+			Commit("1st commit").
+			UpdateFileAndAdd("file1", "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n").
+			Commit("2nd commit").
+			UpdateFileAndAdd("file1", "1\ntwo\n3\n4\n6\n7\n8\n9\nnew\nten\n").
+			UpdateFile("file1", "1\ntwo\n3\n4\n6\n7\n8\n9\nnew\nten\nunstaged\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			Focus().
+			Press(keys.Files.AbsorbStagedChanges)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Absorb staged changes into commits")).
+			Select(Contains("Create fixup commits and squash them")).
+			Confirm()
+
+		t.Views().Commits().
+			Lines(
+				Contains("2nd commit"),
+				Contains("1st commit"),
+			)
+
+		t.ExpectToast(Equals("Created fixup commits for 2 commit(s)"))
+
+		t.Views().Commits().
+			Focus().
+			NavigateToLine(Contains("1st commit"))
+
+		t.Views().Main().
+			Content(Contains("+two").Contains("+4\n+6").DoesNotContain("+5"))
+
+		t.Views().Commits().
+			NavigateToLine(Contains("2nd commit"))
+
+		t.Views().Main().
+			Content(Contains("+9\n+new\n+ten").DoesNotContain("+10"))
+
+		t.Views().Files().
+			Lines(
+				Equals(" M file1"),
+			)
+
+		t.FileSystem().FileContent("file1", Equals("1\ntwo\n3\n4\n6\n7\n8\n9\nnew\nten\nunstaged\n"))
+	},
+})
@@ -0,0 +1,57 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var AbsorbStagedChangesWithSpecialFileNames = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Absorb staged changes of files whose names git quotes or pads in diffs",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.NewBranch("mybranch").
+			CreateFileAndAdd("my file", "1\n2\n3\n").
+			Commit("1st commit").
+			CreateFileAndAdd("café", "1\n2\n3\n").
+			Commit("2nd commit").
+			UpdateFileAndAdd("my file", "1\ntwo\n3\n").
+			UpdateFileAndAdd("café", "1\n2\nthree\n").
+			CreateFileAndAdd("naïve", "new\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			Focus().
+			Press(keys.Files.AbsorbStagedChanges)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Absorb staged changes into commits")).
+			Select(Contains("Create fixup commits").DoesNotContain("squash")).
+			Confirm()
+
+		t.ExpectPopup().Alert().
+			Title(Equals("Absorb staged changes into commits")).
+			Content(
+				Contains("were left staged").
+					Contains("naïve:1 (new file)").
+					DoesNotContain("my file").
+					DoesNotContain("café"),
+			).
+			Confirm()
+
+		t.Views().Commits().
+			Lines(
+				Contains("fixup! 2nd commit"),
+				Contains("fixup! 1st commit"),
+				Contains("2nd commit"),
+				Contains("1st commit"),
+			)
+
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Equals("A  naïve"),
+			)
+	},
+})
@@ -0,0 +1,64 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ShowWholeGitGraph = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show the commits of all branches, cherry-pick a commit of another branch, and verify that rebasing it is not possible",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.
+			EmptyCommitWithDate("base", "2020-01-01 00:00:00").
+			NewBranch("other").
+			EmptyCommitWithDate("other one", "2020-01-02 00:00:00").
+			EmptyCommitWithDate("other two", "2020-01-03 00:00:00").
+			Checkout("master").
+			EmptyCommitWithDate("mine", "2020-01-04 00:00:00")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("mine").IsSelected(),
+				Contains("base"),
+			).
+			Press(keys.Commits.ToggleWholeGitGraph).
+			Lines(
+				Contains("mine").IsSelected(),
+				Contains("other two"),
+				Contains("other one"),
+				Contains("base"),
+			).
+			NavigateToLine(Contains("other two")).
+			Press(keys.Commits.SquashDown).
+			Tap(func() {
+				t.ExpectToast(Equals("Disabled: This action is not allowed for commits of other branches, or while such commits are shown right next to the selection"))
+			}).
+			Press(keys.Commits.CherryPickCopy).
+			Tap(func() {
+				t.Views().Information().Content(Contains("1 commit copied"))
+			}).
+			Press(keys.Commits.ToggleWholeGitGraph).
+			Lines(
+				Contains("mine"),
+				Contains("base"),
+			).
+			NavigateToLine(Contains("mine")).
+			Press(keys.Commits.PasteCommits).
+			Tap(func() {
+				t.ExpectPopup().Alert().
+					Title(Equals("Cherry-pick")).
+					Content(Contains("Are you sure you want to cherry-pick the 1 copied commit(s) onto this branch?")).
+					Confirm()
+			}).
+			Lines(
+				Contains("other two"),
+				Contains("mine").IsSelected(),
+				Contains("base"),
+			)
+	},
+})
@@ -0,0 +1,44 @@
+package file
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var MaxUntrackedFiles = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show untracked directories collapsed when they contain more untracked files than configured",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Git.Status.MaxUntrackedFiles = 2
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFile("build/a", "a")
+		shell.CreateFile("build/b", "b")
+		shell.CreateFile("docs/readme", "readme")
+		shell.CreateFile("new-file", "content")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			Focus().
+			Lines(
+				Equals("▼ /").IsSelected(),
+				Equals("  ?? build/"),
+				Equals("  ?? docs/"),
+				Equals("  ?? new-file"),
+			).
+			NavigateToLine(Contains("build/")).
+			// staging the whole directory brings the number of untracked files
+			// in untracked directories below the limit
+			PressPrimaryAction().
+			Lines(
+				Equals("▼ /"),
+				Equals("  ▼ build").IsSelected(),
+				Equals("    A  a"),
+				Equals("    A  b"),
+				Equals("  ▼ docs"),
+				Equals("    ?? readme"),
+				Equals("  ?? new-file"),
+			)
+	},
+})
@@ -0,0 +1,85 @@
+package file
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var UntrackedFilesOptions = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Switch between showing no untracked files, only untracked directories, and all untracked files",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("tracked-file", "content")
+		shell.Commit("initial commit")
+		shell.UpdateFile("tracked-file", "changed content")
+		shell.CreateFile("build/a", "a")
+		shell.CreateFile("build/b", "b")
+		shell.CreateFile("new-file", "content")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			Focus().
+			Lines(
+				Equals("▼ /"),
+				Equals("  ▼ build"),
+				Equals("    ?? a"),
+				Equals("    ?? b"),
+				Equals("  ?? new-file"),
+				Equals("   M tracked-file"),
+			).
+			Press(keys.Files.ViewUntrackedFilesOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Show untracked files")).
+					Select(Contains("Directories, but not the files in them")).
+					Confirm()
+			}).
+			Lines(
+				Equals("▼ /"),
+				Equals("  ?? build/"),
+				Equals("  ?? new-file"),
+				Equals("   M tracked-file"),
+			).
+			NavigateToLine(Contains("build/")).
+			Tap(func() {
+				t.Views().Main().
+					Content(Contains("The files in this untracked directory are not listed individually").
+						Contains("build/a").
+						Contains("build/b"))
+			}).
+			PressEnter().
+			Tap(func() {
+				t.ExpectPopup().Alert().
+					Title(Equals("Error")).
+					Content(Contains("You can stage the whole directory, or show all untracked files (U).")).
+					Confirm()
+			}).
+			Press(keys.Files.ViewUntrackedFilesOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Show untracked files")).
+					Select(Contains("None")).
+					Confirm()
+			}).
+			Lines(
+				Equals(" M tracked-file"),
+			).
+			Press(keys.Files.ViewUntrackedFilesOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Show untracked files")).
+					Select(Contains("All")).
+					Confirm()
+			}).
+			Lines(
+				Equals("▼ /"),
+				Equals("  ▼ build"),
+				Equals("    ?? a"),
+				Equals("    ?? b"),
+				Equals("  ?? new-file"),
+				Equals("   M tracked-file"),
+			)
+	},
+})
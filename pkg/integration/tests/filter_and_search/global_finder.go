@@ -0,0 +1,78 @@
+package filter_and_search
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var GlobalFinder = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Find items of several panels at once, grouped by panel, and jump to one of them",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Gui.FilterMode = "fuzzy"
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("initial commit")
+		shell.EmptyCommit("add login form")
+		shell.EmptyCommit("unrelated")
+		shell.NewBranch("feature-login")
+		shell.NewBranch("other")
+		shell.CreateLightweightTag("login-v1", "HEAD^")
+		shell.CreateLightweightTag("alpha", "HEAD")
+		shell.CreateFile("login.txt", "content")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Universal.OpenGlobalFinder)
+
+		t.ExpectSearch().
+			Type("login").
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Find")).
+			Lines(
+				Contains("--- Local branches ---"),
+				Contains("feature-login").IsSelected(),
+				Contains(""),
+				Contains("--- Tags ---"),
+				Contains("login-v1"),
+				Contains(""),
+				Contains("--- Commits ---"),
+				Contains("add login form"),
+				Contains(""),
+				Contains("--- Files ---"),
+				Contains("login.txt"),
+			).
+			Select(Contains("login-v1")).
+			Confirm()
+
+		t.Views().Tags().
+			IsFocused().
+			Lines(
+				Contains("alpha"),
+				Contains("login-v1").IsSelected(),
+			)
+
+		t.GlobalPress(keys.Universal.OpenGlobalFinder)
+
+		t.ExpectSearch().
+			Type("login form").
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Find")).
+			Select(Contains("add login form").DoesNotContain("login-v1")).
+			Confirm()
+
+		t.Views().Commits().
+			IsFocused().
+			Lines(
+				Contains("unrelated"),
+				Contains("add login form").IsSelected(),
+				Contains("initial commit"),
+			)
+	},
+})
@@ -0,0 +1,111 @@
+package interactive_rebase
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var SplitCommit = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Split a commit into two commits by assigning its hunks to them",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.
+			CreateFileAndAdd("file1", "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n13\n14\n15\n").
+			Commit("first").
+			UpdateFileAndAdd("file1", "1\ntwo\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n13\nfourteen\n15\n").
+			CreateFileAndAdd("file2", "file2 content\n").
+			SetAuthor("Other Author", "other@example.com").
+			Commit("big change").
+			SetAuthor("CI", "CI@example.com").
+			UpdateFileAndAdd("file2", "file2 content\nmore\n").
+			Commit("last")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("last").IsSelected(),
+				Contains("big change"),
+				Contains("first"),
+			).
+			NavigateToLine(Contains("big change")).
+			Press(keys.Commits.SplitCommit).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Split commit")).
+					ContainsLines(
+						Contains("1").Contains("file1").Contains("@@ -1,5 +1,5 @@"),
+						Contains("1").Contains("file1").Contains("@@ -11,5 +11,5 @@"),
+						Contains("1").Contains("file2").Contains("@@ -0,0 +1 @@"),
+					).
+					Select(Contains("@@ -11,5 +11,5 @@")).
+					Confirm()
+
+				t.ExpectPopup().Menu().
+					Title(Equals("Split commit")).
+					Select(Contains("file2")).
+					Confirm()
+
+				t.ExpectPopup().Menu().
+					Title(Equals("Split commit")).
+					ContainsLines(
+						Contains("1").Contains("file1").Contains("@@ -1,5 +1,5 @@"),
+						Contains("2").Contains("file1").Contains("@@ -11,5 +11,5 @@"),
+						Contains("2").Contains("file2").Contains("@@ -0,0 +1 @@").IsSelected(),
+					).
+					Select(Contains("Split into 2 commits")).
+					Confirm()
+
+				t.ExpectPopup().CommitMessagePanel().
+					Title(Equals("Commit summary (1 of 2)")).
+					InitialText(Equals("big change")).
+					Clear().
+					Type("change line two").
+					Confirm()
+
+				t.ExpectPopup().CommitMessagePanel().
+					Title(Equals("Commit summary (2 of 2)")).
+					InitialText(Equals("")).
+					Type("change line fourteen and add file2").
+					Confirm()
+			}).
+			Lines(
+				Contains("last"),
+				Contains("change line fourteen and add file2").IsSelected(),
+				Contains("change line two"),
+				Contains("first"),
+			)
+
+		t.Views().Main().
+			Content(Contains("Author: Other Author <other@example.com>"))
+
+		t.Views().Commits().
+			PressEnter()
+
+		t.Views().CommitFiles().
+			IsFocused().
+			Lines(
+				Equals("▼ /").IsSelected(),
+				Equals("  M file1"),
+				Equals("  A file2"),
+			)
+
+		t.Views().CommitFiles().PressEscape()
+
+		t.Views().Commits().
+			IsFocused().
+			NavigateToLine(Contains("change line two")).
+			PressEnter()
+
+		t.Views().CommitFiles().
+			IsFocused().
+			Lines(
+				Equals("M file1").IsSelected(),
+			)
+
+		t.Views().Main().
+			Content(Contains("+two").DoesNotContain("+fourteen"))
+	},
+})
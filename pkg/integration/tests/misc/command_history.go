@@ -0,0 +1,104 @@
+package misc
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CommandHistory = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Open the command history, filter it, look at an entry, re-run a command and export the filtered history",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("tracked-file", "content")
+		shell.Commit("initial commit")
+		shell.CreateFile("new-file", "content")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			Focus().
+			Lines(
+				Equals("?? new-file").IsSelected(),
+			).
+			PressPrimaryAction().
+			Lines(
+				Equals("A  new-file").IsSelected(),
+			).
+			PressPrimaryAction().
+			Lines(
+				Equals("?? new-file").IsSelected(),
+			).
+			Press(keys.Universal.ExtrasMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Command log")).
+			Select(Contains("Open command history")).
+			Confirm()
+
+		t.Views().CommandLogEntries().
+			IsFocused().
+			Title(Equals("Command history")).
+			Lines(
+				Contains("Unstage file").Contains("git rm --cached --force -- new-file").Contains("0").IsSelected(),
+				Contains("Stage file").Contains("git add -- new-file").Contains("0"),
+			).
+			FilterOrSearch("git add").
+			Lines(
+				Contains("git add -- new-file").IsSelected(),
+			).
+			Press(keys.Universal.GoInto)
+
+		t.ExpectPopup().Alert().
+			Title(Equals("Command details")).
+			Content(Contains("Action: Stage file").Contains("Command: git add -- new-file").Contains("Exit code: 0")).
+			Confirm()
+
+		t.Views().CommandLogEntries().
+			IsFocused().
+			Press(keys.CommandLog.RerunCommand)
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Re-run command")).
+			Content(Contains("git add -- new-file")).
+			Confirm()
+
+		t.Views().CommandLogEntries().
+			IsFocused().
+			Lines(
+				Contains("Re-run command").Contains("git add -- new-file").IsSelected(),
+				Contains("Stage file").Contains("git add -- new-file"),
+			).
+			Press(keys.CommandLog.ExportLog)
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Export command history to file:")).
+			Type("history.sh").
+			Confirm()
+
+		t.ExpectToast(Equals("Exported 2 entries to history.sh"))
+
+		t.FileSystem().FileContent("history.sh",
+			Contains("Stage file  (exit code 0,").
+				Contains("\ngit add -- new-file\n").
+				Contains("Re-run command  (exit code 0,").
+				DoesNotContain("Unstage file"))
+
+		t.Views().CommandLogEntries().
+			PressEscape().
+			Lines(
+				Contains("Re-run command").Contains("git add -- new-file").IsSelected(),
+				Contains("Unstage file").Contains("git rm --cached --force -- new-file"),
+				Contains("Stage file").Contains("git add -- new-file"),
+			).
+			PressEscape()
+
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Equals("▼ /"),
+				Equals("  ?? history.sh"),
+				Equals("  A  new-file"),
+			)
+	},
+})
@@ -0,0 +1,97 @@
+package misc
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var pluginSource = `
+def show_file_info():
+    file = lazygit.selected_file()
+    lazygit.render("Selected: " + file.path + " (" + file.short_status + ")", title = "File info")
+
+def commit_all():
+    for file in lazygit.files():
+        lazygit.stage(file.path)
+    lazygit.confirm("Commit", "Commit all files?", lambda: lazygit.commit("plugin commit"))
+
+def create_branch():
+    def on_confirm(name):
+        lazygit.new_branch(name)
+        lazygit.checkout(name)
+    lazygit.prompt("Branch name", on_confirm)
+
+lazygit.bind("X", "files", "Show file info", show_file_info)
+lazygit.add_menu_item("Commit all", commit_all, key = "c")
+lazygit.add_menu_item("Create branch", create_branch)
+`
+
+var Plugins = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Use keybindings and menu items added by a plugin in the repo's git dir",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("initial commit")
+		shell.CreateFile(".git/lazygit/plugins/test.star", pluginSource)
+		shell.CreateFile("file1", "content\n")
+		shell.CreateFile("file2", "content\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			Focus().
+			Lines(
+				Equals("▼ /").IsSelected(),
+				Equals("  ?? file1"),
+				Equals("  ?? file2"),
+			).
+			NavigateToLine(Contains("file1")).
+			Press("X")
+
+		t.Views().Main().
+			Title(Equals("File info")).
+			Content(Equals("Selected: file1 (??)"))
+
+		t.GlobalPress(keys.Universal.OpenPluginsMenu)
+		t.ExpectPopup().Menu().
+			Title(Equals("Plugins")).
+			Lines(
+				Contains("c Commit all"),
+				Contains("Create branch"),
+				Contains("Cancel"),
+			).
+			Select(Contains("Commit all")).
+			Confirm()
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Commit")).
+			Content(Equals("Commit all files?")).
+			Confirm()
+
+		t.Views().Files().
+			IsEmpty()
+
+		t.Views().Commits().
+			Lines(
+				Contains("plugin commit"),
+				Contains("initial commit"),
+			)
+
+		t.GlobalPress(keys.Universal.OpenPluginsMenu)
+		t.ExpectPopup().Menu().
+			Title(Equals("Plugins")).
+			Select(Contains("Create branch")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Branch name")).
+			Type("new-branch").
+			Confirm()
+
+		t.Views().Branches().
+			Lines(
+				Contains("new-branch"),
+				Contains("master"),
+			)
+	},
+})
@@ -0,0 +1,77 @@
+package misc
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var RecordAndReplayMacro = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Record rewording a commit and moving to the next one into a macro, then replay it for the remaining commits",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateNCommits(3)
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("commit 03").IsSelected(),
+				Contains("commit 02"),
+				Contains("commit 01"),
+			).
+			Press(keys.Universal.RecordMacro)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Record macro into register"))
+		t.GlobalPress("a")
+
+		t.Views().Information().Content(Contains("Recording macro @a"))
+
+		t.Views().Commits().
+			IsFocused().
+			Press(keys.Commits.RenameCommit)
+
+		t.ExpectPopup().CommitMessagePanel().
+			Type("!").
+			Confirm()
+
+		t.Views().Commits().
+			IsFocused().
+			SelectNextItem().
+			Press(keys.Universal.RecordMacro)
+
+		t.Views().Information().Content(DoesNotContain("Recording macro"))
+
+		t.Views().Commits().
+			Lines(
+				Contains("commit 03!"),
+				Contains("commit 02").IsSelected(),
+				Contains("commit 01"),
+			).
+			Press(keys.Universal.ReplayMacro)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Replay macro")).
+			Lines(
+				Contains("a").Contains("r!<enter><down>"),
+				Contains("Cancel"),
+			)
+		t.GlobalPress("a")
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Number of times to replay")).
+			Clear().
+			Type("2").
+			Confirm()
+
+		t.Views().Commits().
+			IsFocused().
+			Lines(
+				Contains("commit 03!"),
+				Contains("commit 02!"),
+				Contains("commit 01!").IsSelected(),
+			)
+	},
+})
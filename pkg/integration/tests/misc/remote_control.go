@@ -0,0 +1,174 @@
+package misc
+
+import (
+	"bufio"
+	"encoding/json"
+	"net"
+	"os"
+	"time"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var RemoteControl = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Drive lazygit through the socket passed with --listen",
+	ExtraCmdArgs: []string{"--listen={{.actualPath}}/lazygit.sock"},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Gui.UseHunkModeInStagingView = false
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file1", "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n")
+		shell.Commit("initial commit")
+		shell.UpdateFile("file1", "1\n2\n3\n4\n5\n6\n7\neight\n9\n10\n")
+		shell.CreateFile("file2", "content\n")
+		shell.CreateFileAndAdd("file3", "staged\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		// wait for the initial load
+		t.Views().Files().
+			Lines(
+				Contains("▼ /"),
+				Contains("file1"),
+				Contains("file2"),
+				Contains("file3"),
+			)
+
+		client := newRemoteClient(t)
+		defer client.close()
+
+		client.subscribe("headChanged")
+
+		client.call("selectFile", map[string]any{"path": "file2"})
+		t.Views().Files().
+			IsFocused().
+			SelectedLine(Contains("file2"))
+
+		client.call("openDiff", map[string]any{"path": "file1", "line": 8})
+		t.Views().Staging().
+			IsFocused().
+			SelectedLine(Contains("+eight")).
+			PressEscape()
+
+		client.call("focus", map[string]any{"context": "localBranches"})
+		t.Views().Branches().
+			IsFocused()
+
+		errMessage := client.callExpectingError("selectFile", map[string]any{"path": "nonexistent"})
+		t.Views().Branches().
+			IsFocused()
+		if errMessage != "'nonexistent' has no changes" {
+			t.Fail("unexpected error: " + errMessage)
+		}
+
+		client.call("commit", map[string]any{"message": "remote commit"})
+		t.Views().Commits().
+			Lines(
+				Contains("remote commit"),
+				Contains("initial commit"),
+			)
+		client.expectEvent("headChanged")
+	},
+})
+
+type remoteClient struct {
+	t       *TestDriver
+	conn    net.Conn
+	scanner *bufio.Scanner
+	nextID  int
+	events  []string
+}
+
+type remoteMessage struct {
+	ID     *int   `json:"id"`
+	Method string `json:"method"`
+	Params struct {
+		Event string `json:"event"`
+	} `json:"params"`
+	Error *struct {
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+func newRemoteClient(t *TestDriver) *remoteClient {
+	conn, err := net.Dial("unix", os.Getenv("LAZYGIT_SOCKET"))
+	if err != nil {
+		t.Fail("failed to connect to socket: " + err.Error())
+	}
+
+	return &remoteClient{t: t, conn: conn, scanner: bufio.NewScanner(conn)}
+}
+
+func (self *remoteClient) close() {
+	self.conn.Close()
+}
+
+func (self *remoteClient) subscribe(events ...string) {
+	if response := self.send("subscribe", map[string]any{"events": events}); response.Error != nil {
+		self.t.Fail("'subscribe' failed: " + response.Error.Message)
+	}
+}
+
+// Calls a method that does something in the gui, and waits until the gui is
+// done with it
+func (self *remoteClient) call(method string, params any) {
+	response := self.send(method, params)
+	self.t.WaitTillIdle()
+	if response.Error != nil {
+		self.t.Fail("'" + method + "' failed: " + response.Error.Message)
+	}
+}
+
+func (self *remoteClient) callExpectingError(method string, params any) string {
+	response := self.send(method, params)
+	self.t.WaitTillIdle()
+	if response.Error == nil {
+		self.t.Fail("expected '" + method + "' to fail")
+	}
+	return response.Error.Message
+}
+
+// Sends a request and returns its response, recording any events received in
+// the meantime
+func (self *remoteClient) send(method string, params any) remoteMessage {
+	self.nextID++
+	request, _ := json.Marshal(map[string]any{"jsonrpc": "2.0", "id": self.nextID, "method": method, "params": params})
+	if _, err := self.conn.Write(append(request, '\n')); err != nil {
+		self.t.Fail("failed to send request: " + err.Error())
+	}
+
+	for {
+		message := self.read()
+		if message.ID != nil && *message.ID == self.nextID {
+			return message
+		}
+	}
+}
+
+func (self *remoteClient) expectEvent(event string) {
+	for {
+		for _, received := range self.events {
+			if received == event {
+				return
+			}
+		}
+		self.read()
+	}
+}
+
+func (self *remoteClient) read() remoteMessage {
+	_ = self.conn.SetReadDeadline(time.Now().Add(10 * time.Second))
+	if !self.scanner.Scan() {
+		self.t.Fail("failed to read from socket")
+	}
+
+	var message remoteMessage
+	if err := json.Unmarshal(self.scanner.Bytes(), &message); err != nil {
+		self.t.Fail("invalid message: " + err.Error())
+	}
+	if message.Method == "event" {
+		self.events = append(self.events, message.Params.Event)
+	}
+	return message
+}
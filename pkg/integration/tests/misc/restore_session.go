@@ -0,0 +1,69 @@
+package misc
+
+import (
+	"os"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var RestoreSession = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Restore the focused panel, selections and modes that were saved when lazygit was last quit in the repo",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(cfg *config.AppConfig) {
+		// lazygit is started in the repo
+		repo, _ := os.Getwd()
+		cfg.GetAppState().RepoSessions = []*config.RepoSession{
+			{
+				Repo:           repo,
+				CurrentContext: "localBranches",
+				SelectedItems: map[string]string{
+					"localBranches": "branch-b",
+					"files":         "file3",
+				},
+				DiffRef:        "branch-a",
+				CollapsedPaths: []string{"./dir"},
+				SearchHistory: map[string][]string{
+					"localBranches": {"newest", "oldest"},
+				},
+			},
+		}
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+		shell.NewBranch("branch-a")
+		shell.NewBranch("branch-b")
+		shell.NewBranch("branch-c")
+		shell.CreateFile("dir/file1", "content\n")
+		shell.CreateFile("dir/file2", "content\n")
+		shell.CreateFile("file3", "content\n")
+		shell.CreateFile("file4", "content\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Information().Content(Contains("Showing output for: git diff --stat -p branch-a branch-b"))
+
+		t.Views().Branches().
+			IsFocused().
+			SelectedLine(Contains("branch-b")).
+			Press(keys.Universal.StartSearch).
+			Tap(func() {
+				t.ExpectSearch().Clear()
+				t.Views().Search().
+					Press(keys.Universal.PrevItem).
+					Content(Contains("newest")).
+					Press(keys.Universal.PrevItem).
+					Content(Contains("oldest")).
+					PressEscape()
+			})
+
+		t.Views().Files().
+			Focus().
+			Lines(
+				Contains("▼ /"),
+				Contains("▶ dir"),
+				Contains("file3").IsSelected(),
+				Contains("file4"),
+			)
+	},
+})
@@ -0,0 +1,85 @@
+package patch_building
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var MoveToOtherBranch = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Move a patch from a commit to a new commit on another branch",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Gui.ShowFileTree = false
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file1", "file1 content\n")
+		shell.Commit("base")
+		shell.NewBranch("other")
+		shell.Checkout("master")
+
+		shell.UpdateFileAndAdd("file1", "file1 content\nchanged\n")
+		shell.CreateFileAndAdd("file2", "file2 content\n")
+		shell.Commit("fix")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("fix").IsSelected(),
+				Contains("base"),
+			).
+			PressEnter()
+
+		t.Views().CommitFiles().
+			IsFocused().
+			Lines(
+				Contains("file1").IsSelected(),
+				Contains("file2"),
+			).
+			NavigateToLine(Contains("file2")).
+			PressPrimaryAction()
+
+		t.Common().SelectPatchOption(Contains("Move patch to other branch"))
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Move patch to branch")).
+			Type("other").
+			Confirm()
+
+		t.ExpectPopup().CommitMessagePanel().
+			Title(Equals("Commit summary (on other)")).
+			InitialText(Equals("fix")).
+			Clear().
+			Type("add file2").
+			Confirm()
+
+		t.ExpectToast(Equals("Patch committed onto branch 'other'"))
+
+		t.Views().Commits().
+			Lines(
+				Contains("fix"),
+				Contains("base"),
+			)
+
+		t.Views().CommitFiles().
+			Lines(
+				Contains("file1"),
+			)
+
+		t.Views().Branches().
+			Focus().
+			NavigateToLine(Contains("other")).
+			PressEnter()
+
+		t.Views().SubCommits().
+			IsFocused().
+			Lines(
+				Contains("add file2").IsSelected(),
+				Contains("base"),
+			)
+
+		t.Views().Main().
+			Content(Contains("+file2 content"))
+	},
+})
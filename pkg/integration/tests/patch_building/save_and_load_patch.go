@@ -0,0 +1,120 @@
+package patch_building
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var SaveAndLoadPatch = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Save a partial custom patch, reset it and load it again, then do the same by exporting and importing it as a .patch file",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Gui.ShowFileTree = false
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file1", "first line\nsecond line\nthird line\n")
+		shell.Commit("first commit")
+
+		shell.UpdateFileAndAdd("file1", "first line2\nsecond line\nthird line2\n")
+		shell.CreateFileAndAdd("file2", "file2 content\n")
+		shell.Commit("second commit")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("second commit").IsSelected(),
+				Contains("first commit"),
+			).
+			PressEnter()
+
+		t.Views().CommitFiles().
+			IsFocused().
+			Lines(
+				Contains("file1").IsSelected(),
+				Contains("file2"),
+			).
+			PressEnter()
+
+		t.Views().PatchBuilding().
+			IsFocused().
+			PressPrimaryAction().
+			PressEscape()
+
+		expectPartialPatch := func() {
+			t.Views().CommitFiles().
+				IsFocused().
+				Lines(
+					Contains("◐").Contains("file1"),
+					Contains("file2").DoesNotContain("●"),
+				)
+
+			t.Views().Secondary().
+				ContainsLines(
+					Contains(`-first line`),
+					Contains(`+first line2`),
+					Contains(` second line`),
+					Contains(` third line`),
+				)
+		}
+
+		expectPartialPatch()
+
+		t.Common().SelectPatchOption(Contains("Save patch"))
+		t.ExpectPopup().Prompt().
+			Title(Equals("Patch name")).
+			Type("my patch").
+			Confirm()
+
+		t.ExpectToast(Equals("Patch saved"))
+
+		t.Common().SelectPatchOption(Contains("Reset patch"))
+		t.Views().Information().Content(DoesNotContain("Building patch"))
+
+		t.Views().CommitFiles().
+			IsFocused().
+			Press(keys.CommitFiles.LoadCustomPatch).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Load custom patch")).
+					Select(Contains("my patch")).
+					Confirm()
+			})
+
+		t.ExpectToast(Equals("Patch loaded"))
+
+		t.Views().Information().Content(Contains("Building patch"))
+		expectPartialPatch()
+
+		t.Common().SelectPatchOption(Contains("Export patch to file"))
+		t.ExpectPopup().Prompt().
+			Title(Equals("Export patch to")).
+			Clear().
+			Type("exported.patch").
+			Confirm()
+
+		t.ExpectToast(Equals("Patch exported"))
+		t.FileSystem().FileContent("exported.patch", Contains("+first line2").DoesNotContain("+third line2"))
+
+		t.Common().SelectPatchOption(Contains("Reset patch"))
+
+		t.Views().CommitFiles().
+			IsFocused().
+			Press(keys.CommitFiles.LoadCustomPatch).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Load custom patch")).
+					Select(Contains("Import .patch file")).
+					Confirm()
+
+				t.ExpectPopup().Prompt().
+					Title(Equals("Import patch from")).
+					Type("exported.patch").
+					Confirm()
+			})
+
+		t.ExpectToast(Equals("Patch loaded"))
+		expectPartialPatch()
+	},
+})
@@ -0,0 +1,82 @@
+package remote
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var EditOneOfSeveralPushUrls = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Edit and remove single push URLs of a remote that has two of them, leaving the other one untouched",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.
+			EmptyCommit("one").
+			CloneIntoRemote("origin").
+			Clone("mirror-a").
+			Clone("mirror-b").
+			Clone("mirror-c").
+			RunCommand([]string{"git", "remote", "set-url", "--add", "--push", "origin", "../mirror-a"}).
+			RunCommand([]string{"git", "remote", "set-url", "--add", "--push", "origin", "../mirror-b"})
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Remotes().
+			Focus().
+			Lines(
+				Contains("origin").IsSelected(),
+			).
+			Tap(func() {
+				t.Views().Main().Content(
+					Contains("Push URLs:\n../mirror-a\n../mirror-b"),
+				)
+			}).
+			Press(keys.Branches.EditRemotePushUrl).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Edit push URLs")).
+					Lines(
+						Contains("../mirror-a").IsSelected(),
+						Contains("../mirror-b"),
+						Contains("Add push URL"),
+						Contains("Push to fetch URL again"),
+						Contains("Cancel"),
+					).
+					Select(Contains("../mirror-b")).
+					Confirm()
+
+				t.ExpectPopup().Prompt().
+					Title(Equals("Enter push url for origin (empty to remove it):")).
+					InitialText(Equals("../mirror-b")).
+					Clear().
+					Type("../mirror-c").
+					Confirm()
+			}).
+			Tap(func() {
+				t.Views().Main().Content(
+					Contains("Push URLs:\n../mirror-a\n../mirror-c"),
+				)
+			}).
+			Press(keys.Branches.EditRemotePushUrl).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Edit push URLs")).
+					Select(Contains("../mirror-a")).
+					Confirm()
+
+				t.ExpectPopup().Prompt().
+					Title(Equals("Enter push url for origin (empty to remove it):")).
+					InitialText(Equals("../mirror-a")).
+					Clear().
+					Confirm()
+			}).
+			Tap(func() {
+				t.Views().Main().Content(
+					Contains("Push URLs:\n../mirror-c"),
+				)
+				t.Views().Main().Content(
+					DoesNotContain("../mirror-a"),
+				)
+			})
+	},
+})
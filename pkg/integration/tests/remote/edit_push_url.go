@@ -0,0 +1,67 @@
+package remote
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var EditPushUrl = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Add a separate push URL for a remote and remove it again",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.
+			EmptyCommit("one").
+			CloneIntoRemote("origin").
+			Clone("mirror")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Remotes().
+			Focus().
+			Lines(
+				Contains("origin").IsSelected(),
+			).
+			Tap(func() {
+				t.Views().Main().Content(
+					Contains("Fetch URLs:\n../origin\n\nPush URLs:\n(same as fetch URL)"),
+				)
+			}).
+			Press(keys.Branches.EditRemotePushUrl).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Edit push URLs")).
+					Select(Contains("Push to fetch URL again")).
+					Confirm()
+
+				t.ExpectToast(Equals("Disabled: The remote has no push URLs."))
+
+				t.ExpectPopup().Menu().
+					Title(Equals("Edit push URLs")).
+					Select(Contains("Add push URL")).
+					Confirm()
+
+				t.ExpectPopup().Prompt().
+					Title(Equals("Enter new push url for origin:")).
+					Type("../mirror").
+					Confirm()
+			}).
+			Tap(func() {
+				t.Views().Main().Content(
+					Contains("Fetch URLs:\n../origin\n\nPush URLs:\n../mirror"),
+				)
+			}).
+			Press(keys.Branches.EditRemotePushUrl).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Edit push URLs")).
+					Select(Contains("Push to fetch URL again")).
+					Confirm()
+			}).
+			Tap(func() {
+				t.Views().Main().Content(
+					Contains("Push URLs:\n(same as fetch URL)"),
+				)
+			})
+	},
+})
@@ -0,0 +1,51 @@
+package remote
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var PruneStaleBranches = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "List the stale remote-tracking branches of a remote and prune them",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.
+			EmptyCommit("one").
+			NewBranch("branch-a").
+			NewBranch("branch-b").
+			Checkout("master").
+			CloneIntoRemote("origin").
+			RemoveRemoteBranch("origin", "branch-a")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Remotes().
+			Focus().
+			Lines(
+				Contains("origin").IsSelected(),
+			).
+			Press(keys.Branches.PruneRemote).
+			Tap(func() {
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Prune stale remote branches")).
+					Content(Contains("origin/branch-a")).
+					Content(DoesNotContain("origin/branch-b")).
+					Confirm()
+			}).
+			PressEnter()
+
+		t.Views().RemoteBranches().
+			Lines(
+				Contains("branch-b"),
+				Contains("master"),
+			).
+			PressEscape()
+
+		t.Views().Remotes().
+			IsFocused().
+			Press(keys.Branches.PruneRemote)
+
+		t.ExpectToast(Equals("No stale remote-tracking branches"))
+	},
+})
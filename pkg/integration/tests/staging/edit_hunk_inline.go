@@ -0,0 +1,87 @@
+package staging
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var EditHunkInline = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Edit the added lines of a hunk in the inline hunk editor and stage the result",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file1", "1a\n2a\n3a\n4a\n5a\n")
+		shell.Commit("one")
+
+		shell.UpdateFile("file1", "1a\n2a\n3b\n4a\n5a\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("file1").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().Staging().
+			IsFocused().
+			SelectedLines(
+				Contains("-3a"),
+				Contains("+3b"),
+			).
+			Press(keys.Main.EditHunkInline)
+
+		t.Views().HunkEditor().
+			IsFocused().
+			Title(Equals("Edit hunk (unstaged)")).
+			Content(Equals(" 1a\n 2a\n-3a\n+3b\n 4a\n 5a")).
+			// the cursor is at the start of the first line of the selection
+			Press("x").
+			Press(keys.Universal.ConfirmInEditor)
+
+		t.ExpectPopup().Alert().
+			Title(Equals("Error")).
+			Content(Equals("Line 3: lines must start with ' ', '+' or '-'")).
+			Confirm()
+
+		t.Views().HunkEditor().
+			IsFocused().
+			Press("<backspace>").
+			Press("<down>").
+			Press("<end>").
+			Press("c").
+			Content(Equals(" 1a\n 2a\n-3a\n+3bc\n 4a\n 5a")).
+			Press(keys.Universal.ConfirmInEditor)
+
+		t.Views().Staging().
+			IsFocused().
+			Content(Contains("-3bc\n+3b"))
+
+		t.Views().StagingSecondary().
+			Content(Contains("-3a\n+3bc"))
+
+		t.Views().Staging().
+			PressTab()
+
+		t.Views().StagingSecondary().
+			IsFocused().
+			Press(keys.Main.EditHunkInline)
+
+		t.Views().HunkEditor().
+			IsFocused().
+			Title(Equals("Edit hunk (staged)")).
+			Content(Equals(" 1a\n 2a\n-3a\n+3bc\n 4a\n 5a")).
+			Press("<down>").
+			Press("<end>").
+			Press("d").
+			Press(keys.Universal.ConfirmInEditor)
+
+		t.Views().StagingSecondary().
+			IsFocused().
+			Content(Contains("-3a\n+3bcd"))
+
+		t.Views().Staging().
+			Content(Contains("-3bcd\n+3b"))
+	},
+})
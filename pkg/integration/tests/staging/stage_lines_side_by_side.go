@@ -0,0 +1,62 @@
+package staging
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var StageLinesSideBySide = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Toggle the side-by-side layout in the staging panel and stage lines in it",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Gui.UseHunkModeInStagingView = false
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file1", "one\ntwo\nthree\n")
+		shell.Commit("one")
+
+		shell.UpdateFile("file1", "one\n2\nthree\nfour\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("file1").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().Staging().
+			IsFocused().
+			SelectedLines(Equals("-two")).
+			Press(keys.Main.ToggleSideBySideView).
+			ContainsLines(
+				MatchesRegexp(`^ 1 one +│  1 one$`),
+				// the old and new versions of the edited line are next to each other
+				MatchesRegexp(`^-2 two +│ \+2 2$`).IsSelected(),
+				MatchesRegexp(`^ 3 three +│  3 three$`),
+				MatchesRegexp(`^ +│ \+4 four$`),
+			).
+			// stage the edit of 'two'
+			PressPrimaryAction().
+			ContainsLines(
+				MatchesRegexp(`^ 1 one +│  1 one$`),
+				MatchesRegexp(`^ 2 2 +│  2 2$`),
+				MatchesRegexp(`^ 3 three +│  3 three$`),
+				MatchesRegexp(`^ +│ \+4 four$`).IsSelected(),
+			).
+			Tap(func() {
+				t.Views().StagingSecondary().
+					ContainsLines(
+						MatchesRegexp(`^-2 two +│ \+2 2$`),
+					)
+			}).
+			Press(keys.Main.ToggleSideBySideView).
+			ContainsLines(
+				Equals(" one"),
+				Equals(" 2"),
+				Equals(" three"),
+				Equals("+four").IsSelected(),
+			)
+	},
+})
@@ -0,0 +1,62 @@
+package staging
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var StageLinesWithSyntaxHighlighting = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Stage lines of a file with syntax highlighting enabled, which doesn't change the content of the diff",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Gui.UseHunkModeInStagingView = false
+		config.GetUserConfig().Gui.Theme.SyntaxHighlighting = "monokai"
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("main.go", "package main\n\nfunc main() {\n}\n")
+		shell.Commit("one")
+
+		shell.UpdateFile("main.go", "package main\n\nfunc main() {\n    /* a comment */\n    return\n}\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("main.go").IsSelected(),
+			).
+			Tap(func() {
+				t.Views().Main().
+					ContainsLines(
+						Equals(" func main() {"),
+						Equals("+    /* a comment */"),
+						Equals("+    return"),
+						Equals(" }"),
+					)
+			}).
+			PressEnter()
+
+		t.Views().Staging().
+			IsFocused().
+			ContainsLines(
+				Equals(" func main() {"),
+				Equals("+    /* a comment */"),
+				Equals("+    return"),
+				Equals(" }"),
+			).
+			SelectedLines(Equals("+    /* a comment */")).
+			PressPrimaryAction().
+			ContainsLines(
+				Equals("     /* a comment */"),
+				Equals("+    return"),
+			).
+			SelectedLines(Equals("+    return"))
+
+		t.Views().StagingSecondary().
+			ContainsLines(
+				Equals(" func main() {"),
+				Equals("+    /* a comment */"),
+				Equals(" }"),
+			)
+	},
+})
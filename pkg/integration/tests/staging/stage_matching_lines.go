@@ -0,0 +1,94 @@
+package staging
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var StageMatchingLines = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Stage the lines matching a regex in all files, then discard the ones matching another regex in the current file",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file1", "import a\n\ncode\n")
+		shell.CreateFileAndAdd("file2", "import b\n\ncode\n")
+		shell.Commit("one")
+
+		shell.UpdateFile("file1", "import a\nimport c\n\ncode\nprint(debug)\nmore code\n")
+		shell.UpdateFile("file2", "import b\nimport d\n\ncode\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Equals("▼ /").IsSelected(),
+				Contains("file1"),
+				Contains("file2"),
+			).
+			NavigateToLine(Contains("file1")).
+			PressEnter()
+
+		t.Views().Staging().
+			IsFocused().
+			Press(keys.Main.StageMatchingLines)
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Regular expression for changed lines")).
+			Type("^import").
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Lines matching '^import'")).
+			Select(Contains("Stage matching lines in all files")).
+			Confirm()
+
+		t.ExpectToast(Equals("Staged 2 line(s) in 2 file(s)"))
+
+		t.Views().StagingSecondary().
+			ContainsLines(
+				Equals("+import c"),
+			)
+
+		t.Views().Staging().
+			IsFocused().
+			Content(DoesNotContain("+import c")).
+			ContainsLines(
+				Equals("+print(debug)"),
+				Equals("+more code"),
+			).
+			Press(keys.Main.StageMatchingLines)
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Regular expression for changed lines")).
+			Type(`print\(`).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals(`Lines matching 'print\('`)).
+			Select(Contains("Discard matching lines in this file")).
+			Confirm()
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Discard change")).
+			Content(Contains(`Are you sure you want to discard all unstaged lines matching 'print\('?`)).
+			Confirm()
+
+		t.ExpectToast(Equals("Discarded 1 line(s) in 1 file(s)"))
+
+		t.Views().Staging().
+			Content(DoesNotContain("print(debug)")).
+			ContainsLines(
+				Equals("+more code"),
+			)
+
+		t.FileSystem().FileContent("file1", Equals("import a\nimport c\n\ncode\nmore code\n"))
+
+		t.Views().Files().
+			Lines(
+				Equals("▼ /"),
+				Equals("  MM file1"),
+				Equals("  M  file2"),
+			)
+	},
+})
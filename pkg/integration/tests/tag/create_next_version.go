@@ -0,0 +1,39 @@
+package tag
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CreateNextVersion = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Create a tag pre-filled with the next semantic version",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("initial commit")
+		shell.CreateLightweightTag("v1.2.3", "HEAD")
+		shell.EmptyCommit("fix: one")
+		shell.EmptyCommit("feat: two")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Tags().
+			Focus().
+			Lines(
+				Contains("v1.2.3").IsSelected(),
+			).
+			Press(keys.Branches.CreateNextVersionTag).
+			Tap(func() {
+				t.ExpectPopup().CommitMessagePanel().
+					Title(Equals("Tag name")).
+					InitialText(Equals("v1.3.0")).
+					Confirm()
+			}).
+			Lines(
+				Contains("v1.2.3"),
+				Contains("v1.3.0"),
+			)
+
+		t.Git().TagNamesAt("HEAD", []string{"v1.3.0"})
+	},
+})
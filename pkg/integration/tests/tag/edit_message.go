@@ -0,0 +1,43 @@
+package tag
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var EditMessage = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Edit the message of an annotated tag, which is not possible for lightweight tags",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("initial commit")
+		shell.CreateAnnotatedTag("new-tag", "old message", "HEAD")
+		shell.EmptyCommit("second commit")
+		shell.CreateLightweightTag("lightweight-tag", "HEAD")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Tags().
+			Focus().
+			NavigateToLine(Contains("lightweight-tag")).
+			Press(keys.Branches.EditTagMessage).
+			Tap(func() {
+				t.ExpectToast(Equals("Disabled: Lightweight tags have no message. Delete the tag and create an annotated one instead."))
+			}).
+			NavigateToLine(MatchesRegexp(`new-tag.*old message`)).
+			Press(keys.Branches.EditTagMessage).
+			Tap(func() {
+				t.ExpectPopup().CommitMessagePanel().
+					Title(Equals("Tag description")).
+					InitialText(Equals("old message")).
+					Clear().
+					Type("new message").
+					Confirm()
+			}).
+			ContainsLines(
+				MatchesRegexp(`new-tag.*new message`),
+			)
+
+		t.Git().TagNamesAt("HEAD~1", []string{"new-tag"})
+	},
+})
@@ -0,0 +1,71 @@
+package tag
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var PushAndDeleteMultiple = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Push a range selection of tags, then delete them both locally and from the remote",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("initial commit")
+		shell.CloneIntoRemote("origin")
+		shell.CreateLightweightTag("tag-a", "HEAD")
+		shell.CreateLightweightTag("tag-b", "HEAD")
+		shell.CreateLightweightTag("tag-c", "HEAD")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Tags().
+			Focus().
+			Lines(
+				Contains("tag-a").IsSelected(),
+				Contains("tag-b"),
+				Contains("tag-c"),
+			).
+			Press(keys.Universal.RangeSelectDown).
+			Press(keys.Branches.PushTag).
+			Tap(func() {
+				t.ExpectPopup().Prompt().
+					Title(Equals("Remote to push the selected tags to:")).
+					InitialText(Equals("origin")).
+					Confirm()
+			}).
+			Lines(
+				Contains("tag-a").IsSelected(),
+				Contains("tag-b").IsSelected(),
+				Contains("tag-c"),
+			).
+			Press(keys.Universal.Remove).
+			Tap(func() {
+				t.ExpectPopup().
+					Menu().
+					Title(Equals("Delete selected tags?")).
+					Select(Contains("Delete local and remote tags")).
+					Confirm()
+			}).
+			Tap(func() {
+				t.ExpectPopup().Prompt().
+					Title(Equals("Remote from which to remove the selected tags:")).
+					InitialText(Equals("origin")).
+					Confirm()
+			}).
+			Tap(func() {
+				t.ExpectPopup().
+					Confirmation().
+					Title(Equals("Delete selected tags?")).
+					Content(Equals("Are you sure you want to delete the selected tags from both your machine and from 'origin'?")).
+					Confirm()
+			}).
+			Lines(
+				Contains("tag-c").IsSelected(),
+			).
+			Tap(func() {
+				t.Git().
+					RemoteTagDeleted("origin", "tag-a").
+					RemoteTagDeleted("origin", "tag-b")
+			})
+	},
+})
@@ -22,6 +22,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/misc"
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/patch_building"
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/reflog"
+	"github.com/jesseduffield/lazygit/pkg/integration/tests/remote"
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/shell_commands"
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/staging"
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/stash"
@@ -31,6 +32,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/tag"
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/ui"
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/undo"
+	"github.com/jesseduffield/lazygit/pkg/integration/tests/workspace"
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/worktree"
 )
 
@@ -42,6 +44,7 @@ var tests = []*components.IntegrationTest{
 	branch.CheckoutAutostash,
 	branch.CheckoutByName,
 	branch.CheckoutPreviousBranch,
+	branch.CleanUp,
 	branch.CreateTag,
 	branch.Delete,
 	branch.DeleteMultiple,
@@ -84,6 +87,7 @@ var tests = []*components.IntegrationTest{
 	branch.SortLocalBranches,
 	branch.SortRemoteBranches,
 	branch.SquashMerge,
+	branch.StackRestackAndPush,
 	branch.Suggestions,
 	branch.UnsetUpstream,
 	cherry_pick.CherryPick,
@@ -93,6 +97,9 @@ var tests = []*components.IntegrationTest{
 	cherry_pick.CherryPickDuringRebase,
 	cherry_pick.CherryPickMerge,
 	cherry_pick.CherryPickRange,
+	commit.AbsorbStagedChanges,
+	commit.AbsorbStagedChangesAndSquash,
+	commit.AbsorbStagedChangesWithSpecialFileNames,
 	commit.AddCoAuthor,
 	commit.AddCoAuthorRange,
 	commit.AddCoAuthorWhileCommitting,
@@ -146,6 +153,7 @@ var tests = []*components.IntegrationTest{
 	commit.Search,
 	commit.SetAuthor,
 	commit.SetAuthorRange,
+	commit.ShowWholeGitGraph,
 	commit.StageRangeOfLines,
 	commit.Staged,
 	commit.StagedWithoutHooks,
@@ -215,6 +223,7 @@ var tests = []*components.IntegrationTest{
 	file.DiscardVariousChangesRangeSelect,
 	file.Gitignore,
 	file.GitignoreSpecialCharacters,
+	file.MaxUntrackedFiles,
 	file.RememberCommitMessageAfterFail,
 	file.RenameSimilarityThresholdChange,
 	file.RenamedFiles,
@@ -222,6 +231,7 @@ var tests = []*components.IntegrationTest{
 	file.StageChildrenRangeSelect,
 	file.StageDeletedRangeSelect,
 	file.StageRangeSelect,
+	file.UntrackedFilesOptions,
 	filter_and_search.FilterByFileStatus,
 	filter_and_search.FilterCommitFiles,
 	filter_and_search.FilterFiles,
@@ -234,6 +244,7 @@ var tests = []*components.IntegrationTest{
 	filter_and_search.FilterRemotes,
 	filter_and_search.FilterSearchHistory,
 	filter_and_search.FilterUpdatesWhenModelChanges,
+	filter_and_search.GlobalFinder,
 	filter_and_search.NestedFilter,
 	filter_and_search.NestedFilterTransient,
 	filter_and_search.NewSearch,
@@ -299,6 +310,7 @@ var tests = []*components.IntegrationTest{
 	interactive_rebase.RewordYouAreHereCommit,
 	interactive_rebase.RewordYouAreHereCommitWithEditor,
 	interactive_rebase.ShowExecTodos,
+	interactive_rebase.SplitCommit,
 	interactive_rebase.SquashDownFirstCommit,
 	interactive_rebase.SquashDownSecondCommit,
 	interactive_rebase.SquashFixupsAbove,
@@ -308,12 +320,17 @@ var tests = []*components.IntegrationTest{
 	interactive_rebase.SwapInRebaseWithConflictAndEdit,
 	interactive_rebase.SwapWithConflict,
 	interactive_rebase.ViewFilesOfTodoEntries,
+	misc.CommandHistory,
 	misc.ConfirmOnQuit,
 	misc.CopyConfirmationMessageToClipboard,
 	misc.CopyToClipboard,
 	misc.DisabledKeybindings,
 	misc.InitialOpen,
+	misc.Plugins,
 	misc.RecentReposOnLaunch,
+	misc.RecordAndReplayMacro,
+	misc.RemoteControl,
+	misc.RestoreSession,
 	patch_building.Apply,
 	patch_building.ApplyInReverse,
 	patch_building.ApplyInReverseWithConflict,
@@ -338,9 +355,11 @@ var tests = []*components.IntegrationTest{
 	patch_building.MoveToNewCommitFromDeletedFile,
 	patch_building.MoveToNewCommitInLastCommitOfStackedBranch,
 	patch_building.MoveToNewCommitPartialHunk,
+	patch_building.MoveToOtherBranch,
 	patch_building.RemoveFromCommit,
 	patch_building.RemovePartsOfAddedFile,
 	patch_building.ResetWithEscape,
+	patch_building.SaveAndLoadPatch,
 	patch_building.SelectAllFiles,
 	patch_building.SpecificSelection,
 	patch_building.StartNewPatch,
@@ -350,6 +369,9 @@ var tests = []*components.IntegrationTest{
 	reflog.DoNotShowBranchMarkersInReflogSubcommits,
 	reflog.Patch,
 	reflog.Reset,
+	remote.EditOneOfSeveralPushUrls,
+	remote.EditPushUrl,
+	remote.PruneStaleBranches,
 	shell_commands.BasicShellCommand,
 	shell_commands.ComplexShellCommand,
 	shell_commands.DeleteFromHistory,
@@ -359,9 +381,13 @@ var tests = []*components.IntegrationTest{
 	staging.DiffChangeScreenMode,
 	staging.DiffContextChange,
 	staging.DiscardAllChanges,
+	staging.EditHunkInline,
 	staging.Search,
 	staging.StageHunks,
 	staging.StageLines,
+	staging.StageLinesSideBySide,
+	staging.StageLinesWithSyntaxHighlighting,
+	staging.StageMatchingLines,
 	staging.StageRanges,
 	stash.Apply,
 	stash.ApplyPatch,
@@ -423,15 +449,19 @@ var tests = []*components.IntegrationTest{
 	tag.Checkout,
 	tag.CheckoutWhenBranchWithSameNameExists,
 	tag.CopyToClipboard,
+	tag.CreateNextVersion,
 	tag.CreateWhileCommitting,
 	tag.CrudAnnotated,
 	tag.CrudLightweight,
 	tag.DeleteLocalAndRemote,
+	tag.EditMessage,
 	tag.ForceTagAnnotated,
 	tag.ForceTagLightweight,
+	tag.PushAndDeleteMultiple,
 	tag.Reset,
 	tag.ResetToDuplicateNamedBranch,
 	ui.Accordion,
+	ui.CommandPalette,
 	ui.DisableSwitchTabWithPanelJumpKeys,
 	ui.EmptyMenu,
 	ui.KeybindingSuggestionsWhenSwitchingRepos,
@@ -443,6 +473,7 @@ var tests = []*components.IntegrationTest{
 	undo.UndoCheckoutAndDrop,
 	undo.UndoCommit,
 	undo.UndoDrop,
+	workspace.Workspace,
 	worktree.AddFromBranch,
 	worktree.AddFromBranchDetached,
 	worktree.AddFromCommit,
@@ -0,0 +1,80 @@
+package ui
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CommandPalette = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Execute actions of other panels and custom commands from the command palette",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.GetUserConfig().CustomCommands = []config.CustomCommand{
+			{
+				Key:         "X",
+				Context:     "commits",
+				Command:     "touch myfile",
+				Description: "Create my file",
+			},
+		}
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+		shell.NewBranch("branch-a")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			IsEmpty().
+			Press(keys.Universal.OpenCommandPalette)
+
+		t.ExpectSearch().
+			Type("rename branch").
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Command palette")).
+			ContainsLines(
+				Contains("--- Branches ---"),
+				Contains("R").Contains("Rename branch"),
+			).
+			Select(Contains("Rename branch")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Contains("Enter new branch name")).
+			Clear().
+			Type("branch-b").
+			Confirm()
+
+		t.Views().Branches().
+			IsFocused().
+			Lines(
+				Contains("branch-b").IsSelected(),
+				Contains("master"),
+			).
+			Press(keys.Universal.OpenCommandPalette)
+
+		t.ExpectSearch().
+			Type("create my file").
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Command palette")).
+			ContainsLines(
+				Contains("--- Commits ---"),
+				Contains("X").Contains("Create my file"),
+			).
+			Select(Contains("Create my file")).
+			Confirm()
+
+		t.Views().Commits().
+			IsFocused()
+
+		t.Views().Files().
+			Lines(
+				Contains("myfile"),
+			)
+	},
+})
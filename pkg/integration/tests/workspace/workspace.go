@@ -0,0 +1,61 @@
+package workspace
+
+import (
+	"path/filepath"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var Workspace = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show the status of other repos in the workspace panel, fetch and pull in all of them, and switch to one",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		parentDir, _ := filepath.Abs("..")
+		config.GetUserConfig().Workspace.ScanDirs = []string{parentDir}
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+		shell.CloneNonBare("other")
+		shell.EmptyCommit("two")
+
+		shell.Chdir("../other")
+		shell.CreateFile("dirty-file", "content")
+		shell.Chdir("../repo")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Workspace().
+			Focus().
+			Lines(
+				Contains("other").Contains("master").Contains("✓").Contains("1 changed").IsSelected(),
+				Contains("*").Contains("repo").Contains("master").DoesNotContain("changed"),
+			).
+			Press(keys.Workspace.FetchAllRepos).
+			Lines(
+				Contains("other").Contains("master").Contains("↓1").Contains("1 changed").IsSelected(),
+				Contains("*").Contains("repo").Contains("master"),
+			).
+			Press(keys.Workspace.PullAllRepos).
+			Lines(
+				Contains("other").Contains("master").Contains("✓").Contains("1 changed").IsSelected(),
+				Contains("*").Contains("repo").Contains("master"),
+			).
+			PressPrimaryAction()
+
+		t.Views().Status().Content(Contains("other → master"))
+
+		t.Views().Workspace().
+			IsFocused().
+			Lines(
+				Contains("*").Contains("other"),
+				DoesNotContain("*").Contains("repo"),
+			)
+
+		t.Views().Commits().
+			Lines(
+				Contains("two"),
+				Contains("one"),
+			)
+	},
+})
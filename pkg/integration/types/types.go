@@ -47,4 +47,7 @@ type GuiDriver interface {
 	NextToast() *string
 	CheckAllToastsAcknowledged()
 	Headless() bool
+	// Waits until lazygit has finished processing something that wasn't
+	// triggered through PressKey or Click (which wait by themselves)
+	WaitTillIdle()
 }
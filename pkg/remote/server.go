@@ -0,0 +1,352 @@
+// Package remote implements a small JSON-RPC 2.0 server on a Unix domain
+// socket, allowing other tools (e.g. editors) to drive a running lazygit.
+//
+// Messages are newline-delimited JSON objects. Besides the methods registered
+// with Handle, the server supports 'subscribe' and 'unsubscribe', taking an
+// 'events' list of event names; subscribed clients receive an 'event'
+// notification whenever Publish is called with one of those names.
+package remote
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+// The events that clients can subscribe to
+const (
+	// Sent when the checked out branch or commit changes. The data has the
+	// fields 'branch' (empty if HEAD is detached) and 'hash'.
+	HeadChangedEvent = "headChanged"
+	// Sent after each refresh that isn't done in the background, e.g. one
+	// requested with the 'refresh' method. The data is the list of refreshed
+	// scopes.
+	RefreshCompletedEvent = "refreshCompleted"
+)
+
+// Standard JSON-RPC error codes
+const (
+	ParseErrorCode     = -32700
+	InvalidRequestCode = -32600
+	MethodNotFoundCode = -32601
+	InvalidParamsCode  = -32602
+	// used for errors returned by handlers
+	ServerErrorCode = -32000
+)
+
+// A handler receives the raw params of a request and returns the result, which
+// is marshalled to JSON. If the returned error is an *Error, it is passed to the
+// client as is, otherwise it is wrapped in a server error.
+type Handler func(params json.RawMessage) (any, error)
+
+type Error struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (self *Error) Error() string {
+	return self.Message
+}
+
+func NewInvalidParamsError(err error) *Error {
+	return &Error{Code: InvalidParamsCode, Message: err.Error()}
+}
+
+type request struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+type successResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  any             `json:"result"`
+}
+
+type errorResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Error   *Error          `json:"error"`
+}
+
+type notification struct {
+	JSONRPC string `json:"jsonrpc"`
+	Method  string `json:"method"`
+	Params  any    `json:"params"`
+}
+
+type subscriptionParams struct {
+	Events []string `json:"events"`
+}
+
+// The params of an 'event' notification
+type EventParams struct {
+	Event string `json:"event"`
+	Data  any    `json:"data,omitempty"`
+}
+
+type Server struct {
+	path     string
+	listener net.Listener
+	log      *logrus.Entry
+
+	mutex    sync.Mutex
+	handlers map[string]Handler
+	conns    map[*conn]struct{}
+}
+
+// Messages to a client are queued and written by a goroutine of their own, so
+// that a client that doesn't read its messages can't block us (e.g. while
+// publishing events during a refresh). If the queue is full or a write times
+// out, we give up on the client and close the connection.
+const (
+	outgoingQueueSize = 256
+	writeTimeout      = 5 * time.Second
+)
+
+type conn struct {
+	netConn net.Conn
+	log     *logrus.Entry
+
+	outgoing  chan []byte
+	done      chan struct{}
+	closeOnce sync.Once
+
+	// guards the subscriptions
+	mutex         sync.Mutex
+	subscriptions map[string]bool
+}
+
+func newConn(netConn net.Conn, log *logrus.Entry) *conn {
+	return &conn{
+		netConn:       netConn,
+		log:           log,
+		outgoing:      make(chan []byte, outgoingQueueSize),
+		done:          make(chan struct{}),
+		subscriptions: map[string]bool{},
+	}
+}
+
+// Creates a server listening on the socket at the given path. A stale socket
+// file left behind by a crashed instance is removed, but we refuse to take
+// over a socket that another instance is still listening on.
+func NewServer(path string, log *logrus.Entry) (*Server, error) {
+	if _, err := os.Stat(path); err == nil {
+		if existingConn, err := net.Dial("unix", path); err == nil {
+			existingConn.Close()
+			return nil, fmt.Errorf("socket %s is already in use", path)
+		}
+		if err := os.Remove(path); err != nil {
+			return nil, err
+		}
+	}
+
+	listener, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, err
+	}
+	// anyone who can connect can run git commands in the repo, so only the
+	// user may use the socket
+	if err := os.Chmod(path, 0o600); err != nil {
+		listener.Close()
+		return nil, err
+	}
+
+	server := &Server{
+		path:     path,
+		listener: listener,
+		log:      log,
+		handlers: map[string]Handler{},
+		conns:    map[*conn]struct{}{},
+	}
+
+	return server, nil
+}
+
+func (self *Server) Path() string {
+	return self.path
+}
+
+// Registers the handler for the given method, replacing any previous one
+func (self *Server) Handle(method string, handler Handler) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	self.handlers[method] = handler
+}
+
+// Accepts connections until the server is closed. Requests of a single
+// connection are handled one after the other.
+func (self *Server) Serve() {
+	for {
+		netConn, err := self.listener.Accept()
+		if err != nil {
+			if !errors.Is(err, net.ErrClosed) {
+				self.log.Errorf("remote: failed to accept connection: %v", err)
+			}
+			return
+		}
+
+		c := newConn(netConn, self.log)
+		self.mutex.Lock()
+		self.conns[c] = struct{}{}
+		self.mutex.Unlock()
+
+		go utils.Safe(c.writeLoop)
+		go utils.Safe(func() { self.serveConn(c) })
+	}
+}
+
+func (self *Server) serveConn(c *conn) {
+	defer func() {
+		self.mutex.Lock()
+		delete(self.conns, c)
+		self.mutex.Unlock()
+		c.close()
+	}()
+
+	scanner := bufio.NewScanner(c.netConn)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+
+		var req request
+		if err := json.Unmarshal(line, &req); err != nil {
+			c.write(errorResponse{JSONRPC: "2.0", ID: json.RawMessage("null"), Error: &Error{Code: ParseErrorCode, Message: err.Error()}})
+			continue
+		}
+
+		result, err := self.handleRequest(c, req)
+
+		// requests without an ID are notifications, which don't get a response
+		if len(req.ID) == 0 {
+			continue
+		}
+
+		if err != nil {
+			rpcErr, ok := err.(*Error)
+			if !ok {
+				rpcErr = &Error{Code: ServerErrorCode, Message: err.Error()}
+			}
+			c.write(errorResponse{JSONRPC: "2.0", ID: req.ID, Error: rpcErr})
+		} else {
+			c.write(successResponse{JSONRPC: "2.0", ID: req.ID, Result: result})
+		}
+	}
+}
+
+func (self *Server) handleRequest(c *conn, req request) (any, error) {
+	if req.JSONRPC != "2.0" || req.Method == "" {
+		return nil, &Error{Code: InvalidRequestCode, Message: "invalid request"}
+	}
+
+	switch req.Method {
+	case "subscribe", "unsubscribe":
+		var params subscriptionParams
+		if err := json.Unmarshal(req.Params, &params); err != nil {
+			return nil, NewInvalidParamsError(err)
+		}
+
+		c.mutex.Lock()
+		for _, event := range params.Events {
+			c.subscriptions[event] = req.Method == "subscribe"
+		}
+		c.mutex.Unlock()
+		return nil, nil
+	}
+
+	self.mutex.Lock()
+	handler, ok := self.handlers[req.Method]
+	self.mutex.Unlock()
+	if !ok {
+		return nil, &Error{Code: MethodNotFoundCode, Message: fmt.Sprintf("unknown method '%s'", req.Method)}
+	}
+
+	self.log.Infof("remote: handling '%s'", req.Method)
+	return handler(req.Params)
+}
+
+// Sends an 'event' notification to all clients subscribed to the given event
+func (self *Server) Publish(event string, data any) {
+	self.mutex.Lock()
+	conns := lo.Keys(self.conns)
+	self.mutex.Unlock()
+
+	for _, c := range conns {
+		c.mutex.Lock()
+		subscribed := c.subscriptions[event]
+		c.mutex.Unlock()
+
+		if subscribed {
+			c.write(notification{JSONRPC: "2.0", Method: "event", Params: EventParams{Event: event, Data: data}})
+		}
+	}
+}
+
+// Stops accepting connections and closes the open ones. Closing the listener
+// also removes the socket file.
+func (self *Server) Close() error {
+	err := self.listener.Close()
+
+	self.mutex.Lock()
+	for c := range self.conns {
+		c.close()
+	}
+	self.mutex.Unlock()
+
+	return err
+}
+
+// Queues the message for sending; never blocks
+func (self *conn) write(message any) {
+	bytes, err := json.Marshal(message)
+	if err != nil {
+		bytes, _ = json.Marshal(errorResponse{JSONRPC: "2.0", ID: json.RawMessage("null"), Error: &Error{Code: ServerErrorCode, Message: err.Error()}})
+	}
+
+	select {
+	case <-self.done:
+	case self.outgoing <- append(bytes, '\n'):
+	default:
+		self.log.Warn("remote: client doesn't read its messages, closing the connection")
+		self.close()
+	}
+}
+
+func (self *conn) writeLoop() {
+	for {
+		select {
+		case <-self.done:
+			return
+		case bytes := <-self.outgoing:
+			_ = self.netConn.SetWriteDeadline(time.Now().Add(writeTimeout))
+			if _, err := self.netConn.Write(bytes); err != nil {
+				self.log.Warnf("remote: failed to write to client, closing the connection: %v", err)
+				self.close()
+				return
+			}
+		}
+	}
+}
+
+// Closing the connection also ends serveConn, since reading from it fails
+func (self *conn) close() {
+	self.closeOnce.Do(func() {
+		close(self.done)
+		self.netConn.Close()
+	})
+}
@@ -0,0 +1,185 @@
+package remote
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func startServer(t *testing.T) (*Server, func(string) string) {
+	server, conn := startServerWithConn(t)
+	reader := bufio.NewReader(conn)
+
+	send := func(message string) string {
+		if message != "" {
+			if _, err := conn.Write([]byte(message + "\n")); err != nil {
+				t.Fatal(err)
+			}
+		}
+		response, err := reader.ReadString('\n')
+		if err != nil {
+			t.Fatal(err)
+		}
+		return response
+	}
+
+	return server, send
+}
+
+func startServerWithConn(t *testing.T) (*Server, net.Conn) {
+	t.Helper()
+
+	// keep the path short; socket paths are limited to about 100 characters
+	path := filepath.Join(t.TempDir(), "s")
+	server, err := NewServer(path, utils.NewDummyLog())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = server.Close() })
+	go server.Serve()
+
+	conn, err := net.Dial("unix", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return server, conn
+}
+
+func TestServerRequests(t *testing.T) {
+	server, send := startServer(t)
+
+	server.Handle("add", func(params json.RawMessage) (any, error) {
+		var numbers []int
+		if err := json.Unmarshal(params, &numbers); err != nil {
+			return nil, NewInvalidParamsError(err)
+		}
+		return numbers[0] + numbers[1], nil
+	})
+	server.Handle("fail", func(params json.RawMessage) (any, error) {
+		return nil, errors.New("something went wrong")
+	})
+
+	for _, s := range []struct {
+		request          string
+		expectedResponse string
+	}{
+		{
+			request:          `{"jsonrpc":"2.0","id":1,"method":"add","params":[1,2]}`,
+			expectedResponse: `{"jsonrpc":"2.0","id":1,"result":3}`,
+		},
+		{
+			request:          `{"jsonrpc":"2.0","id":"abc","method":"add","params":"x"}`,
+			expectedResponse: `{"jsonrpc":"2.0","id":"abc","error":{"code":-32602,"message":"json: cannot unmarshal string into Go value of type []int"}}`,
+		},
+		{
+			request:          `{"jsonrpc":"2.0","id":2,"method":"fail"}`,
+			expectedResponse: `{"jsonrpc":"2.0","id":2,"error":{"code":-32000,"message":"something went wrong"}}`,
+		},
+		{
+			request:          `{"jsonrpc":"2.0","id":3,"method":"unknown"}`,
+			expectedResponse: `{"jsonrpc":"2.0","id":3,"error":{"code":-32601,"message":"unknown method 'unknown'"}}`,
+		},
+		{
+			request:          `{"id":4,"method":"add"}`,
+			expectedResponse: `{"jsonrpc":"2.0","id":4,"error":{"code":-32600,"message":"invalid request"}}`,
+		},
+		{
+			request:          `{not json`,
+			expectedResponse: `{"jsonrpc":"2.0","id":null,"error":{"code":-32700,"message":"invalid character 'n' looking for beginning of object key string"}}`,
+		},
+	} {
+		t.Run(s.request, func(t *testing.T) {
+			assert.Equal(t, s.expectedResponse+"\n", send(s.request))
+		})
+	}
+}
+
+func TestServerEvents(t *testing.T) {
+	server, send := startServer(t)
+
+	assert.Equal(t, `{"jsonrpc":"2.0","id":1,"result":null}`+"\n",
+		send(`{"jsonrpc":"2.0","id":1,"method":"subscribe","params":{"events":["headChanged"]}}`))
+
+	// not subscribed, so not sent
+	server.Publish("refreshCompleted", nil)
+	server.Publish("headChanged", map[string]string{"branch": "master"})
+	assert.Equal(t, `{"jsonrpc":"2.0","method":"event","params":{"event":"headChanged","data":{"branch":"master"}}}`+"\n",
+		send(""))
+
+	assert.Equal(t, `{"jsonrpc":"2.0","id":2,"result":null}`+"\n",
+		send(`{"jsonrpc":"2.0","id":2,"method":"unsubscribe","params":{"events":["headChanged"]}}`))
+
+	server.Publish("headChanged", map[string]string{"branch": "other"})
+	// a notification without an id gets no response, so the next line we
+	// read is the response to the request after it
+	assert.Equal(t, `{"jsonrpc":"2.0","id":3,"result":null}`+"\n",
+		send(`{"jsonrpc":"2.0","method":"subscribe","params":{"events":["x"]}}`+"\n"+
+			`{"jsonrpc":"2.0","id":3,"method":"subscribe","params":{"events":["y"]}}`))
+}
+
+func TestServerRefusesSocketInUse(t *testing.T) {
+	server, _ := startServer(t)
+
+	_, err := NewServer(server.Path(), utils.NewDummyLog())
+	assert.ErrorContains(t, err, "already in use")
+}
+
+func TestServerSocketIsOnlyAccessibleByUser(t *testing.T) {
+	server, _ := startServer(t)
+
+	info, err := os.Stat(server.Path())
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+}
+
+func TestServerDropsClientsThatDontRead(t *testing.T) {
+	server, conn := startServerWithConn(t)
+	reader := bufio.NewReader(conn)
+
+	_, err := conn.Write([]byte(`{"jsonrpc":"2.0","id":1,"method":"subscribe","params":{"events":["headChanged"]}}` + "\n"))
+	assert.NoError(t, err)
+	response, err := reader.ReadString('\n')
+	assert.NoError(t, err)
+	assert.Equal(t, `{"jsonrpc":"2.0","id":1,"result":null}`+"\n", response)
+
+	// publishing must not block even though the client doesn't read, so that
+	// it can't hold up the gui
+	data := strings.Repeat("x", 1024)
+	published := make(chan struct{})
+	go func() {
+		for range 2000 {
+			server.Publish("headChanged", data)
+		}
+		close(published)
+	}()
+	select {
+	case <-published:
+	case <-time.After(5 * time.Second):
+		t.Fatal("publishing blocked")
+	}
+
+	// the connection is closed once the queue overflows, so we only get some
+	// of the events
+	_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	eventCount := 0
+	for {
+		if _, err := reader.ReadString('\n'); err != nil {
+			assert.ErrorIs(t, err, io.EOF)
+			break
+		}
+		eventCount++
+	}
+	assert.Less(t, eventCount, 2000)
+}
@@ -0,0 +1,159 @@
+package syntax
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	// a run of SGR escape sequences, e.g. "\x1b[1;32m"
+	leadingColorsRegexp = regexp.MustCompile(`^(?:\x1b\[[0-9;]*m)*`)
+	// git resets the colour at the end of each coloured line
+	trailingResetRegexp = regexp.MustCompile(`(?:\x1b\[0?m)+$`)
+	anyColorRegexp      = regexp.MustCompile(`\x1b\[[0-9;]*m`)
+)
+
+// Longer lines (e.g. of minified files) are not highlighted, to keep rendering
+// fast
+const maxHighlightedLineLength = 1000
+
+// Adds syntax highlighting to the lines of a unified diff (as output by `git
+// diff --color=always`, `git show` etc.) as they stream through. Lines keep
+// the colour given to them by git; tokens that the syntax highlighting style
+// assigns a colour to are rendered in that colour instead.
+//
+// Since lines are highlighted one at a time, constructs that span several
+// lines (e.g. block comments) are only partially recognised. Lines that git
+// colours in more than one way (e.g. because of whitespace errors or
+// --color-moved) are left untouched.
+type diffHighlighter struct {
+	highlighter *Highlighter
+	filename    string
+	inHunk      bool
+}
+
+func (self *diffHighlighter) highlightLine(line string) string {
+	plainLine := anyColorRegexp.ReplaceAllString(line, "")
+
+	if self.inHunk && strings.HasPrefix(plainLine, `\`) {
+		// "\ No newline at end of file"
+		return line
+	}
+
+	if !self.inHunk || plainLine == "" || !strings.ContainsRune("+- ", rune(plainLine[0])) {
+		self.inHunk = false
+		self.processHeaderLine(plainLine)
+		return line
+	}
+
+	if len(line) > maxHighlightedLineLength {
+		return line
+	}
+
+	leadingColors := leadingColorsRegexp.FindString(line)
+	rest := line[len(leadingColors):]
+	trailingReset := trailingResetRegexp.FindString(rest)
+	content := rest[:len(rest)-len(trailingReset)]
+	if strings.Contains(content, "\x1b") {
+		return line
+	}
+
+	tokens := self.highlighter.HighlightLine(self.filename, content[1:])
+	if tokens == nil {
+		return line
+	}
+
+	stringBuilder := &strings.Builder{}
+	stringBuilder.WriteString(leadingColors)
+	stringBuilder.WriteString(content[:1])
+	for _, token := range tokens {
+		if token.Style == nil {
+			stringBuilder.WriteString(token.Text)
+			continue
+		}
+		stringBuilder.WriteString(token.Style.Sprint(token.Text))
+		// restore the colour of the line, which the token's style has reset
+		stringBuilder.WriteString(leadingColors)
+	}
+	stringBuilder.WriteString(trailingReset)
+	return stringBuilder.String()
+}
+
+func (self *diffHighlighter) processHeaderLine(plainLine string) {
+	switch {
+	case strings.HasPrefix(plainLine, "diff --git "):
+		self.filename = ""
+		if idx := strings.LastIndex(plainLine, " b/"); idx != -1 {
+			self.filename = unquote(plainLine[idx+len(" b/"):])
+		}
+	case strings.HasPrefix(plainLine, "--- "), strings.HasPrefix(plainLine, "+++ "):
+		path := unquote(strings.TrimSuffix(plainLine[len("+++ "):], "\t"))
+		if path != "/dev/null" {
+			self.filename = path
+		}
+	case strings.HasPrefix(plainLine, "@@ "):
+		// combined diffs of merge commits start their hunks with "@@@" and have
+		// more than one marker column, which we don't support
+		self.inHunk = true
+	}
+}
+
+func unquote(path string) string {
+	if strings.HasPrefix(path, `"`) {
+		if unquoted, err := strconv.Unquote(path); err == nil {
+			return unquoted
+		}
+	}
+	return path
+}
+
+type diffReader struct {
+	reader      *bufio.Reader
+	highlighter diffHighlighter
+	pending     []byte
+	err         error
+}
+
+// Wraps a reader of diff output, adding syntax highlighting to it. Returns the
+// reader unchanged if highlighter is nil.
+func NewDiffReader(reader io.Reader, highlighter *Highlighter) io.Reader {
+	if highlighter == nil {
+		return reader
+	}
+
+	return &diffReader{
+		reader:      bufio.NewReader(reader),
+		highlighter: diffHighlighter{highlighter: highlighter},
+	}
+}
+
+func (self *diffReader) Read(p []byte) (int, error) {
+	for len(self.pending) == 0 {
+		if self.err != nil {
+			return 0, self.err
+		}
+
+		var line []byte
+		line, self.err = self.reader.ReadBytes('\n')
+		if len(line) == 0 {
+			continue
+		}
+
+		content, lineEnding := line, []byte{}
+		if bytes.HasSuffix(content, []byte("\n")) {
+			content, lineEnding = content[:len(content)-1], []byte("\n")
+			if bytes.HasSuffix(content, []byte("\r")) {
+				content, lineEnding = content[:len(content)-1], []byte("\r\n")
+			}
+		}
+		self.pending = append([]byte(self.highlighter.highlightLine(string(content))), lineEnding...)
+	}
+
+	n := copy(p, self.pending)
+	self.pending = self.pending[n:]
+	return n, nil
+}
@@ -0,0 +1,114 @@
+package syntax
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/gookit/color"
+	"github.com/stretchr/testify/assert"
+	"github.com/xo/terminfo"
+)
+
+func TestDiffReader(t *testing.T) {
+	oldColorLevel := color.ForceSetColorLevel(terminfo.ColorLevelMillions)
+	defer color.ForceSetColorLevel(oldColorLevel)
+
+	highlighter := NewHighlighter("monokai")
+	tokens := highlighter.HighlightLine("main.go", "return a")
+	keywordStyle, nameStyle := tokens[0].Style, tokens[2].Style
+
+	scenarios := []struct {
+		testName string
+		input    string
+		expected string
+	}{
+		{
+			testName: "highlights added, deleted and context lines",
+			input: "\x1b[1mdiff --git a/main.go b/main.go\x1b[m\n" +
+				"\x1b[1m--- a/main.go\x1b[m\n" +
+				"\x1b[1m+++ b/main.go\x1b[m\n" +
+				"\x1b[36m@@ -1,2 +1,2 @@\x1b[m\n" +
+				" return a\n" +
+				"\x1b[31m-return b\x1b[m\n" +
+				"\x1b[32m+return c\x1b[m\n",
+			expected: "\x1b[1mdiff --git a/main.go b/main.go\x1b[m\n" +
+				"\x1b[1m--- a/main.go\x1b[m\n" +
+				"\x1b[1m+++ b/main.go\x1b[m\n" +
+				"\x1b[36m@@ -1,2 +1,2 @@\x1b[m\n" +
+				" " + keywordStyle.Sprint("return") + " " + nameStyle.Sprint("a") + "\n" +
+				"\x1b[31m-" + keywordStyle.Sprint("return") + "\x1b[31m " + nameStyle.Sprint("b") + "\x1b[31m\x1b[m\n" +
+				"\x1b[32m+" + keywordStyle.Sprint("return") + "\x1b[32m " + nameStyle.Sprint("c") + "\x1b[32m\x1b[m\n",
+		},
+		{
+			testName: "leaves lines with several colours untouched",
+			input: "diff --git a/main.go b/main.go\n" +
+				"--- a/main.go\n" +
+				"+++ b/main.go\n" +
+				"@@ -1 +1 @@\n" +
+				"\x1b[32m+\x1b[m\x1b[32mreturn c\x1b[m\x1b[41m \x1b[m\n",
+			expected: "diff --git a/main.go b/main.go\n" +
+				"--- a/main.go\n" +
+				"+++ b/main.go\n" +
+				"@@ -1 +1 @@\n" +
+				"\x1b[32m+\x1b[m\x1b[32mreturn c\x1b[m\x1b[41m \x1b[m\n",
+		},
+		{
+			testName: "only highlights lines within hunks",
+			input: "commit 1234\n" +
+				"\n" +
+				"    return in commit message\n" +
+				"diff --git a/main.go b/main.go\n" +
+				"new file mode 100644\n" +
+				"--- /dev/null\n" +
+				"+++ b/main.go\n" +
+				"@@ -0,0 +1 @@\n" +
+				"+return a\n" +
+				"\\ No newline at end of file\n" +
+				"commit 5678\n" +
+				"\n" +
+				"    return in commit message\n",
+			expected: "commit 1234\n" +
+				"\n" +
+				"    return in commit message\n" +
+				"diff --git a/main.go b/main.go\n" +
+				"new file mode 100644\n" +
+				"--- /dev/null\n" +
+				"+++ b/main.go\n" +
+				"@@ -0,0 +1 @@\n" +
+				"+" + keywordStyle.Sprint("return") + " " + nameStyle.Sprint("a") + "\n" +
+				"\\ No newline at end of file\n" +
+				"commit 5678\n" +
+				"\n" +
+				"    return in commit message\n",
+		},
+		{
+			testName: "leaves files of unknown type untouched",
+			input: "diff --git a/file.unknown-extension b/file.unknown-extension\n" +
+				"--- a/file.unknown-extension\n" +
+				"+++ b/file.unknown-extension\n" +
+				"@@ -1 +1 @@\n" +
+				"-return a\n" +
+				"+return b",
+			expected: "diff --git a/file.unknown-extension b/file.unknown-extension\n" +
+				"--- a/file.unknown-extension\n" +
+				"+++ b/file.unknown-extension\n" +
+				"@@ -1 +1 @@\n" +
+				"-return a\n" +
+				"+return b",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			output, err := io.ReadAll(NewDiffReader(strings.NewReader(s.input), highlighter))
+			assert.NoError(t, err)
+			assert.Equal(t, s.expected, string(output))
+		})
+	}
+}
+
+func TestDiffReaderWithoutHighlighter(t *testing.T) {
+	reader := strings.NewReader("+return a\n")
+	assert.Same(t, reader, NewDiffReader(reader, nil))
+}
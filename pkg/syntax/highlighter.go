@@ -0,0 +1,189 @@
+package syntax
+
+import (
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/alecthomas/chroma/v2"
+	"github.com/alecthomas/chroma/v2/lexers"
+	"github.com/alecthomas/chroma/v2/styles"
+	"github.com/gookit/color"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+)
+
+// A Highlighter splits source code into tokens and styles them according to a
+// chroma style. It only ever sets foreground colours and decorations, so that
+// the result can be layered beneath other colouring (e.g. that of a diff) and
+// the background is left free for selection highlighting.
+type Highlighter struct {
+	style *chroma.Style
+
+	mutex       sync.Mutex
+	lexers      map[string]chroma.Lexer
+	tokenStyles map[chroma.TokenType]*style.TextStyle
+}
+
+// A piece of text along with the style to render it in
+type Token struct {
+	Text string
+	// nil for text that the syntax highlighting style doesn't assign a colour
+	// to, which should be rendered in whatever colour it would have otherwise
+	Style *style.TextStyle
+}
+
+// Returns the names of all styles that can be passed to NewHighlighter
+func StyleNames() []string {
+	return styles.Names()
+}
+
+// Returns nil if styleName is empty, meaning that syntax highlighting is
+// disabled. Unknown style names fall back to chroma's default style.
+func NewHighlighter(styleName string) *Highlighter {
+	if styleName == "" {
+		return nil
+	}
+
+	return &Highlighter{
+		style:       styles.Get(styleName),
+		lexers:      map[string]chroma.Lexer{},
+		tokenStyles: map[chroma.TokenType]*style.TextStyle{},
+	}
+}
+
+// Splits the given lines of a file into tokens. The lines are tokenised
+// together so that constructs spanning several lines (e.g. block comments) are
+// recognised. Returns nil if the language of the file is not known.
+func (self *Highlighter) Highlight(filename string, lines []string) [][]Token {
+	if self == nil || len(lines) == 0 {
+		return nil
+	}
+
+	lexer := self.lexerForFile(filename)
+	if lexer == nil {
+		return nil
+	}
+
+	tokens, ok := tokenise(lexer, strings.Join(lines, "\n"))
+	if !ok {
+		return nil
+	}
+
+	result := make([][]Token, 0, len(lines))
+	line := []Token{}
+	for _, token := range tokens {
+		for i, text := range strings.Split(token.Value, "\n") {
+			if i > 0 {
+				result = append(result, line)
+				line = []Token{}
+			}
+			if text != "" {
+				line = append(line, Token{Text: text, Style: self.tokenStyle(token.Type)})
+			}
+		}
+	}
+	result = append(result, line)
+
+	// Some lexers add a trailing newline, so we may get one line too many. If
+	// the tokens don't add up to the original lines for any other reason we
+	// don't highlight anything rather than rendering something wrong.
+	if len(result) == len(lines)+1 && len(result[len(lines)]) == 0 {
+		result = result[:len(lines)]
+	}
+	if len(result) != len(lines) {
+		return nil
+	}
+	for i, line := range lines {
+		if joinTokens(result[i]) != line {
+			return nil
+		}
+	}
+
+	return result
+}
+
+// Splits a single line of a file into tokens. Returns nil if the language of
+// the file is not known.
+func (self *Highlighter) HighlightLine(filename string, line string) []Token {
+	result := self.Highlight(filename, []string{line})
+	if result == nil {
+		return nil
+	}
+	return result[0]
+}
+
+func (self *Highlighter) lexerForFile(filename string) chroma.Lexer {
+	name := filepath.Base(filename)
+
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	// matching a file against all lexers is slow, so we cache the result,
+	// including the case where no lexer matches
+	lexer, ok := self.lexers[name]
+	if !ok {
+		lexer = lexers.Match(name)
+		self.lexers[name] = lexer
+	}
+	return lexer
+}
+
+func tokenise(lexer chroma.Lexer, text string) (tokens []chroma.Token, ok bool) {
+	// chroma iterators report errors by panicking
+	defer func() {
+		if recover() != nil {
+			tokens, ok = nil, false
+		}
+	}()
+
+	iterator, err := lexer.Tokenise(nil, text)
+	if err != nil {
+		return nil, false
+	}
+	return iterator.Tokens(), true
+}
+
+func (self *Highlighter) tokenStyle(tokenType chroma.TokenType) *style.TextStyle {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	if textStyle, ok := self.tokenStyles[tokenType]; ok {
+		return textStyle
+	}
+
+	textStyle := self.newTokenStyle(tokenType)
+	self.tokenStyles[tokenType] = textStyle
+	return textStyle
+}
+
+func (self *Highlighter) newTokenStyle(tokenType chroma.TokenType) *style.TextStyle {
+	entry := self.style.Get(tokenType)
+	// Every token inherits the colour of the style's background entry, which is
+	// the default text colour of the theme. We don't apply that, so that plain
+	// text keeps whatever colour it would have without syntax highlighting.
+	hasColour := entry.Colour.IsSet() && entry.Colour != self.style.Get(chroma.Background).Colour
+	if !hasColour && entry.Bold != chroma.Yes && entry.Underline != chroma.Yes {
+		return nil
+	}
+
+	textStyle := style.New()
+	if hasColour {
+		textStyle = textStyle.SetFg(style.NewRGBColor(
+			color.RGB(entry.Colour.Red(), entry.Colour.Green(), entry.Colour.Blue())))
+	}
+	if entry.Bold == chroma.Yes {
+		textStyle = textStyle.SetBold()
+	}
+	if entry.Underline == chroma.Yes {
+		textStyle = textStyle.SetUnderline()
+	}
+	return &textStyle
+}
+
+func joinTokens(tokens []Token) string {
+	stringBuilder := &strings.Builder{}
+	for _, token := range tokens {
+		stringBuilder.WriteString(token.Text)
+	}
+	return stringBuilder.String()
+}
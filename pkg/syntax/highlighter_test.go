@@ -0,0 +1,53 @@
+package syntax
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func tokenTexts(tokens [][]Token) [][]string {
+	return lo.Map(tokens, func(line []Token, _ int) []string {
+		return lo.Map(line, func(token Token, _ int) string { return token.Text })
+	})
+}
+
+func TestHighlight(t *testing.T) {
+	highlighter := NewHighlighter("monokai")
+
+	lines := []string{
+		"func main() {",
+		"\t/* a comment",
+		"\tspanning two lines */",
+		"}",
+	}
+	tokens := highlighter.Highlight("pkg/main.go", lines)
+
+	assert.Equal(t, [][]string{
+		{"func", " ", "main", "(", ")", " ", "{"},
+		{"\t", "/* a comment"},
+		{"\tspanning two lines */"},
+		{"}"},
+	}, tokenTexts(tokens))
+
+	// keywords are highlighted, whitespace is not
+	assert.NotNil(t, tokens[0][0].Style)
+	assert.Nil(t, tokens[0][1].Style)
+	// the second line of the comment is recognised as part of it
+	assert.Equal(t, tokens[1][1].Style, tokens[2][0].Style)
+}
+
+func TestHighlightUnknownLanguage(t *testing.T) {
+	highlighter := NewHighlighter("monokai")
+
+	assert.Nil(t, highlighter.Highlight("file.unknown-extension", []string{"some text"}))
+	assert.Nil(t, highlighter.HighlightLine("file.unknown-extension", "some text"))
+}
+
+func TestHighlightingDisabled(t *testing.T) {
+	highlighter := NewHighlighter("")
+
+	assert.Nil(t, highlighter)
+	assert.Nil(t, highlighter.Highlight("main.go", []string{"func main() {}"}))
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/syntax"
 )
 
 var (
@@ -45,6 +46,9 @@ var (
 	DiffTerminalColor = style.FgMagenta
 
 	UnstagedChangesColor = style.New()
+
+	// SyntaxHighlighter is used for highlighting the code in diffs; nil if syntax highlighting is disabled
+	SyntaxHighlighter *syntax.Highlighter
 )
 
 // UpdateTheme updates all theme variables
@@ -73,4 +77,6 @@ func UpdateTheme(themeConfig config.ThemeConfig) {
 
 	DefaultTextColor = GetTextStyle(themeConfig.DefaultFgColor, false)
 	GocuiDefaultTextColor = GetGocuiStyle(themeConfig.DefaultFgColor)
+
+	SyntaxHighlighter = syntax.NewHighlighter(themeConfig.SyntaxHighlighting)
 }
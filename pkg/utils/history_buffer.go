@@ -2,6 +2,7 @@ package utils
 
 import (
 	"errors"
+	"slices"
 )
 
 type HistoryBuffer[T any] struct {
@@ -36,3 +37,8 @@ func (self *HistoryBuffer[T]) PeekAt(index int) (T, error) {
 	}
 	return self.items[index], nil
 }
+
+// Returns the items, most recent first
+func (self *HistoryBuffer[T]) Items() []T {
+	return slices.Clone(self.items)
+}